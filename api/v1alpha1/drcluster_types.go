@@ -28,14 +28,29 @@ type DRClusterSpec struct {
 	// operation for sync/Metro DR.
 	CIDRs []string `json:"cidrs,omitempty"`
 
+	// CIDRsDiscovery opts in to discovering the CIDRs of the cluster's node addresses and storage clients on the
+	// cluster, reporting them and their drift from cidrs in status, and, optionally, fencing them too
+	// +optional
+	CIDRsDiscovery *DRClusterCIDRsDiscoverySpec `json:"cidrsDiscovery,omitempty"`
+
 	// ClusterFence is a string that determines the desired fencing state of the cluster.
 	ClusterFence ClusterFenceState `json:"clusterFence,omitempty"`
 
+	// Fencing selects how the cluster is fenced and unfenced when clusterFence is Fenced or Unfenced. The cluster is
+	// fenced off its storage by csi-addons NetworkFences of its CIDRs on its peer cluster if unset.
+	// +optional
+	Fencing *DRClusterFencingSpec `json:"fencing,omitempty"`
+
 	// Region of a managed cluster determines it DR group.
 	// All managed clusters in a region are considered to be in a sync group.
 	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="region is immutable"
 	Region Region `json:"region,omitempty"`
 
+	// Topology describes where and on what provider the managed cluster runs, in addition to its region, for
+	// DRPolicy placement constraints to restrict the clusters that workloads fail over to
+	// +optional
+	Topology *DRClusterTopology `json:"topology,omitempty"`
+
 	// S3 profile name (in Ramen config) to use as a source to restore PV
 	// related cluster state during recovery or relocate actions of applications
 	// to this managed cluster;  hence, this S3 profile should be available to
@@ -48,6 +63,58 @@ type DRClusterSpec struct {
 	S3ProfileName string `json:"s3ProfileName"`
 }
 
+// FencingProviderNetworkFence fences a cluster off its storage by csi-addons NetworkFences of its CIDRs on its peer
+// cluster
+const FencingProviderNetworkFence = "NetworkFence"
+
+// DRClusterTopology describes the location and provider of a managed cluster
+type DRClusterTopology struct {
+	// Zone of the managed cluster within its region
+	// +optional
+	Zone string `json:"zone,omitempty"`
+
+	// Provider of the managed cluster, e.g. a cloud provider or an on-premises site
+	// +optional
+	Provider string `json:"provider,omitempty"`
+
+	// Labels are arbitrary topology labels of the managed cluster, that DRPolicy placement constraints may select
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+// DRClusterFencingSpec selects the provider that fences and unfences a cluster
+type DRClusterFencingSpec struct {
+	// Name of the fencing provider: NetworkFence, or one registered with the hub operator, e.g. one that powers off
+	// the cluster's nodes through their cloud provider or BMC, or one that cuts their access to a storage array
+	// +kubebuilder:default=NetworkFence
+	// +optional
+	Provider string `json:"provider,omitempty"`
+
+	// Parameters of the fencing provider
+	// +optional
+	Parameters map[string]string `json:"parameters,omitempty"`
+
+	// Verify that the cluster can no longer write once fenced, before failover from it proceeds: the fencing
+	// provider must verify it, if it verifies fencing, or else the storage of its peer cluster must report, in its
+	// DRClusterConfig status, that no client of the cluster's CIDRs holds open sessions or watchers since the
+	// cluster was fenced
+	// +optional
+	Verify bool `json:"verify,omitempty"`
+
+	// VerificationOverride lets failover from the fenced cluster proceed without verification, as an admin
+	// confirmed that the cluster can no longer write
+	// +optional
+	VerificationOverride bool `json:"verificationOverride,omitempty"`
+}
+
+// DRClusterCIDRsDiscoverySpec configures the discovery of a cluster's CIDRs
+type DRClusterCIDRsDiscoverySpec struct {
+	// ReconcileFence fences the effective CIDRs, i.e. cidrs and the discovered CIDRs they do not cover, rather than
+	// cidrs alone, and updates the NetworkFences of a fenced cluster as they change, e.g. as nodes scale out
+	// +optional
+	ReconcileFence bool `json:"reconcileFence,omitempty"`
+}
+
 const (
 	// DRCluster has been validated
 	DRClusterValidated string = `Validated`
@@ -59,6 +126,11 @@ const (
 	// Fencing CR to fence off this cluster
 	// has been created
 	DRClusterConditionTypeFenced = "Fenced"
+
+	// The storage of the peer cluster reported that no client
+	// of this fenced cluster accesses it, or an admin overrode
+	// the verification
+	DRClusterConditionTypeFenceVerified = "FenceVerified"
 )
 
 type DRClusterPhase string
@@ -102,6 +174,10 @@ type ClusterMaintenanceMode struct {
 	// TargetID indicates the storage or replication instance identifier for the StorageProvisioner
 	TargetID string `json:"targetID"`
 
+	// Modes requested of the StorageProvisioner in the MaintenanceMode resource
+	// +optional
+	Modes []MMode `json:"modes,omitempty"`
+
 	// State from MaintenanceMode resource created for the StorageProvisioner
 	State MModeState `json:"state"`
 
@@ -114,6 +190,43 @@ type DRClusterStatus struct {
 	Phase            DRClusterPhase           `json:"phase,omitempty"`
 	Conditions       []metav1.Condition       `json:"conditions,omitempty"`
 	MaintenanceModes []ClusterMaintenanceMode `json:"maintenanceModes,omitempty"`
+
+	// Capabilities reports the health and capacity facts of the cluster's storage and DR components, as its
+	// DRClusterConfig reports them
+	// +optional
+	Capabilities []ClusterCapability `json:"capabilities,omitempty"`
+
+	// CIDRs reports the discovered CIDRs of the cluster and their drift from spec, if cidrsDiscovery is set
+	// +optional
+	CIDRs *DRClusterCIDRsStatus `json:"cidrs,omitempty"`
+
+	// FencingProvider is the fencing provider that fenced the cluster last, which unfences it and removes what its
+	// fencing left behind, even if the spec selects another one since
+	// +optional
+	FencingProvider string `json:"fencingProvider,omitempty"`
+}
+
+// DRClusterCIDRsStatus reports the discovered CIDRs of a cluster and their drift from its spec
+type DRClusterCIDRsStatus struct {
+	// Discovered CIDRs of the cluster's node addresses and storage clients
+	// +optional
+	Discovered []string `json:"discovered,omitempty"`
+
+	// Effective CIDRs to fence the cluster: spec cidrs and the discovered CIDRs they do not cover
+	// +optional
+	Effective []string `json:"effective,omitempty"`
+
+	// Undeclared discovered CIDRs that spec cidrs do not cover, which fencing misses unless reconcileFence is set
+	// +optional
+	Undeclared []string `json:"undeclared,omitempty"`
+
+	// Undiscovered spec cidrs that cover no discovered CIDR
+	// +optional
+	Undiscovered []string `json:"undiscovered,omitempty"`
+
+	// LastUpdateTime is the time the CIDRs were last discovered
+	// +optional
+	LastUpdateTime *metav1.Time `json:"lastUpdateTime,omitempty"`
 }
 
 //+kubebuilder:object:root=true
@@ -28,6 +28,11 @@ type DRClusterConfigSpec struct {
 	// ClusterID would carry the ManagedCluster identity from the ManagedCluster claim value for `id.k8s.io`
 	ClusterID string `json:"clusterID,omitempty"`
 
+	// DiscoverNodeAddresses requests that the CIDRs of the cluster's node addresses are reported in status, for the
+	// hub to discover the CIDRs to fence the cluster
+	// +optional
+	DiscoverNodeAddresses bool `json:"discoverNodeAddresses,omitempty"`
+
 	// TODO: PeerClusters []ClusterID; to decide if we really need this!
 }
 
@@ -65,6 +70,79 @@ type DRClusterConfigStatus struct {
 
 	// StorageAccessDetails lists the storage access information for each storage provisioner detected on the cluster.
 	StorageAccessDetails []StorageAccessDetail `json:"storageAccessDetails,omitempty"`
+
+	// NodeCIDRs lists the single address CIDRs of the internal addresses of the cluster's nodes, if
+	// discoverNodeAddresses is set
+	// +optional
+	NodeCIDRs []string `json:"nodeCIDRs,omitempty"`
+
+	// Capabilities lists the health and capacity facts that capability providers on the cluster report about its
+	// storage and DR components, e.g. free capacity per StorageClass, mirroring daemon health or VolSync version
+	// +optional
+	Capabilities []ClusterCapability `json:"capabilities,omitempty"`
+
+	// FenceVerifications lists the reports of storage backends on whether clients of CIDRs, e.g. of a fenced peer
+	// cluster, still access their storage. Storage operators report them by ClusterClaims labeled
+	// ramendr.openshift.io/fence-verification, and fence verifiers registered with the DR cluster operator report
+	// them on each reconcile.
+	// +optional
+	FenceVerifications []FenceVerification `json:"fenceVerifications,omitempty"`
+}
+
+// ClusterCapabilityStatus is the health of a cluster capability
+// +kubebuilder:validation:Enum=Healthy;Degraded;Unhealthy;Unknown
+type ClusterCapabilityStatus string
+
+// Valid values for ClusterCapabilityStatus
+const (
+	ClusterCapabilityHealthy   = ClusterCapabilityStatus("Healthy")
+	ClusterCapabilityDegraded  = ClusterCapabilityStatus("Degraded")
+	ClusterCapabilityUnhealthy = ClusterCapabilityStatus("Unhealthy")
+	ClusterCapabilityUnknown   = ClusterCapabilityStatus("Unknown")
+)
+
+// ClusterCapability is a health or capacity fact about a storage or DR component of a cluster
+type ClusterCapability struct {
+	// Name of the fact, unique on the cluster, e.g. storageclass.capacity.free/<storageclass> or volsync.version
+	Name string `json:"name"`
+
+	// Provider that reports the fact
+	Provider string `json:"provider"`
+
+	// Value of the fact, e.g. a quantity or a version
+	// +optional
+	Value string `json:"value,omitempty"`
+
+	// Status is the health of the component as the fact indicates it
+	// +optional
+	Status ClusterCapabilityStatus `json:"status,omitempty"`
+
+	// BlocksFailover indicates that workloads may not fail over to the cluster while the fact is Unhealthy, e.g. as
+	// it has no free capacity. Facts that a disaster affects, e.g. the health of a mirror link to the failed peer
+	// cluster, do not.
+	// +optional
+	BlocksFailover bool `json:"blocksFailover,omitempty"`
+
+	// Message explains the status
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// FenceVerification is a storage backend's report on whether clients of CIDRs hold open sessions or watchers
+type FenceVerification struct {
+	// StorageProvisioner is the name of the storage provisioner of the storage backend
+	StorageProvisioner string `json:"storageProvisioner"`
+
+	// CIDRs whose clients were checked
+	CIDRs []string `json:"cidrs"`
+
+	// ActiveClients lists the clients of the CIDRs that hold open sessions or watchers, none once the CIDRs can no
+	// longer write
+	// +optional
+	ActiveClients []string `json:"activeClients,omitempty"`
+
+	// CheckTime is the time of the check
+	CheckTime metav1.Time `json:"checkTime"`
 }
 
 // StorageAccessDetail contains storage access information for a specific storage provisioner.
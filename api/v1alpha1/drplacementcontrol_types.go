@@ -76,6 +76,10 @@ const (
 	// Protected condition provides the latest available observation regarding the protection status of the workload,
 	// on the cluster it is expected to be available on.
 	ConditionProtected = "Protected"
+
+	// SplitBrain condition reports the PVCs, on the cluster failed over from, whose volumes may hold writes that
+	// were not replicated, and whether their diverged data is pending acknowledgement before it is discarded.
+	ConditionSplitBrain = "SplitBrain"
 )
 
 const (
@@ -93,6 +97,12 @@ const (
 	ReasonProtected            = "Protected"
 )
 
+const (
+	ReasonSplitBrainAcknowledgementRequired = "AcknowledgementRequired"
+	ReasonSplitBrainDetected                = "Detected"
+	ReasonSplitBrainResolved                = "Resolved"
+)
+
 type ProgressionStatus string
 
 const (
@@ -119,6 +129,7 @@ const (
 	ProgressionDeleting                            = ProgressionStatus("Deleting")
 	ProgressionDeleted                             = ProgressionStatus("Deleted")
 	ProgressionActionPaused                        = ProgressionStatus("Paused")
+	ProgressionMigratingDRPolicy                   = ProgressionStatus("MigratingDRPolicy")
 )
 
 // DRPlacementControlSpec defines the desired state of DRPlacementControl
@@ -136,16 +147,19 @@ type DRPlacementControlSpec struct {
 	// +kubebuilder:validation:Optional
 	ProtectedNamespaces *[]string `json:"protectedNamespaces,omitempty"`
 
-	// DRPolicyRef is the reference to the DRPolicy participating in the DR replication for this DRPC
+	// DRPolicyRef is the reference to the DRPolicy participating in the DR replication for this DRPC.
+	// Changing it to another DRPolicy that contains the current primary cluster migrates the DRPC to it, keeping
+	// the primary protected, setting up the new peers and removing protection from clusters that are not in it.
+	// A change is rejected unless the DRPC is idle as Deployed, FailedOver or Relocated.
 	// +kubebuilder:validation:Required
-	// +kubebuilder:validation:XValidation:rule="self == oldSelf", message="drPolicyRef is immutable"
 	DRPolicyRef v1.ObjectReference `json:"drPolicyRef"`
 
 	// PreferredCluster is the cluster name that the user preferred to run the application on
 	PreferredCluster string `json:"preferredCluster,omitempty"`
 
 	// FailoverCluster is the cluster name that the user wants to failover the application to.
-	// If not specified, then the DRPC will select the surviving cluster from the DRPolicy
+	// If not specified for a Failover action, then the DRPC selects the first cluster in the DRPolicy that is
+	// neither the primary nor fenced, and that satisfies the DRPolicy placementConstraints, and sets it here
 	FailoverCluster string `json:"failoverCluster,omitempty"`
 
 	// Label selector to identify all the PVCs that need DR protection.
@@ -163,6 +177,31 @@ type DRPlacementControlSpec struct {
 
 	// +optional
 	VolSyncSpec *VolSyncSpec `json:"volSyncSpec,omitempty"`
+
+	// ReplicationMethodOverrides forces the replication method of the PVCs matching each override's selector.
+	// The first matching override applies to a PVC.
+	// +optional
+	ReplicationMethodOverrides []ReplicationMethodOverride `json:"replicationMethodOverrides,omitempty"`
+
+	// S3WriteQuorum is the number of the S3 profiles of the DRPolicy's clusters that the VRG's uploads must
+	// succeed to: Any one, a Majority, or All of them. If unset, all of them, with inaccessible ones skipped as
+	// described for the VRG.
+	// +optional
+	S3WriteQuorum S3WriteQuorum `json:"s3WriteQuorum,omitempty"`
+
+	// SchedulingTier is the name of a scheduling tier of the DRPolicy to replicate at, instead of the DRPolicy
+	// SchedulingInterval. Changing the tier updates the schedule of replication in place only for workloads whose
+	// PVCs are all replicated using VolSync. A change that alters the schedule of PVCs replicated using
+	// VolumeReplications is rejected, as their VolumeReplicationClass is immutable, until DR is disabled and
+	// re-enabled for the workload.
+	// +optional
+	SchedulingTier string `json:"schedulingTier,omitempty"`
+
+	// SplitBrain configures how the VRG handles PVCs that may hold writes that were not replicated, as it is demoted
+	// on the cluster failed over from. When acknowledgement is required, the SplitBrain condition reports the PVCs
+	// pending it, and splitBrain.acknowledgedTime is to be set to discard their diverged data.
+	// +optional
+	SplitBrain *SplitBrainSpec `json:"splitBrain,omitempty"`
 }
 
 // PlacementDecision defines the decision made by controller
@@ -197,6 +236,10 @@ type VRGResourceMeta struct {
 	//+optional
 	PVCGroups []Groups `json:"pvcgroups,omitempty"`
 
+	// Replication method of each protected PVC, keyed by the PVC namespace/name
+	//+optional
+	ReplicationMethods map[string]ReplicationMethod `json:"replicationMethods,omitempty"`
+
 	// ResourceVersion is a value used to identify the version of the
 	// VRG resource object
 	//+optional
@@ -241,9 +284,36 @@ type DRPlacementControlStatus struct {
 	//+optional
 	LastGroupSyncBytes *int64 `json:"lastGroupSyncBytes,omitempty"`
 
+	// groupSyncProgress summarizes the in-flight or failing synchronizations of all PVCs
+	//+optional
+	GroupSyncProgress *SyncProgress `json:"groupSyncProgress,omitempty"`
+
 	// lastKubeObjectProtectionTime is the time of the most recent successful kube object protection
 	//+optional
 	LastKubeObjectProtectionTime *metav1.Time `json:"lastKubeObjectProtectionTime,omitempty"`
+
+	// drPolicyName is the name of the DRPolicy that the workload is protected with
+	//+optional
+	DRPolicyName string `json:"drPolicyName,omitempty"`
+
+	// drPolicyMigration reports an in progress migration of the workload from another DRPolicy to the DRPolicy
+	// referenced by the spec
+	//+optional
+	DRPolicyMigration *DRPolicyMigration `json:"drPolicyMigration,omitempty"`
+}
+
+// DRPolicyMigration reports the migration of a workload from one DRPolicy to another
+type DRPolicyMigration struct {
+	// FromDRPolicy is the name of the DRPolicy that the workload is migrating from
+	FromDRPolicy string `json:"fromDRPolicy"`
+
+	// RetiringClusters are the clusters of FromDRPolicy that are not in the DRPolicy the workload is migrating to,
+	// whose protection resources are removed once every peer in the new DRPolicy reports DataProtected
+	//+optional
+	RetiringClusters []string `json:"retiringClusters,omitempty"`
+
+	// StartTime is the time the migration started
+	StartTime metav1.Time `json:"startTime"`
 }
 
 // +kubebuilder:object:root=true
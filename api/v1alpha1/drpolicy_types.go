@@ -10,6 +10,7 @@ import (
 // DRPolicySpec defines the desired state of DRPolicy
 // +kubebuilder:validation:XValidation:rule="has(oldSelf.replicationClassSelector) == has(self.replicationClassSelector)", message="replicationClassSelector is immutable"
 // +kubebuilder:validation:XValidation:rule="has(oldSelf.volumeSnapshotClassSelector) == has(self.volumeSnapshotClassSelector)", message="volumeSnapshotClassSelector is immutable"
+// +kubebuilder:validation:XValidation:rule="has(oldSelf.peers) == has(self.peers)", message="peers is immutable"
 type DRPolicySpec struct {
 	// scheduling Interval for replicating Persistent Volume
 	// data to a peer cluster. Interval is typically in the
@@ -46,9 +47,124 @@ type DRPolicySpec struct {
 
 	// List of DRCluster resources that are governed by this policy
 	// +kubebuilder:validation:Required
-	// +kubebuilder:validation:XValidation:rule="size(self) == 2", message="drClusters requires a list of 2 clusters"
+	// +kubebuilder:validation:XValidation:rule="size(self) >= 2", message="drClusters requires a list of at least 2 clusters"
 	// +kubebuilder:validation:XValidation:rule="self == oldSelf", message="drClusters is immutable"
 	DRClusters []string `json:"drClusters"`
+
+	// Peers declares the replication type and schedule of pairs of clusters in DRClusters, for policies that
+	// replicate between more than two clusters, such as a cluster replicating to remote regions at different
+	// intervals. Pairs of clusters that are not declared replicate as per SchedulingInterval. All pairs replicate
+	// synchronously if SchedulingInterval is empty, or asynchronously otherwise, as a policy does not mix both.
+	// Volumes of policies with more than two clusters are replicated only by VolumeReplication, as VolSync does not
+	// replicate to more than one destination, and such policies whose async StorageClasses lack replication classes
+	// fail validation.
+	//+optional
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf", message="peers is immutable"
+	Peers []DRPolicyPeer `json:"peers,omitempty"`
+
+	// SchedulingTiers are named scheduling intervals for asynchronous replication, such as gold=1m, silver=15m and
+	// bronze=1h, that a DRPlacementControl may select instead of SchedulingInterval
+	//+optional
+	// +listType=map
+	// +listMapKey=name
+	SchedulingTiers []SchedulingTier `json:"schedulingTiers,omitempty"`
+
+	// StorageClassMapping pairs StorageClasses with different names across the clusters in the policy, for
+	// workloads that are protected across clusters whose storage is provisioned using differently named
+	// StorageClasses. A mapping applies in both directions between its clusters.
+	//+optional
+	StorageClassMapping []StorageClassMapping `json:"storageClassMapping,omitempty"`
+
+	// PlacementConstraints restrict the clusters in DRClusters that a workload may fail over to from its primary
+	// cluster, as per the topology of the DRClusters. Constraints that leave a cluster with no failover target are
+	// reported by the PlacementConstraintsSatisfied condition, and do not invalidate the policy.
+	//+optional
+	PlacementConstraints *PlacementConstraints `json:"placementConstraints,omitempty"`
+}
+
+// TopologyKey names an attribute of the topology of a DRCluster
+// +kubebuilder:validation:Enum=Region;Zone;Provider
+type TopologyKey string
+
+const (
+	TopologyKeyRegion   = TopologyKey("Region")
+	TopologyKeyZone     = TopologyKey("Zone")
+	TopologyKeyProvider = TopologyKey("Provider")
+)
+
+// PlacementConstraints restrict the failover targets of a primary cluster, such as requiring that the target is in
+// a different region than the primary, or avoiding a provider
+type PlacementConstraints struct {
+	// DistinctTopologyKeys are the topology attributes in which a failover target must differ from the primary
+	// cluster. A cluster with no value for an attribute does not differ from any cluster in it.
+	//+optional
+	DistinctTopologyKeys []TopologyKey `json:"distinctTopologyKeys,omitempty"`
+
+	// AvoidProviders are the providers of clusters that may not be failover targets
+	//+optional
+	AvoidProviders []string `json:"avoidProviders,omitempty"`
+
+	// TargetSelector selects, by their topology labels, the clusters that may be failover targets
+	//+optional
+	TargetSelector *metav1.LabelSelector `json:"targetSelector,omitempty"`
+}
+
+// DRPolicyReplicationType is the type of replication between a pair of clusters in a DRPolicy
+// +kubebuilder:validation:Enum=Sync;Async
+type DRPolicyReplicationType string
+
+const (
+	// DRPolicyReplicationSync denotes synchronous replication across a pair of clusters sharing a storage instance
+	DRPolicyReplicationSync = DRPolicyReplicationType("Sync")
+
+	// DRPolicyReplicationAsync denotes asynchronous replication across a pair of clusters at a scheduling interval
+	DRPolicyReplicationAsync = DRPolicyReplicationType("Async")
+)
+
+// DRPolicyPeer declares the replication between a pair of clusters in a DRPolicy
+// +kubebuilder:validation:XValidation:rule="self.replicationType == 'Async' ? (has(self.schedulingInterval) && self.schedulingInterval != '') : (!has(self.schedulingInterval) || self.schedulingInterval == '')", message="schedulingInterval is required for Async peers and not allowed for Sync peers"
+type DRPolicyPeer struct {
+	// Clusters is the pair of DRCluster names, from the DRPolicy DRClusters list, that replicate to each other
+	// +kubebuilder:validation:MinItems=2
+	// +kubebuilder:validation:MaxItems=2
+	Clusters []string `json:"clusters"`
+
+	// ReplicationType is the type of replication between the Clusters
+	ReplicationType DRPolicyReplicationType `json:"replicationType"`
+
+	// SchedulingInterval for asynchronous replication between the Clusters, in the same form as the DRPolicy
+	// SchedulingInterval
+	//+optional
+	// +kubebuilder:validation:Pattern=`^(|\d+[mhd])$`
+	SchedulingInterval string `json:"schedulingInterval,omitempty"`
+}
+
+// SchedulingTier is a named scheduling interval for asynchronous replication
+type SchedulingTier struct {
+	// Name of the tier
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// SchedulingInterval of the tier, in the same form as the DRPolicy SchedulingInterval
+	// +kubebuilder:validation:Pattern=`^\d+[mhd]$`
+	SchedulingInterval string `json:"schedulingInterval"`
+}
+
+// StorageClassMapping pairs a StorageClass on a source cluster with a differently named StorageClass on a
+// target cluster
+type StorageClassMapping struct {
+	// SourceCluster is the name of the DRCluster where SourceStorageClassName is available
+	SourceCluster string `json:"sourceCluster"`
+
+	// SourceStorageClassName is the name of the StorageClass on the SourceCluster
+	SourceStorageClassName string `json:"sourceStorageClassName"`
+
+	// TargetCluster is the name of the DRCluster where TargetStorageClassName is available
+	TargetCluster string `json:"targetCluster"`
+
+	// TargetStorageClassName is the name of the StorageClass on the TargetCluster that PVCs using the
+	// SourceStorageClassName on the SourceCluster are restored with
+	TargetStorageClassName string `json:"targetStorageClassName"`
 }
 
 // DRPolicyStatus defines the observed state of DRPolicy
@@ -64,6 +180,30 @@ type DRPolicyStatus struct {
 	// sync replication details between the clusters in the policy
 	//+optional
 	Sync Sync `json:"sync,omitempty"`
+
+	// UnmatchedStorageClasses lists, for each pair of clusters in the policy, the StorageClasses that could not be
+	// paired into a sync or async PeerClass, at the DRPolicy SchedulingInterval or at that of a scheduling tier, along
+	// with the reason the pairing failed
+	//+optional
+	UnmatchedStorageClasses []UnmatchedStorageClass `json:"unmatchedStorageClasses,omitempty"`
+}
+
+// UnmatchedStorageClass reports a StorageClass that is not DR capable between a pair of clusters
+type UnmatchedStorageClass struct {
+	// StorageClassName is the name of the StorageClass on the first cluster in Clusters, or on the second cluster if
+	// it does not exist on the first
+	StorageClassName string `json:"storageClassName"`
+
+	// Clusters is the pair of cluster names across which the StorageClass could not be paired
+	Clusters []string `json:"clusters"`
+
+	// Reason describes the class or label that is missing or mismatched, and on which cluster
+	Reason string `json:"reason"`
+
+	// Tier is the name of the scheduling tier at whose interval the StorageClass could not be paired, for a reason
+	// other than at the DRPolicy SchedulingInterval, or empty for the DRPolicy SchedulingInterval
+	//+optional
+	Tier string `json:"tier,omitempty"`
 }
 
 // for RDR
@@ -72,6 +212,21 @@ type Async struct {
 	// that have related async relationships. (one per pair of peers in the policy)
 	//+optional
 	PeerClasses []PeerClass `json:"peerClasses,omitempty"`
+
+	// Tiers contains the async peer classes for each of the DRPolicy SchedulingTiers
+	//+optional
+	Tiers []AsyncTier `json:"tiers,omitempty"`
+}
+
+// AsyncTier contains the async peer classes for a DRPolicy scheduling tier
+type AsyncTier struct {
+	// Name of the scheduling tier
+	Name string `json:"name"`
+
+	// PeerClasses is a list of common StorageClasses across the clusters in a policy that have related async
+	// relationships at the scheduling interval of the tier
+	//+optional
+	PeerClasses []PeerClass `json:"peerClasses,omitempty"`
 }
 
 // for MetroDR
@@ -99,10 +254,16 @@ type PeerClass struct {
 	//+optional
 	StorageID []string `json:"storageID,omitempty"`
 
-	// StorageClassName is the name of a StorageClass that is available across the peers
+	// StorageClassName is the name of a StorageClass that is available across the peers, or, if
+	// PeerStorageClassName is set, the name of the StorageClass on the first cluster in the ClusterIDs list
 	//+optional
 	StorageClassName string `json:"storageClassName,omitempty"`
 
+	// PeerStorageClassName is the name of the StorageClass on the second cluster in the ClusterIDs list, when it
+	// differs from StorageClassName as a result of a StorageClassMapping in the policy
+	//+optional
+	PeerStorageClassName string `json:"peerStorageClassName,omitempty"`
+
 	// ClusterIDs is a list of two clusterIDs that represent this peer relationship for a common StorageClassName
 	// The IDs are based on the value of the metadata.uid of the kube-system namespace
 	ClusterIDs []string `json:"clusterIDs,omitempty"`
@@ -124,6 +285,11 @@ type PeerClass struct {
 
 const (
 	DRPolicyValidated string = `Validated`
+
+	// The placement constraints of the DRPolicy leave every cluster in
+	// it with a failover target. A DRPolicy remains valid for DR actions
+	// if they do not, as failovers to targets that violate them fail.
+	DRPolicyPlacementConstraintsSatisfied string = `PlacementConstraintsSatisfied`
 )
 
 // +kubebuilder:object:root=true
@@ -9,12 +9,21 @@ import (
 
 // MMode defines a maintenance mode, that a storage backend may be requested to act on, based on the DR orchestration
 // in progress for one or more workloads whose PVCs use the specific storage provisioner
-// +kubebuilder:validation:Enum=Failover
+// +kubebuilder:validation:Enum=Failover;Relocate;Resync
 type MMode string
 
 // Supported maintenance modes
 const (
+	// MModeFailover is activated on the cluster that workloads fail over to, before they fail over
 	MModeFailover = MMode("Failover")
+
+	// MModeRelocate is activated on the cluster that workloads relocate from, before their final sync, e.g. for the
+	// storage backend to drain replication or take a snapshot
+	MModeRelocate = MMode("Relocate")
+
+	// MModeResync is activated on the peer clusters of the cluster that workloads failed over or relocated to, while
+	// they are cleaned up, for the storage backend to resync their volumes from it
+	MModeResync = MMode("Resync")
 )
 
 // MaintenanceModeSpec defines the desired state of MaintenanceMode for a StorageProvisioner
@@ -55,12 +64,14 @@ const (
 )
 
 // MModeStatusConditionType defines an expected condition type
-// +kubebuilder:validation:Enum=FailoverActivated
+// +kubebuilder:validation:Enum=FailoverActivated;RelocateActivated;ResyncActivated
 type MModeStatusConditionType string
 
 // Valid MModeStatusConditionType types (condition types)
 const (
 	MModeConditionFailoverActivated = MModeStatusConditionType("FailoverActivated")
+	MModeConditionRelocateActivated = MModeStatusConditionType("RelocateActivated")
+	MModeConditionResyncActivated   = MModeStatusConditionType("ResyncActivated")
 )
 
 // MaintenanceModeStatus defines the observed state of MaintenanceMode
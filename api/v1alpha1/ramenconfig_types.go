@@ -173,6 +173,17 @@ type RamenConfig struct {
 		// from source to destination. Should be Snapshot/Direct
 		// default: Snapshot
 		DestinationCopyMethod string `json:"destinationCopyMethod,omitempty"`
+
+		// PSKRotationInterval is the interval at which the pre-shared keys used by the VolSync rsync-TLS
+		// movers are rotated, for example "720h". Keys are not rotated if unset. A new key is first accepted, then
+		// used to connect, and the previous key is dropped, each step starting once the secret of the previous step
+		// is applied on all clusters.
+		PSKRotationInterval metav1.Duration `json:"pskRotationInterval,omitempty"`
+
+		// SecretPropagation selects how VolSync secrets are propagated from the hub to the managed clusters.
+		// Policy uses ACM governance policies, and ManifestWork uses a ManifestWork per managed cluster.
+		// Defaults to Policy.
+		SecretPropagation VolSyncSecretPropagation `json:"secretPropagation,omitempty"`
 	} `json:"volSync,omitempty"`
 
 	KubeObjectProtection struct {
@@ -180,6 +191,8 @@ type RamenConfig struct {
 		Disabled bool `json:"disabled,omitempty"`
 		// Velero namespace input
 		VeleroNamespaceName string `json:"veleroNamespaceName,omitempty"`
+		// Provider is the implementation used to capture and recover kube objects, defaults to Velero
+		Provider KubeObjectProtectionProvider `json:"provider,omitempty"`
 	} `json:"kubeObjectProtection,omitempty"`
 
 	MultiNamespace struct {
@@ -195,6 +208,30 @@ type RamenConfig struct {
 	RamenOpsNamespace string `json:"ramenOpsNamespace,omitempty"`
 }
 
+// KubeObjectProtectionProvider is the implementation used to capture and recover kube objects
+type KubeObjectProtectionProvider string
+
+const (
+	// KubeObjectProtectionProviderVelero captures and recovers kube objects using Velero or OADP
+	KubeObjectProtectionProviderVelero = KubeObjectProtectionProvider("Velero")
+
+	// KubeObjectProtectionProviderNative captures and recovers kube objects using Ramen's built-in
+	// implementation, which stores them directly in the S3 stores and does not require Velero or OADP. The
+	// dr-cluster operator must be granted access to the protected kinds, as by the native-kubeobjects component.
+	KubeObjectProtectionProviderNative = KubeObjectProtectionProvider("Native")
+)
+
+// VolSyncSecretPropagation is the mechanism used to propagate VolSync secrets from the hub to managed clusters
+type VolSyncSecretPropagation string
+
+const (
+	// VolSyncSecretPropagationPolicy propagates secrets using ACM governance policies
+	VolSyncSecretPropagationPolicy = VolSyncSecretPropagation("Policy")
+
+	// VolSyncSecretPropagationManifestWork propagates secrets using ManifestWorks
+	VolSyncSecretPropagationManifestWork = VolSyncSecretPropagation("ManifestWork")
+)
+
 func init() {
 	SchemeBuilder.Register(&RamenConfig{})
 }
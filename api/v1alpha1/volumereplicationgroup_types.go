@@ -68,6 +68,11 @@ type VRGAsyncSpec struct {
 	// creates a PVC using a newer StorageClass that is determined to be common across the peers.
 	//+optional
 	PeerClasses []PeerClass `json:"peerClasses,omitempty"`
+
+	// StorageClassMapping maps the name of a StorageClass used by PVCs on a peer cluster to the name of the
+	// StorageClass that is used to restore these PVCs on this cluster
+	//+optional
+	StorageClassMapping map[string]string `json:"storageClassMapping,omitempty"`
 }
 
 // VRGSyncSpec has the parameters associated with VE
@@ -117,6 +122,21 @@ type VolSyncReplicationSourceSpec struct {
 	MoverConfig *MoverConfig `json:"moverConfig,omitempty"`
 }
 
+// UnmountedPVCMode determines how a VolSync protected PVC that is not mounted by any pod is prepared for
+// replication
+// +kubebuilder:validation:Enum=MountJob;SnapshotOnly
+type UnmountedPVCMode string
+
+const (
+	// UnmountedPVCModeMountJob runs a Job that mounts the PVC, which binds the PVC prior to replication
+	UnmountedPVCModeMountJob = UnmountedPVCMode("MountJob")
+
+	// UnmountedPVCModeSnapshotOnly replicates the PVC from CSI snapshots without mounting it, which requires
+	// the PVC to be bound without a consumer, as is the case for PVCs created by volume populators or clones
+	// using a StorageClass with an Immediate volume binding mode
+	UnmountedPVCModeSnapshotOnly = UnmountedPVCMode("SnapshotOnly")
+)
+
 // VolSynccSpec defines the ReplicationDestination specs for the Secondary VRG, or
 // the ReplicationSource specs for the Primary VRG
 type VolSyncSpec struct {
@@ -133,6 +153,11 @@ type VolSyncSpec struct {
 
 	//+optional
 	MoverConfig []MoverConfig `json:"moverConfig,omitempty"`
+
+	// unmountedPVCMode determines how PVCs that are not mounted by any pod are prepared for replication.
+	// Defaults to MountJob.
+	//+optional
+	UnmountedPVCMode UnmountedPVCMode `json:"unmountedPVCMode,omitempty"`
 }
 
 type MoverConfig struct {
@@ -175,11 +200,23 @@ const (
 )
 
 type KubeObjectProtectionSpec struct {
-	// Preferred time between captures
+	// Preferred time between captures. In Incremental capture mode, preferred time between updates of the time up
+	// to which changes are captured.
 	//+optional
 	//+kubebuilder:validation:Format=duration
 	CaptureInterval *metav1.Duration `json:"captureInterval,omitempty"`
 
+	// Capture mode. Full captures all the selected kube objects every capture interval. Incremental captures all
+	// the selected kube objects every full capture interval, and captures the changes to them as they happen in
+	// between. Incremental requires the Native kube object protection provider; other providers capture fully.
+	//+optional
+	CaptureMode KubeObjectsCaptureMode `json:"captureMode,omitempty"`
+
+	// Preferred time between full captures in Incremental capture mode
+	//+optional
+	//+kubebuilder:validation:Format=duration
+	FullCaptureInterval *metav1.Duration `json:"fullCaptureInterval,omitempty"`
+
 	// Name of the Recipe to reference for capture and recovery workflows and volume selection.
 	//+optional
 	RecipeRef *RecipeRef `json:"recipeRef,omitempty"`
@@ -191,6 +228,243 @@ type KubeObjectProtectionSpec struct {
 	// Label selector to identify all the kube objects that need DR protection.
 	// +optional
 	KubeObjectSelector *metav1.LabelSelector `json:"kubeObjectSelector,omitempty"`
+
+	// Transforms applied to kube objects as they are recovered, in order. They apply to the objects recovered by
+	// the default recover workflow and by the groups of a recipe recover workflow.
+	//+optional
+	RecoverTransforms []KubeObjectTransform `json:"recoverTransforms,omitempty"`
+
+	// Readiness of the recovered kube objects to wait for before their recovery is complete. Recovery is complete
+	// once the kube objects are restored if unset.
+	//+optional
+	RecoverReadiness *KubeObjectsRecoverReadinessSpec `json:"recoverReadiness,omitempty"`
+
+	// Cluster scoped kube objects that the protected kube objects depend on, e.g. custom resource definitions,
+	// cluster roles, storage classes and priority classes, to protect with them. Requires the Native kube object
+	// protection provider.
+	//+optional
+	ClusterResources *KubeObjectsClusterResourcesSpec `json:"clusterResources,omitempty"`
+
+	// Conditions to wait for before the kube objects of recover groups are recovered, e.g. that the definitions of
+	// their custom resources are established, or that the operator that reconciles them is available and its
+	// webhooks ready
+	//+optional
+	RecoverWaitFor []KubeObjectsRecoverWaitFor `json:"recoverWaitFor,omitempty"`
+
+	// How the kube objects recovered by the default recover workflow, i.e. without a recipe, are ordered. Default
+	// leaves their order to the kube object protection provider. Automatic recovers custom resource definitions
+	// first and waits for them to be established, then recovers the objects of built-in kinds, then waits for the
+	// webhooks served in the protected namespaces to be ready and recovers the objects of custom kinds, the kinds
+	// that own others before the kinds they own. Automatic requires the Native kube object protection provider.
+	//+optional
+	RecoverOrdering KubeObjectsRecoverOrdering `json:"recoverOrdering,omitempty"`
+}
+
+// KubeObjectsRecoverOrdering is how kube objects recovered without a recipe are ordered
+// +kubebuilder:validation:Enum=Default;Automatic
+type KubeObjectsRecoverOrdering string
+
+const (
+	KubeObjectsRecoverOrderingDefault   = KubeObjectsRecoverOrdering("Default")
+	KubeObjectsRecoverOrderingAutomatic = KubeObjectsRecoverOrdering("Automatic")
+)
+
+// KubeObjectsRecoverWaitFor declares a condition to wait for before the kube objects of a recover group are
+// recovered. Exactly one of customResourceDefinition, deployment and webhook is set.
+type KubeObjectsRecoverWaitFor struct {
+	// Name of the recover group, i.e. of a group of the recipe recover workflow or of the capture group it
+	// refers to, whose recovery waits. The recovery of every group waits if unset.
+	//+optional
+	Group string `json:"group,omitempty"`
+
+	// Name of a custom resource definition to be established
+	//+optional
+	CustomResourceDefinition string `json:"customResourceDefinition,omitempty"`
+
+	// Deployment to be available
+	//+optional
+	Deployment *KubeObjectsNamespacedName `json:"deployment,omitempty"`
+
+	// Name of a validating or mutating webhook configuration whose webhooks' services have ready endpoints
+	//+optional
+	Webhook string `json:"webhook,omitempty"`
+
+	// Time to wait for the condition before the recovery fails and is retried, 5 minutes if unset
+	//+optional
+	//+kubebuilder:validation:Format=duration
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+}
+
+// KubeObjectsNamespacedName identifies a namespaced kube object
+type KubeObjectsNamespacedName struct {
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+}
+
+// KubeObjectsCaptureMode is how kube objects are captured
+// +kubebuilder:validation:Enum=Full;Incremental
+type KubeObjectsCaptureMode string
+
+const (
+	KubeObjectsCaptureModeFull        = KubeObjectsCaptureMode("Full")
+	KubeObjectsCaptureModeIncremental = KubeObjectsCaptureMode("Incremental")
+)
+
+// KubeObjectTransformPatchType is the format of a kube object transform patch
+// +kubebuilder:validation:Enum=JSONPatch;MergePatch;StrategicMergePatch
+type KubeObjectTransformPatchType string
+
+const (
+	KubeObjectTransformJSONPatch           = KubeObjectTransformPatchType("JSONPatch")
+	KubeObjectTransformMergePatch          = KubeObjectTransformPatchType("MergePatch")
+	KubeObjectTransformStrategicMergePatch = KubeObjectTransformPatchType("StrategicMergePatch")
+)
+
+// KubeObjectTransform patches the recovered kube objects of a kind that match a label selector
+type KubeObjectTransform struct {
+	// Name of the transform
+	Name string `json:"name"`
+
+	// API group of the objects to transform, empty for the core group
+	//+optional
+	Group string `json:"group,omitempty"`
+
+	// Kind of the objects to transform
+	Kind string `json:"kind"`
+
+	// Label selector of the objects to transform, all objects of the kind if unset
+	//+optional
+	LabelSelector *metav1.LabelSelector `json:"labelSelector,omitempty"`
+
+	// Format of the patch
+	PatchType KubeObjectTransformPatchType `json:"patchType"`
+
+	// Patch applied to the objects. References to parameters, as $name or ${name}, are replaced with the values
+	// of the parameters for the cluster the objects are recovered to.
+	Patch string `json:"patch"`
+
+	// Parameters of the patch, keyed by the name of the cluster the objects are recovered to
+	//+optional
+	ClusterParameters map[string]map[string]string `json:"clusterParameters,omitempty"`
+}
+
+// KubeObjectsRecoverFailurePolicy is what is done when the recovered kube objects are not ready in time
+// +kubebuilder:validation:Enum=Retry;Keep;Rollback
+type KubeObjectsRecoverFailurePolicy string
+
+const (
+	KubeObjectsRecoverFailurePolicyRetry    = KubeObjectsRecoverFailurePolicy("Retry")
+	KubeObjectsRecoverFailurePolicyKeep     = KubeObjectsRecoverFailurePolicy("Keep")
+	KubeObjectsRecoverFailurePolicyRollback = KubeObjectsRecoverFailurePolicy("Rollback")
+)
+
+// KubeObjectsConflictPolicy is how a recovered cluster scoped kube object that exists already, and differs, is handled
+// +kubebuilder:validation:Enum=Skip;Overwrite;FailIfDifferent
+type KubeObjectsConflictPolicy string
+
+const (
+	KubeObjectsConflictPolicySkip            = KubeObjectsConflictPolicy("Skip")
+	KubeObjectsConflictPolicyOverwrite       = KubeObjectsConflictPolicy("Overwrite")
+	KubeObjectsConflictPolicyFailIfDifferent = KubeObjectsConflictPolicy("FailIfDifferent")
+)
+
+// KubeObjectsClusterResourcesSpec selects the cluster scoped kube objects that the protected kube objects depend on,
+// and declares how those that exist already where they are recovered are handled. The objects that a recovery creates
+// are annotated as owned by the VRG, and deleted when the VRG becomes secondary unless other VRGs own them or other
+// objects refer to them. Objects that exist already, and custom resource definitions, are never deleted.
+type KubeObjectsClusterResourcesSpec struct {
+	// Cluster scoped resources whose objects are protected, e.g. customresourcedefinitions.apiextensions.k8s.io,
+	// clusterroles.rbac.authorization.k8s.io, storageclasses.storage.k8s.io or priorityclasses.scheduling.k8s.io
+	//+kubebuilder:validation:MinItems=1
+	Resources []string `json:"resources"`
+
+	// Label selector of the objects to protect
+	//+optional
+	LabelSelector *metav1.LabelSelector `json:"labelSelector,omitempty"`
+
+	// Protect the objects that the protected kube objects refer to by name as well, e.g. the storage classes of
+	// their PVCs, the priority classes of their pods, the cluster roles of their role bindings and the definitions
+	// of their custom resources
+	//+optional
+	//+kubebuilder:default=true
+	Referenced *bool `json:"referenced,omitempty"`
+
+	// What is done if a recovered object exists already and differs. Skip leaves it as it is. Overwrite updates it.
+	// FailIfDifferent fails the recovery. Objects that exist already and do not differ are left as they are.
+	//+optional
+	//+kubebuilder:default=Skip
+	ConflictPolicy KubeObjectsConflictPolicy `json:"conflictPolicy,omitempty"`
+}
+
+// KubeObjectsClusterObjectResult is the outcome of protecting or recovering a cluster scoped kube object
+type KubeObjectsClusterObjectResult string
+
+// KubeObjectsClusterObjectStatus reports the outcome of capturing or recovering a cluster scoped kube object
+type KubeObjectsClusterObjectStatus struct {
+	// Group qualified resource of the object
+	Resource string `json:"resource"`
+
+	// Name of the object
+	Name string `json:"name"`
+
+	// Outcome: Captured, Created, Unchanged, Skipped, Overwritten or Conflict
+	Result KubeObjectsClusterObjectResult `json:"result"`
+
+	// How the object differs from the one recovered, if it does
+	//+optional
+	Message string `json:"message,omitempty"`
+}
+
+// KubeObjectsRecoverReadinessSpec declares how long to wait for the recovered kube objects to be ready, how their
+// readiness is determined, and what is done if they are not ready in time. Only the kinds the operator may access
+// are checked: workloads and virtual machines, or all kinds if the native kube objects component is enabled.
+type KubeObjectsRecoverReadinessSpec struct {
+	// Time to wait for the recovered kube objects to be ready after they are restored
+	//+optional
+	//+kubebuilder:validation:Format=duration
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+
+	// What is done if the recovered kube objects are not ready in time. Retry restores them again and waits again.
+	// Keep completes their recovery regardless. Rollback deletes the namespaced ones the recovery created, leaving
+	// those that existed already, and does not recover them again until the VRG spec changes. Kube objects are not
+	// protected until their recovery is complete.
+	//+optional
+	//+kubebuilder:default=Retry
+	FailurePolicy KubeObjectsRecoverFailurePolicy `json:"failurePolicy,omitempty"`
+
+	// Readiness rules of kinds, overriding the default. By default, workloads are ready once their ready replicas
+	// reach their desired replicas, virtual machines and other kinds once their Ready or Available condition, if
+	// any, is True, and kinds that report an observed generation once it is current.
+	//+optional
+	Rules []KubeObjectReadinessRule `json:"rules,omitempty"`
+}
+
+// KubeObjectReadinessRule declares how recovered kube objects of a kind are determined to be ready
+type KubeObjectReadinessRule struct {
+	// API group of the objects, empty for the core group
+	//+optional
+	Group string `json:"group,omitempty"`
+
+	// Kind of the objects
+	Kind string `json:"kind"`
+
+	// Type of a condition of the objects that must be True
+	//+optional
+	ConditionType string `json:"conditionType,omitempty"`
+
+	// Path of the field of the number of ready replicas of the objects, e.g. status.readyReplicas, that must be at
+	// least the number of desired replicas
+	//+optional
+	ReadyReplicasField string `json:"readyReplicasField,omitempty"`
+
+	// Path of the field of the number of desired replicas of the objects, spec.replicas if unset. The number of
+	// desired replicas is 1 if the field is not set.
+	//+optional
+	ReplicasField string `json:"replicasField,omitempty"`
+
+	// Objects of the kind are ready regardless of their state
+	//+optional
+	Ignore bool `json:"ignore,omitempty"`
 }
 
 type RecipeRef struct {
@@ -203,7 +477,11 @@ type RecipeRef struct {
 	Name string `json:"name,omitempty"`
 }
 
-const KubeObjectProtectionCaptureIntervalDefault = 5 * time.Minute
+const (
+	KubeObjectProtectionCaptureIntervalDefault     = 5 * time.Minute
+	KubeObjectProtectionFullCaptureIntervalDefault = time.Hour
+	KubeObjectsRecoverReadinessTimeoutDefault      = 10 * time.Minute
+)
 
 // VolumeReplicationGroup (VRG) spec declares the desired schedule for data
 // replication and replication state of all PVCs identified via the given
@@ -230,6 +508,15 @@ type VolumeReplicationGroupSpec struct {
 	// and forward PV related cluster state to peer DR clusters.
 	S3Profiles []string `json:"s3Profiles"`
 
+	// Number of the S3 profiles that PV cluster data, kube object captures and the VRG itself must be uploaded to
+	// for them to be protected: Any one, a Majority, or All of them. Once uploaded to a quorum, PV cluster data is
+	// not uploaded to the others until it changes. Inaccessible S3 profiles count as failed uploads if set. If
+	// unset, uploads must succeed to all of them, and kube objects and the VRG are not uploaded if any is
+	// inaccessible. Kube objects and PV cluster data are restored from the S3 profile with the most recent copy
+	// that is reachable, preferring the earlier profiles of the list.
+	//+optional
+	S3WriteQuorum S3WriteQuorum `json:"s3WriteQuorum,omitempty"`
+
 	//+optional
 	Async *VRGAsyncSpec `json:"async,omitempty"`
 	//+optional
@@ -262,6 +549,74 @@ type VolumeReplicationGroupSpec struct {
 	// You can use a recipe to filter and coordinate the order of the resources that are protected.
 	//+optional
 	ProtectedNamespaces *[]string `json:"protectedNamespaces,omitempty"`
+
+	// ReplicationMethodOverrides forces the replication method of selected PVCs. The first override whose
+	// selector matches a PVC applies to it, PVCs matching no override are replicated using the method the VRG
+	// chooses based on the available replication and peer classes.
+	//+optional
+	ReplicationMethodOverrides []ReplicationMethodOverride `json:"replicationMethodOverrides,omitempty"`
+
+	// SplitBrain configures how PVCs whose volumes may hold writes that were not replicated to the peer cluster are
+	// handled when the VRG is demoted after a failover
+	//+optional
+	SplitBrain *SplitBrainSpec `json:"splitBrain,omitempty"`
+}
+
+// SplitBrainSpec configures the handling of possibly split-brain PVCs. A PVC may be in split-brain when its volume
+// is still primary as the VRG is demoted after a failover away from its cluster, as it may hold writes after its
+// last sync that the new primary does not have. Whether it does is not known, so every volume that was primary at
+// an unplanned failover is handled as such. Resyncing such a volume from the new primary discards those writes.
+type SplitBrainSpec struct {
+	// PreserveDivergedData when set, a VolumeSnapshot of each split-brain PVC is taken, and is ready to use,
+	// before its volume is demoted and resynced
+	//+optional
+	PreserveDivergedData bool `json:"preserveDivergedData,omitempty"`
+
+	// RequireAcknowledgement when set, split-brain PVCs are demoted but not resynced until acknowledgedTime is
+	// set to a time at or after their detection
+	//+optional
+	RequireAcknowledgement bool `json:"requireAcknowledgement,omitempty"`
+
+	// AcknowledgedTime is the time at which the discarding of the diverged data of split-brain PVCs was
+	// acknowledged
+	//+optional
+	AcknowledgedTime *metav1.Time `json:"acknowledgedTime,omitempty"`
+}
+
+// S3WriteQuorum is the number of S3 profiles that uploads must succeed to
+// +kubebuilder:validation:Enum=Any;Majority;All
+type S3WriteQuorum string
+
+const (
+	S3WriteQuorumAny      = S3WriteQuorum("Any")
+	S3WriteQuorumMajority = S3WriteQuorum("Majority")
+	S3WriteQuorumAll      = S3WriteQuorum("All")
+)
+
+// ReplicationMethod identifies the mechanism used to replicate a PVC
+// +kubebuilder:validation:Enum=VolRep;VolSync;VolGroupRep
+type ReplicationMethod string
+
+const (
+	// ReplicationMethodVolRep replicates the PVC using a VolumeReplication resource
+	ReplicationMethodVolRep = ReplicationMethod("VolRep")
+
+	// ReplicationMethodVolSync replicates the PVC using VolSync
+	ReplicationMethodVolSync = ReplicationMethod("VolSync")
+
+	// ReplicationMethodVolGroupRep replicates the PVC along with its consistency group peers using a
+	// VolumeGroupReplication resource
+	ReplicationMethodVolGroupRep = ReplicationMethod("VolGroupRep")
+)
+
+// ReplicationMethodOverride forces the replication method for the PVCs matching its selector, instead of
+// letting the VRG choose a method based on the available replication and peer classes
+type ReplicationMethodOverride struct {
+	// Selector selects the PVCs, among those protected, that the override applies to
+	Selector metav1.LabelSelector `json:"selector"`
+
+	// Method is the replication method to use for the selected PVCs
+	Method ReplicationMethod `json:"method"`
 }
 
 type Identifier struct {
@@ -314,6 +669,10 @@ type ProtectedPVC struct {
 	//+optional
 	ProtectedByVolSync bool `json:"protectedByVolSync,omitempty"`
 
+	// ReplicationMethod is the mechanism used to replicate this PVC
+	//+optional
+	ReplicationMethod ReplicationMethod `json:"replicationMethod,omitempty"`
+
 	//+optional
 	StorageIdentifiers `json:",inline,omitempty"`
 
@@ -356,6 +715,32 @@ type ProtectedPVC struct {
 
 	// VolumeMode describes how a volume is intended to be consumed, either Block or Filesystem.
 	VolumeMode *corev1.PersistentVolumeMode `json:"volumeMode,omitempty"`
+
+	// SyncProgress reports the state of an in-flight or failing synchronization for the PVC,
+	// if protected in the volsync mode
+	//+optional
+	SyncProgress *SyncProgress `json:"syncProgress,omitempty"`
+}
+
+// SyncProgress captures the state of a data mover synchronization that has not yet completed
+type SyncProgress struct {
+	// Time the in-flight synchronization started
+	//+optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// Bytes transferred by the last failed data mover attempt of the in-flight synchronization. Not set while
+	// an attempt is running, as the data mover reports the bytes it transferred only once it ends.
+	//+optional
+	BytesTransferred *int64 `json:"bytesTransferred,omitempty"`
+
+	// Estimated time the in-flight synchronization completes: its start time plus the duration of the previous
+	// synchronization
+	//+optional
+	EstimatedCompletionTime *metav1.Time `json:"estimatedCompletionTime,omitempty"`
+
+	// Most recent error reported by the data mover
+	//+optional
+	LastError string `json:"lastError,omitempty"`
 }
 
 type KubeObjectsCaptureIdentifier struct {
@@ -365,11 +750,73 @@ type KubeObjectsCaptureIdentifier struct {
 	//+nullable
 	EndTime         metav1.Time `json:"endTime,omitempty"`
 	StartGeneration int64       `json:"startGeneration,omitempty"`
+
+	// Time up to which changes to the kube objects, made after the capture, are included in it. Set in Incremental
+	// capture mode only.
+	//+optional
+	ChangesTime *metav1.Time `json:"changesTime,omitempty"`
+}
+
+// KubeObjectsCaptureManifestSummary summarizes the manifest of the kube objects of a capture, which is stored with
+// the capture, and how they differ from those of the previous capture
+type KubeObjectsCaptureManifestSummary struct {
+	// Number of the capture
+	Number int64 `json:"number"`
+
+	// Number of objects captured
+	ObjectCount int `json:"objectCount"`
+
+	// Number of objects captured of each API version and kind, e.g. apps/v1/Deployment
+	//+optional
+	KindCounts map[string]int `json:"kindCounts,omitempty"`
+
+	// Number of objects captured that the previous capture did not capture
+	//+optional
+	AddedCount int `json:"addedCount,omitempty"`
+
+	// Number of objects that the previous capture captured and this capture did not
+	//+optional
+	RemovedCount int `json:"removedCount,omitempty"`
+
+	// Objects that the previous capture captured and this capture did not, as API version and kind followed by
+	// namespace qualified name, e.g. "v1/Secret app/credentials". Limited to the first ten.
+	//+optional
+	Removed []string `json:"removed,omitempty"`
 }
 
 type KubeObjectProtectionStatus struct {
 	//+optional
 	CaptureToRecoverFrom *KubeObjectsCaptureIdentifier `json:"captureToRecoverFrom,omitempty"`
+
+	// Summary of the manifest of the latest capture
+	//+optional
+	CaptureManifest *KubeObjectsCaptureManifestSummary `json:"captureManifest,omitempty"`
+
+	// Cluster scoped kube objects of the latest capture or recovery, and their outcome
+	//+optional
+	ClusterObjects []KubeObjectsClusterObjectStatus `json:"clusterObjects,omitempty"`
+
+	// Recover group whose recovery waits for its conditions, if any
+	//+optional
+	RecoverWaiting *KubeObjectsRecoverWaitingStatus `json:"recoverWaiting,omitempty"`
+
+	// Start time of the latest recovery. Objects created earlier were not created by it.
+	//+optional
+	RecoverStartTime *metav1.Time `json:"recoverStartTime,omitempty"`
+}
+
+// KubeObjectsRecoverWaitingStatus reports the recover group whose recovery waits for its conditions
+type KubeObjectsRecoverWaitingStatus struct {
+	// Name of the recover group, empty for the group of the default recover workflow
+	//+optional
+	Group string `json:"group,omitempty"`
+
+	// Time the wait started
+	Since metav1.Time `json:"since"`
+
+	// Conditions waited for that are not met
+	//+optional
+	Message string `json:"message,omitempty"`
 }
 
 // VolSyncReplicationDestinationInfo defines the configuration details for a PVC
@@ -426,6 +873,42 @@ type VolumeReplicationGroupStatus struct {
 	// successful synchronization of all PVCs
 	//+optional
 	LastGroupSyncBytes *int64 `json:"lastGroupSyncBytes,omitempty"`
+
+	// groupSyncProgress summarizes the in-flight or failing synchronizations of all PVCs
+	//+optional
+	GroupSyncProgress *SyncProgress `json:"groupSyncProgress,omitempty"`
+
+	// splitBrainPVCs lists the PVCs that may have diverged, as their volumes were primary when the VRG was demoted
+	// after a failover
+	//+optional
+	SplitBrainPVCs []SplitBrainPVC `json:"splitBrainPVCs,omitempty"`
+}
+
+// SplitBrainPVC records a PVC whose volume was primary when the VRG was demoted after a failover, and so may have
+// diverged, and what became of its possibly diverged data
+type SplitBrainPVC struct {
+	// Name of the PVC
+	Name string `json:"name"`
+
+	// Namespace of the PVC
+	Namespace string `json:"namespace"`
+
+	// DetectedTime is the time at which the possible split-brain was detected
+	DetectedTime metav1.Time `json:"detectedTime"`
+
+	// LastSyncTime is the time of the last successful synchronization of the volume to the peer cluster, writes
+	// after it may have diverged
+	//+optional
+	LastSyncTime *metav1.Time `json:"lastSyncTime,omitempty"`
+
+	// SnapshotName is the name of the VolumeSnapshot, in the namespace of the PVC, that preserves the diverged
+	// data, when preserveDivergedData is set
+	//+optional
+	SnapshotName string `json:"snapshotName,omitempty"`
+
+	// Discarded is true once the volume is resynced from the peer cluster, discarding its diverged data
+	//+optional
+	Discarded bool `json:"discarded,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -24,6 +24,13 @@ func (in *Async) DeepCopyInto(out *Async) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Tiers != nil {
+		in, out := &in.Tiers, &out.Tiers
+		*out = make([]AsyncTier, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Async.
@@ -36,9 +43,51 @@ func (in *Async) DeepCopy() *Async {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AsyncTier) DeepCopyInto(out *AsyncTier) {
+	*out = *in
+	if in.PeerClasses != nil {
+		in, out := &in.PeerClasses, &out.PeerClasses
+		*out = make([]PeerClass, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AsyncTier.
+func (in *AsyncTier) DeepCopy() *AsyncTier {
+	if in == nil {
+		return nil
+	}
+	out := new(AsyncTier)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterCapability) DeepCopyInto(out *ClusterCapability) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterCapability.
+func (in *ClusterCapability) DeepCopy() *ClusterCapability {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterCapability)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterMaintenanceMode) DeepCopyInto(out *ClusterMaintenanceMode) {
 	*out = *in
+	if in.Modes != nil {
+		in, out := &in.Modes, &out.Modes
+		*out = make([]MMode, len(*in))
+		copy(*out, *in)
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make([]v1.Condition, len(*in))
@@ -115,6 +164,60 @@ func (in *DRCluster) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DRClusterCIDRsDiscoverySpec) DeepCopyInto(out *DRClusterCIDRsDiscoverySpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DRClusterCIDRsDiscoverySpec.
+func (in *DRClusterCIDRsDiscoverySpec) DeepCopy() *DRClusterCIDRsDiscoverySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DRClusterCIDRsDiscoverySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DRClusterCIDRsStatus) DeepCopyInto(out *DRClusterCIDRsStatus) {
+	*out = *in
+	if in.Discovered != nil {
+		in, out := &in.Discovered, &out.Discovered
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Effective != nil {
+		in, out := &in.Effective, &out.Effective
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Undeclared != nil {
+		in, out := &in.Undeclared, &out.Undeclared
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Undiscovered != nil {
+		in, out := &in.Undiscovered, &out.Undiscovered
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.LastUpdateTime != nil {
+		in, out := &in.LastUpdateTime, &out.LastUpdateTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DRClusterCIDRsStatus.
+func (in *DRClusterCIDRsStatus) DeepCopy() *DRClusterCIDRsStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DRClusterCIDRsStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DRClusterConfig) DeepCopyInto(out *DRClusterConfig) {
 	*out = *in
@@ -241,6 +344,23 @@ func (in *DRClusterConfigStatus) DeepCopyInto(out *DRClusterConfigStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.NodeCIDRs != nil {
+		in, out := &in.NodeCIDRs, &out.NodeCIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Capabilities != nil {
+		in, out := &in.Capabilities, &out.Capabilities
+		*out = make([]ClusterCapability, len(*in))
+		copy(*out, *in)
+	}
+	if in.FenceVerifications != nil {
+		in, out := &in.FenceVerifications, &out.FenceVerifications
+		*out = make([]FenceVerification, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DRClusterConfigStatus.
@@ -253,6 +373,28 @@ func (in *DRClusterConfigStatus) DeepCopy() *DRClusterConfigStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DRClusterFencingSpec) DeepCopyInto(out *DRClusterFencingSpec) {
+	*out = *in
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DRClusterFencingSpec.
+func (in *DRClusterFencingSpec) DeepCopy() *DRClusterFencingSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DRClusterFencingSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DRClusterList) DeepCopyInto(out *DRClusterList) {
 	*out = *in
@@ -293,6 +435,21 @@ func (in *DRClusterSpec) DeepCopyInto(out *DRClusterSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.CIDRsDiscovery != nil {
+		in, out := &in.CIDRsDiscovery, &out.CIDRsDiscovery
+		*out = new(DRClusterCIDRsDiscoverySpec)
+		**out = **in
+	}
+	if in.Fencing != nil {
+		in, out := &in.Fencing, &out.Fencing
+		*out = new(DRClusterFencingSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Topology != nil {
+		in, out := &in.Topology, &out.Topology
+		*out = new(DRClusterTopology)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DRClusterSpec.
@@ -322,6 +479,16 @@ func (in *DRClusterStatus) DeepCopyInto(out *DRClusterStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Capabilities != nil {
+		in, out := &in.Capabilities, &out.Capabilities
+		*out = make([]ClusterCapability, len(*in))
+		copy(*out, *in)
+	}
+	if in.CIDRs != nil {
+		in, out := &in.CIDRs, &out.CIDRs
+		*out = new(DRClusterCIDRsStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DRClusterStatus.
@@ -334,6 +501,28 @@ func (in *DRClusterStatus) DeepCopy() *DRClusterStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DRClusterTopology) DeepCopyInto(out *DRClusterTopology) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DRClusterTopology.
+func (in *DRClusterTopology) DeepCopy() *DRClusterTopology {
+	if in == nil {
+		return nil
+	}
+	out := new(DRClusterTopology)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DRPlacementControl) DeepCopyInto(out *DRPlacementControl) {
 	*out = *in
@@ -418,6 +607,18 @@ func (in *DRPlacementControlSpec) DeepCopyInto(out *DRPlacementControlSpec) {
 		*out = new(VolSyncSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ReplicationMethodOverrides != nil {
+		in, out := &in.ReplicationMethodOverrides, &out.ReplicationMethodOverrides
+		*out = make([]ReplicationMethodOverride, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.SplitBrain != nil {
+		in, out := &in.SplitBrain, &out.SplitBrain
+		*out = new(SplitBrainSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DRPlacementControlSpec.
@@ -469,10 +670,20 @@ func (in *DRPlacementControlStatus) DeepCopyInto(out *DRPlacementControlStatus)
 		*out = new(int64)
 		**out = **in
 	}
+	if in.GroupSyncProgress != nil {
+		in, out := &in.GroupSyncProgress, &out.GroupSyncProgress
+		*out = new(SyncProgress)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.LastKubeObjectProtectionTime != nil {
 		in, out := &in.LastKubeObjectProtectionTime, &out.LastKubeObjectProtectionTime
 		*out = (*in).DeepCopy()
 	}
+	if in.DRPolicyMigration != nil {
+		in, out := &in.DRPolicyMigration, &out.DRPolicyMigration
+		*out = new(DRPolicyMigration)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DRPlacementControlStatus.
@@ -544,6 +755,47 @@ func (in *DRPolicyList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DRPolicyMigration) DeepCopyInto(out *DRPolicyMigration) {
+	*out = *in
+	if in.RetiringClusters != nil {
+		in, out := &in.RetiringClusters, &out.RetiringClusters
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.StartTime.DeepCopyInto(&out.StartTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DRPolicyMigration.
+func (in *DRPolicyMigration) DeepCopy() *DRPolicyMigration {
+	if in == nil {
+		return nil
+	}
+	out := new(DRPolicyMigration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DRPolicyPeer) DeepCopyInto(out *DRPolicyPeer) {
+	*out = *in
+	if in.Clusters != nil {
+		in, out := &in.Clusters, &out.Clusters
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DRPolicyPeer.
+func (in *DRPolicyPeer) DeepCopy() *DRPolicyPeer {
+	if in == nil {
+		return nil
+	}
+	out := new(DRPolicyPeer)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DRPolicySpec) DeepCopyInto(out *DRPolicySpec) {
 	*out = *in
@@ -555,6 +807,28 @@ func (in *DRPolicySpec) DeepCopyInto(out *DRPolicySpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Peers != nil {
+		in, out := &in.Peers, &out.Peers
+		*out = make([]DRPolicyPeer, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.SchedulingTiers != nil {
+		in, out := &in.SchedulingTiers, &out.SchedulingTiers
+		*out = make([]SchedulingTier, len(*in))
+		copy(*out, *in)
+	}
+	if in.StorageClassMapping != nil {
+		in, out := &in.StorageClassMapping, &out.StorageClassMapping
+		*out = make([]StorageClassMapping, len(*in))
+		copy(*out, *in)
+	}
+	if in.PlacementConstraints != nil {
+		in, out := &in.PlacementConstraints, &out.PlacementConstraints
+		*out = new(PlacementConstraints)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DRPolicySpec.
@@ -579,6 +853,13 @@ func (in *DRPolicyStatus) DeepCopyInto(out *DRPolicyStatus) {
 	}
 	in.Async.DeepCopyInto(&out.Async)
 	in.Sync.DeepCopyInto(&out.Sync)
+	if in.UnmatchedStorageClasses != nil {
+		in, out := &in.UnmatchedStorageClasses, &out.UnmatchedStorageClasses
+		*out = make([]UnmatchedStorageClass, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DRPolicyStatus.
@@ -591,6 +872,32 @@ func (in *DRPolicyStatus) DeepCopy() *DRPolicyStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FenceVerification) DeepCopyInto(out *FenceVerification) {
+	*out = *in
+	if in.CIDRs != nil {
+		in, out := &in.CIDRs, &out.CIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ActiveClients != nil {
+		in, out := &in.ActiveClients, &out.ActiveClients
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.CheckTime.DeepCopyInto(&out.CheckTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FenceVerification.
+func (in *FenceVerification) DeepCopy() *FenceVerification {
+	if in == nil {
+		return nil
+	}
+	out := new(FenceVerification)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Groups) DeepCopyInto(out *Groups) {
 	*out = *in
@@ -639,6 +946,11 @@ func (in *KubeObjectProtectionSpec) DeepCopyInto(out *KubeObjectProtectionSpec)
 		*out = new(v1.Duration)
 		**out = **in
 	}
+	if in.FullCaptureInterval != nil {
+		in, out := &in.FullCaptureInterval, &out.FullCaptureInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
 	if in.RecipeRef != nil {
 		in, out := &in.RecipeRef, &out.RecipeRef
 		*out = new(RecipeRef)
@@ -665,6 +977,30 @@ func (in *KubeObjectProtectionSpec) DeepCopyInto(out *KubeObjectProtectionSpec)
 		*out = new(v1.LabelSelector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.RecoverTransforms != nil {
+		in, out := &in.RecoverTransforms, &out.RecoverTransforms
+		*out = make([]KubeObjectTransform, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.RecoverReadiness != nil {
+		in, out := &in.RecoverReadiness, &out.RecoverReadiness
+		*out = new(KubeObjectsRecoverReadinessSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ClusterResources != nil {
+		in, out := &in.ClusterResources, &out.ClusterResources
+		*out = new(KubeObjectsClusterResourcesSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RecoverWaitFor != nil {
+		in, out := &in.RecoverWaitFor, &out.RecoverWaitFor
+		*out = make([]KubeObjectsRecoverWaitFor, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeObjectProtectionSpec.
@@ -685,6 +1021,25 @@ func (in *KubeObjectProtectionStatus) DeepCopyInto(out *KubeObjectProtectionStat
 		*out = new(KubeObjectsCaptureIdentifier)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.CaptureManifest != nil {
+		in, out := &in.CaptureManifest, &out.CaptureManifest
+		*out = new(KubeObjectsCaptureManifestSummary)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ClusterObjects != nil {
+		in, out := &in.ClusterObjects, &out.ClusterObjects
+		*out = make([]KubeObjectsClusterObjectStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.RecoverWaiting != nil {
+		in, out := &in.RecoverWaiting, &out.RecoverWaiting
+		*out = new(KubeObjectsRecoverWaitingStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RecoverStartTime != nil {
+		in, out := &in.RecoverStartTime, &out.RecoverStartTime
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeObjectProtectionStatus.
@@ -697,11 +1052,68 @@ func (in *KubeObjectProtectionStatus) DeepCopy() *KubeObjectProtectionStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeObjectReadinessRule) DeepCopyInto(out *KubeObjectReadinessRule) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeObjectReadinessRule.
+func (in *KubeObjectReadinessRule) DeepCopy() *KubeObjectReadinessRule {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeObjectReadinessRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeObjectTransform) DeepCopyInto(out *KubeObjectTransform) {
+	*out = *in
+	if in.LabelSelector != nil {
+		in, out := &in.LabelSelector, &out.LabelSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ClusterParameters != nil {
+		in, out := &in.ClusterParameters, &out.ClusterParameters
+		*out = make(map[string]map[string]string, len(*in))
+		for key, val := range *in {
+			var outVal map[string]string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				inVal := (*in)[key]
+				in, out := &inVal, &outVal
+				*out = make(map[string]string, len(*in))
+				for key, val := range *in {
+					(*out)[key] = val
+				}
+			}
+			(*out)[key] = outVal
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeObjectTransform.
+func (in *KubeObjectTransform) DeepCopy() *KubeObjectTransform {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeObjectTransform)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KubeObjectsCaptureIdentifier) DeepCopyInto(out *KubeObjectsCaptureIdentifier) {
 	*out = *in
 	in.StartTime.DeepCopyInto(&out.StartTime)
 	in.EndTime.DeepCopyInto(&out.EndTime)
+	if in.ChangesTime != nil {
+		in, out := &in.ChangesTime, &out.ChangesTime
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeObjectsCaptureIdentifier.
@@ -714,6 +1126,159 @@ func (in *KubeObjectsCaptureIdentifier) DeepCopy() *KubeObjectsCaptureIdentifier
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeObjectsCaptureManifestSummary) DeepCopyInto(out *KubeObjectsCaptureManifestSummary) {
+	*out = *in
+	if in.KindCounts != nil {
+		in, out := &in.KindCounts, &out.KindCounts
+		*out = make(map[string]int, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Removed != nil {
+		in, out := &in.Removed, &out.Removed
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeObjectsCaptureManifestSummary.
+func (in *KubeObjectsCaptureManifestSummary) DeepCopy() *KubeObjectsCaptureManifestSummary {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeObjectsCaptureManifestSummary)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeObjectsClusterObjectStatus) DeepCopyInto(out *KubeObjectsClusterObjectStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeObjectsClusterObjectStatus.
+func (in *KubeObjectsClusterObjectStatus) DeepCopy() *KubeObjectsClusterObjectStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeObjectsClusterObjectStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeObjectsClusterResourcesSpec) DeepCopyInto(out *KubeObjectsClusterResourcesSpec) {
+	*out = *in
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.LabelSelector != nil {
+		in, out := &in.LabelSelector, &out.LabelSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Referenced != nil {
+		in, out := &in.Referenced, &out.Referenced
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeObjectsClusterResourcesSpec.
+func (in *KubeObjectsClusterResourcesSpec) DeepCopy() *KubeObjectsClusterResourcesSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeObjectsClusterResourcesSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeObjectsNamespacedName) DeepCopyInto(out *KubeObjectsNamespacedName) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeObjectsNamespacedName.
+func (in *KubeObjectsNamespacedName) DeepCopy() *KubeObjectsNamespacedName {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeObjectsNamespacedName)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeObjectsRecoverReadinessSpec) DeepCopyInto(out *KubeObjectsRecoverReadinessSpec) {
+	*out = *in
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]KubeObjectReadinessRule, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeObjectsRecoverReadinessSpec.
+func (in *KubeObjectsRecoverReadinessSpec) DeepCopy() *KubeObjectsRecoverReadinessSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeObjectsRecoverReadinessSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeObjectsRecoverWaitFor) DeepCopyInto(out *KubeObjectsRecoverWaitFor) {
+	*out = *in
+	if in.Deployment != nil {
+		in, out := &in.Deployment, &out.Deployment
+		*out = new(KubeObjectsNamespacedName)
+		**out = **in
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeObjectsRecoverWaitFor.
+func (in *KubeObjectsRecoverWaitFor) DeepCopy() *KubeObjectsRecoverWaitFor {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeObjectsRecoverWaitFor)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeObjectsRecoverWaitingStatus) DeepCopyInto(out *KubeObjectsRecoverWaitingStatus) {
+	*out = *in
+	in.Since.DeepCopyInto(&out.Since)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeObjectsRecoverWaitingStatus.
+func (in *KubeObjectsRecoverWaitingStatus) DeepCopy() *KubeObjectsRecoverWaitingStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeObjectsRecoverWaitingStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MaintenanceMode) DeepCopyInto(out *MaintenanceMode) {
 	*out = *in
@@ -865,6 +1430,36 @@ func (in *PeerClass) DeepCopy() *PeerClass {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlacementConstraints) DeepCopyInto(out *PlacementConstraints) {
+	*out = *in
+	if in.DistinctTopologyKeys != nil {
+		in, out := &in.DistinctTopologyKeys, &out.DistinctTopologyKeys
+		*out = make([]TopologyKey, len(*in))
+		copy(*out, *in)
+	}
+	if in.AvoidProviders != nil {
+		in, out := &in.AvoidProviders, &out.AvoidProviders
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.TargetSelector != nil {
+		in, out := &in.TargetSelector, &out.TargetSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlacementConstraints.
+func (in *PlacementConstraints) DeepCopy() *PlacementConstraints {
+	if in == nil {
+		return nil
+	}
+	out := new(PlacementConstraints)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PlacementDecision) DeepCopyInto(out *PlacementDecision) {
 	*out = *in
@@ -935,6 +1530,11 @@ func (in *ProtectedPVC) DeepCopyInto(out *ProtectedPVC) {
 		*out = new(corev1.PersistentVolumeMode)
 		**out = **in
 	}
+	if in.SyncProgress != nil {
+		in, out := &in.SyncProgress, &out.SyncProgress
+		*out = new(SyncProgress)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProtectedPVC.
@@ -1394,6 +1994,22 @@ func (in *ReplicationGroupSourceStatus) DeepCopy() *ReplicationGroupSourceStatus
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicationMethodOverride) DeepCopyInto(out *ReplicationMethodOverride) {
+	*out = *in
+	in.Selector.DeepCopyInto(&out.Selector)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationMethodOverride.
+func (in *ReplicationMethodOverride) DeepCopy() *ReplicationMethodOverride {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicationMethodOverride)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ReplicationSourceTriggerSpec) DeepCopyInto(out *ReplicationSourceTriggerSpec) {
 	*out = *in
@@ -1460,6 +2076,60 @@ func (in *S3StoreProfile) DeepCopy() *S3StoreProfile {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SchedulingTier) DeepCopyInto(out *SchedulingTier) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SchedulingTier.
+func (in *SchedulingTier) DeepCopy() *SchedulingTier {
+	if in == nil {
+		return nil
+	}
+	out := new(SchedulingTier)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SplitBrainPVC) DeepCopyInto(out *SplitBrainPVC) {
+	*out = *in
+	in.DetectedTime.DeepCopyInto(&out.DetectedTime)
+	if in.LastSyncTime != nil {
+		in, out := &in.LastSyncTime, &out.LastSyncTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SplitBrainPVC.
+func (in *SplitBrainPVC) DeepCopy() *SplitBrainPVC {
+	if in == nil {
+		return nil
+	}
+	out := new(SplitBrainPVC)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SplitBrainSpec) DeepCopyInto(out *SplitBrainSpec) {
+	*out = *in
+	if in.AcknowledgedTime != nil {
+		in, out := &in.AcknowledgedTime, &out.AcknowledgedTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SplitBrainSpec.
+func (in *SplitBrainSpec) DeepCopy() *SplitBrainSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SplitBrainSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *StorageAccessDetail) DeepCopyInto(out *StorageAccessDetail) {
 	*out = *in
@@ -1480,6 +2150,21 @@ func (in *StorageAccessDetail) DeepCopy() *StorageAccessDetail {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StorageClassMapping) DeepCopyInto(out *StorageClassMapping) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageClassMapping.
+func (in *StorageClassMapping) DeepCopy() *StorageClassMapping {
+	if in == nil {
+		return nil
+	}
+	out := new(StorageClassMapping)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *StorageIdentifiers) DeepCopyInto(out *StorageIdentifiers) {
 	*out = *in
@@ -1519,6 +2204,54 @@ func (in *Sync) DeepCopy() *Sync {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyncProgress) DeepCopyInto(out *SyncProgress) {
+	*out = *in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.BytesTransferred != nil {
+		in, out := &in.BytesTransferred, &out.BytesTransferred
+		*out = new(int64)
+		**out = **in
+	}
+	if in.EstimatedCompletionTime != nil {
+		in, out := &in.EstimatedCompletionTime, &out.EstimatedCompletionTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyncProgress.
+func (in *SyncProgress) DeepCopy() *SyncProgress {
+	if in == nil {
+		return nil
+	}
+	out := new(SyncProgress)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UnmatchedStorageClass) DeepCopyInto(out *UnmatchedStorageClass) {
+	*out = *in
+	if in.Clusters != nil {
+		in, out := &in.Clusters, &out.Clusters
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UnmatchedStorageClass.
+func (in *UnmatchedStorageClass) DeepCopy() *UnmatchedStorageClass {
+	if in == nil {
+		return nil
+	}
+	out := new(UnmatchedStorageClass)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VRGAsyncSpec) DeepCopyInto(out *VRGAsyncSpec) {
 	*out = *in
@@ -1532,6 +2265,13 @@ func (in *VRGAsyncSpec) DeepCopyInto(out *VRGAsyncSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.StorageClassMapping != nil {
+		in, out := &in.StorageClassMapping, &out.StorageClassMapping
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VRGAsyncSpec.
@@ -1582,6 +2322,13 @@ func (in *VRGResourceMeta) DeepCopyInto(out *VRGResourceMeta) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.ReplicationMethods != nil {
+		in, out := &in.ReplicationMethods, &out.ReplicationMethods
+		*out = make(map[string]ReplicationMethod, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VRGResourceMeta.
@@ -1813,6 +2560,18 @@ func (in *VolumeReplicationGroupSpec) DeepCopyInto(out *VolumeReplicationGroupSp
 			copy(*out, *in)
 		}
 	}
+	if in.ReplicationMethodOverrides != nil {
+		in, out := &in.ReplicationMethodOverrides, &out.ReplicationMethodOverrides
+		*out = make([]ReplicationMethodOverride, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.SplitBrain != nil {
+		in, out := &in.SplitBrain, &out.SplitBrain
+		*out = new(SplitBrainSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeReplicationGroupSpec.
@@ -1872,6 +2631,18 @@ func (in *VolumeReplicationGroupStatus) DeepCopyInto(out *VolumeReplicationGroup
 		*out = new(int64)
 		**out = **in
 	}
+	if in.GroupSyncProgress != nil {
+		in, out := &in.GroupSyncProgress, &out.GroupSyncProgress
+		*out = new(SyncProgress)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SplitBrainPVCs != nil {
+		in, out := &in.SplitBrainPVCs, &out.SplitBrainPVCs
+		*out = make([]SplitBrainPVC, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeReplicationGroupStatus.
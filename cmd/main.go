@@ -239,6 +239,7 @@ func setupReconcilersHub(mgr ctrl.Manager) {
 			APIReader: mgr.GetAPIReader(),
 		},
 		ObjectStoreGetter: controllers.S3ObjectStoreGetter(),
+		FencingProviders:  controllers.DefaultFencingProviders(),
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "DRCluster")
 		os.Exit(1)
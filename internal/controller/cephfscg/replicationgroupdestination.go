@@ -183,7 +183,7 @@ func (m *rgdMachine) SetOutOfSync(bool)                     {}
 func (m *rgdMachine) IncMissedIntervals()                   {}
 func (m *rgdMachine) ObserveSyncDuration(dur time.Duration) {}
 
-//nolint:cyclop
+//nolint:cyclop,funlen
 func (m *rgdMachine) ReconcileRD(
 	rdSpec ramendrv1alpha1.VolSyncReplicationDestinationSpec, manual string,
 ) (*volsyncv1alpha1.ReplicationDestination, error,
@@ -225,6 +225,19 @@ func (m *rgdMachine) ReconcileRD(
 		}
 	}
 
+	resized, err := m.VSHandler.EnsureDestPVCResized(rdSpec, rd, dstPVC)
+	if err != nil {
+		log.Error(err, "Failed to EnsureDestPVCResized", "RD", rd.GetName())
+
+		return nil, err
+	}
+
+	if !resized {
+		log.Info("Waiting for the destination PVC to be resized to the capacity of the protected PVC")
+
+		return nil, nil
+	}
+
 	if !volsync.RDStatusReady(rd, m.Logger) {
 		return nil, nil
 	}
@@ -262,7 +275,7 @@ func (m *rgdMachine) CreateReplicationDestinations(
 	pskSecretName string, dstPVC *string, manual string,
 ) (*volsyncv1alpha1.ReplicationDestination, error) {
 	volumeSnapshotClassName, err := m.VSHandler.GetVolumeSnapshotClassFromPVCStorageClass(
-		rdSpec.ProtectedPVC.StorageClassName)
+		m.VSHandler.DestinationStorageClassName(rdSpec.ProtectedPVC.StorageClassName))
 	if err != nil {
 		m.Logger.Error(err, "Failed to get VolumeSnapshotClass from PVC StorageClass", "PVCName", rdSpec.ProtectedPVC.Name)
 
@@ -303,7 +316,7 @@ func (m *rgdMachine) CreateReplicationDestinations(
 				ReplicationDestinationVolumeOptions: volsyncv1alpha1.ReplicationDestinationVolumeOptions{
 					CopyMethod:              volsyncv1alpha1.CopyMethodSnapshot,
 					Capacity:                rdSpec.ProtectedPVC.Resources.Requests.Storage(),
-					StorageClassName:        rdSpec.ProtectedPVC.StorageClassName,
+					StorageClassName:        m.VSHandler.DestinationStorageClassName(rdSpec.ProtectedPVC.StorageClassName),
 					AccessModes:             pvcAccessModes,
 					VolumeSnapshotClassName: &volumeSnapshotClassName,
 					DestinationPVC:          dstPVC,
@@ -65,8 +65,9 @@ type VolumeGroupSourceHandler interface {
 		ctx context.Context,
 	) (bool, error)
 
-	// EnsureApplicationPVCsMounted ensures all application PVCs (for this owner/RGS) that
-	// need to be mounted are mounted (e.g. via mount job) before creating a volume group snapshot.
+	// EnsureApplicationPVCsMounted ensures all application PVCs (for this owner/RGS) are ready for a
+	// volume group snapshot, as per the UnmountedPVCMode of the owning VRG: unmounted PVCs are mounted
+	// (e.g. via mount job), or in SnapshotOnly mode, are checked to be bound instead.
 	// Returns (true, nil) when all are ready, (false, nil) to requeue, or (_, err) on error.
 	EnsureApplicationPVCsMounted(ctx context.Context) (bool, error)
 }
@@ -709,7 +710,7 @@ func (h *volumeGroupSourceHandler) EnsureApplicationPVCsMounted(
 			},
 		}
 
-		ready, mountErr := h.VSHandler.EnsureMountJobForUnmountedPVC(&rsSpec)
+		ready, mountErr := h.VSHandler.EnsureUnmountedPVCReady(&rsSpec)
 		if mountErr != nil {
 			h.Logger.Error(mountErr, "Failed to ensure application PVC is mounted", "pvc", pvc.Namespace+"/"+pvc.Name)
 
@@ -12,6 +12,7 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	vgsv1beta1 "github.com/red-hat-storage/external-snapshotter/client/v8/apis/volumegroupsnapshot/v1beta1"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	storagev1 "k8s.io/api/storage/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
@@ -208,6 +209,51 @@ var _ = Describe("Volumegroupsourcehandler", func() {
 		})
 	})
 
+	Describe("EnsureApplicationPVCsMounted", func() {
+		It("Should not create mount jobs in SnapshotOnly mode", func() {
+			cgPVCName := "cg-apppvc"
+			cgLabel := map[string]string{util.ConsistencyGroupLabel: "cg1"}
+
+			pvc := &corev1.PersistentVolumeClaim{
+				ObjectMeta: metav1.ObjectMeta{Name: cgPVCName, Namespace: "default", Labels: cgLabel},
+				Spec: corev1.PersistentVolumeClaimSpec{
+					StorageClassName: &scName,
+					AccessModes:      []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+					Resources: corev1.VolumeResourceRequirements{
+						Requests: map[corev1.ResourceName]resource.Quantity{
+							corev1.ResourceStorage: *resource.NewQuantity(1, resource.BinarySI),
+						},
+					},
+				},
+			}
+			Expect(client.IgnoreAlreadyExists(k8sClient.Create(context.TODO(), pvc))).To(Succeed())
+
+			vrg := &v1alpha1.VolumeReplicationGroup{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: vrgName, UID: "123"},
+				Spec: v1alpha1.VolumeReplicationGroupSpec{
+					VolSync: v1alpha1.VolSyncSpec{UnmountedPVCMode: v1alpha1.UnmountedPVCModeSnapshotOnly},
+				},
+			}
+			vsHandler := volsync.NewVSHandler(context.Background(), k8sClient, testLogger, vrg,
+				&v1alpha1.VRGAsyncSpec{}, internalController.DefaultCephFSCSIDriverName, "Snapshot", false)
+
+			handler := cephfscg.NewVolumeGroupSourceHandler(k8sClient,
+				GenerateReplicationGroupSource(vgsName, vgscName, cgLabel),
+				internalController.DefaultCephFSCSIDriverName, vsHandler, testLogger)
+
+			// The PVC is unbound in the test environment, and its StorageClass binds volumes immediately
+			ready, err := handler.EnsureApplicationPVCsMounted(context.TODO())
+			Expect(err).To(BeNil())
+			Expect(ready).To(BeFalse())
+
+			job := &batchv1.Job{}
+			err = k8sClient.Get(context.TODO(), types.NamespacedName{
+				Name:      util.GetJobName(volsync.VolSyncMountJobNamePrefix, cgPVCName),
+				Namespace: "default",
+			}, job)
+			Expect(k8serrors.IsNotFound(err)).To(BeTrue())
+		})
+	})
 	Describe("CheckReplicationSourceForRestoredPVCsCompleted", func() {
 		It("Should be successful", func() {
 			completed, err := volumeGroupSourceHandler.CheckReplicationSourceForRestoredPVCsCompleted(
@@ -101,6 +101,7 @@ func updateDRClusterParameters(drc *ramen.DRCluster) *ramen.DRCluster {
 		latestdrc.Spec.ClusterFence = drc.Spec.ClusterFence
 		latestdrc.Spec.S3ProfileName = drc.Spec.S3ProfileName
 		latestdrc.Spec.CIDRs = drc.Spec.CIDRs
+		latestdrc.Spec.Fencing = drc.Spec.Fencing
 
 		return k8sClient.Update(context.TODO(), latestdrc)
 	})
@@ -0,0 +1,53 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+
+	ramen "github.com/ramendr/ramen/api/v1alpha1"
+)
+
+// capabilitiesUpdate reports the capabilities of the cluster as its DRClusterConfig reports them
+func (u *drclusterInstance) capabilitiesUpdate() error {
+	drcConfig, err := u.getDRCCFromCluster(u.object)
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			// Watch on MCV triggers reconcile when DRClusterConfig is created
+			return nil
+		}
+
+		return fmt.Errorf("failed to get DRClusterConfig of cluster %s: %w", u.object.Name, err)
+	}
+
+	u.object.Status.Capabilities = drcConfig.Status.Capabilities
+
+	return nil
+}
+
+// failoverBlockingCapabilities returns the capabilities of the cluster that block failover to it, i.e. those that
+// are Unhealthy and block failover, as a message. The free capacity of a StorageClass blocks failover only if it is
+// one of the StorageClasses of the PVCs to fail over.
+func failoverBlockingCapabilities(drCluster *ramen.DRCluster, storageClassNames []string) string {
+	blocking := []string{}
+
+	for _, capability := range drCluster.Status.Capabilities {
+		if !capability.BlocksFailover || capability.Status != ramen.ClusterCapabilityUnhealthy {
+			continue
+		}
+
+		if storageClassName, ok := strings.CutPrefix(capability.Name, capabilityNameStorageClassFreeCapacity); ok &&
+			!slices.Contains(storageClassNames, storageClassName) {
+			continue
+		}
+
+		blocking = append(blocking, fmt.Sprintf("%s (%s)", capability.Name, capability.Message))
+	}
+
+	return strings.Join(blocking, ", ")
+}
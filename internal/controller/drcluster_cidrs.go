@@ -0,0 +1,127 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"fmt"
+	"net"
+	"reflect"
+	"slices"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	ramen "github.com/ramendr/ramen/api/v1alpha1"
+)
+
+// cidrsDiscover reports, if cidrsDiscovery is set, the CIDRs of the cluster's node addresses and storage clients,
+// as its DRClusterConfig reports them, the effective CIDRs to fence and their drift from spec
+func (u *drclusterInstance) cidrsDiscover() error {
+	if u.object.Spec.CIDRsDiscovery == nil {
+		u.object.Status.CIDRs = nil
+
+		return nil
+	}
+
+	drcConfig, err := u.getDRCCFromCluster(u.object)
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			// Watch on MCV triggers reconcile when DRClusterConfig is created
+			return nil
+		}
+
+		return fmt.Errorf("failed to get DRClusterConfig of cluster %s: %w", u.object.Name, err)
+	}
+
+	discovered := slices.Clone(drcConfig.Status.NodeCIDRs)
+	for _, storageAccessDetail := range drcConfig.Status.StorageAccessDetails {
+		discovered = append(discovered, storageAccessDetail.CIDRs...)
+	}
+
+	status := cidrsDrift(u.object.Spec.CIDRs, discovered)
+
+	if previous := u.object.Status.CIDRs; previous != nil {
+		status.LastUpdateTime = previous.LastUpdateTime
+		if reflect.DeepEqual(*previous, status) {
+			return nil
+		}
+	}
+
+	if len(status.Undeclared) > 0 {
+		u.log.Info("Discovered CIDRs not covered by spec", "cidrs", status.Undeclared)
+	}
+
+	now := metav1.Now()
+	status.LastUpdateTime = &now
+	u.object.Status.CIDRs = &status
+
+	return nil
+}
+
+// cidrsDrift returns the sorted discovered CIDRs, the effective CIDRs to fence, i.e. the specified ones followed by
+// the discovered ones they do not cover, and the drift between the specified and discovered ones
+func cidrsDrift(specified, discovered []string) ramen.DRClusterCIDRsStatus {
+	discovered = slices.Clone(discovered)
+	slices.Sort(discovered)
+	discovered = slices.Compact(discovered)
+
+	status := ramen.DRClusterCIDRsStatus{
+		Discovered: discovered,
+		Effective:  slices.Clone(specified),
+	}
+
+	for _, cidr := range discovered {
+		if !slices.ContainsFunc(specified, func(specifiedCIDR string) bool { return cidrCovers(specifiedCIDR, cidr) }) {
+			status.Undeclared = append(status.Undeclared, cidr)
+			status.Effective = append(status.Effective, cidr)
+		}
+	}
+
+	for _, cidr := range specified {
+		if !slices.ContainsFunc(discovered, func(discoveredCIDR string) bool { return cidrCovers(cidr, discoveredCIDR) }) {
+			status.Undiscovered = append(status.Undiscovered, cidr)
+		}
+	}
+
+	return status
+}
+
+// cidrCovers returns whether CIDR a covers CIDR b, i.e. whether b's addresses are all a's addresses too
+func cidrCovers(a, b string) bool {
+	_, aNet, err := net.ParseCIDR(a)
+	if err != nil {
+		return false
+	}
+
+	bIP, bNet, err := net.ParseCIDR(b)
+	if err != nil {
+		return false
+	}
+
+	aOnes, aBits := aNet.Mask.Size()
+	bOnes, bBits := bNet.Mask.Size()
+
+	return aBits == bBits && aOnes <= bOnes && aNet.Contains(bIP)
+}
+
+// fencingCIDRs returns the CIDRs to fence the cluster: its effective ones if it reconciles them, its specified ones
+// otherwise
+func fencingCIDRs(drCluster *ramen.DRCluster) []string {
+	if drCluster.Spec.CIDRsDiscovery == nil || !drCluster.Spec.CIDRsDiscovery.ReconcileFence ||
+		drCluster.Status.CIDRs == nil {
+		return drCluster.Spec.CIDRs
+	}
+
+	return drCluster.Status.CIDRs.Effective
+}
+
+// fenceCIDRsReconcile updates the NetworkFence of a fenced cluster, if it reconciles its fencing CIDRs, e.g. as nodes
+// scale out
+func (u *drclusterInstance) fenceCIDRsReconcile(peerCluster *ramen.DRCluster, networkFenceClassName string) error {
+	if u.object.Spec.CIDRsDiscovery == nil || !u.object.Spec.CIDRsDiscovery.ReconcileFence {
+		return nil
+	}
+
+	return u.createNFManifestWork(u.object, peerCluster, u.log, networkFenceClassName)
+}
@@ -0,0 +1,57 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	ramen "github.com/ramendr/ramen/api/v1alpha1"
+)
+
+var _ = Describe("CIDRs discovery", func() {
+	It("represents node addresses as single address CIDRs", func() {
+		Expect(addressCIDR("10.0.0.1")).To(Equal("10.0.0.1/32"))
+		Expect(addressCIDR("fd00::1")).To(Equal("fd00::1/128"))
+
+		_, err := addressCIDR("node-1")
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("covers CIDRs within a CIDR of the same address family", func() {
+		Expect(cidrCovers("10.0.0.0/24", "10.0.0.11/32")).To(BeTrue())
+		Expect(cidrCovers("10.0.0.0/24", "10.0.0.0/24")).To(BeTrue())
+		Expect(cidrCovers("10.0.0.0/24", "10.0.0.0/16")).To(BeFalse())
+		Expect(cidrCovers("10.0.0.0/24", "10.0.1.11/32")).To(BeFalse())
+		Expect(cidrCovers("::/0", "10.0.0.11/32")).To(BeFalse())
+		Expect(cidrCovers("invalid", "10.0.0.11/32")).To(BeFalse())
+	})
+
+	It("reports the discovered CIDRs that spec does not cover and the spec CIDRs that cover none", func() {
+		status := cidrsDrift(
+			[]string{"10.0.0.0/24", "10.0.9.0/24"},
+			[]string{"10.0.1.11/32", "10.0.0.11/32", "192.168.0.0/22", "10.0.0.11/32"},
+		)
+		Expect(status.Discovered).To(Equal([]string{"10.0.0.11/32", "10.0.1.11/32", "192.168.0.0/22"}))
+		Expect(status.Undeclared).To(Equal([]string{"10.0.1.11/32", "192.168.0.0/22"}))
+		Expect(status.Undiscovered).To(Equal([]string{"10.0.9.0/24"}))
+		Expect(status.Effective).To(Equal([]string{"10.0.0.0/24", "10.0.9.0/24", "10.0.1.11/32", "192.168.0.0/22"}))
+	})
+
+	It("fences the effective CIDRs only if reconciling them", func() {
+		drCluster := &ramen.DRCluster{
+			Spec: ramen.DRClusterSpec{CIDRs: []string{"10.0.0.0/24"}},
+			Status: ramen.DRClusterStatus{
+				CIDRs: &ramen.DRClusterCIDRsStatus{Effective: []string{"10.0.0.0/24", "10.0.1.11/32"}},
+			},
+		}
+		Expect(fencingCIDRs(drCluster)).To(Equal([]string{"10.0.0.0/24"}))
+
+		drCluster.Spec.CIDRsDiscovery = &ramen.DRClusterCIDRsDiscoverySpec{}
+		Expect(fencingCIDRs(drCluster)).To(Equal([]string{"10.0.0.0/24"}))
+
+		drCluster.Spec.CIDRsDiscovery.ReconcileFence = true
+		Expect(fencingCIDRs(drCluster)).To(Equal([]string{"10.0.0.0/24", "10.0.1.11/32"}))
+	})
+})
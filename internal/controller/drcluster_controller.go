@@ -46,6 +46,10 @@ type DRClusterReconciler struct {
 	MCVGetter         util.ManagedClusterViewGetter
 	ObjectStoreGetter ObjectStoreGetter
 	RateLimiter       *workqueue.TypedRateLimiter[reconcile.Request]
+
+	// FencingProviders are the fencing providers that DRClusters may select, by name, in addition to NetworkFence,
+	// which may be overridden
+	FencingProviders map[string]FencingProvider
 }
 
 // DRCluster condition reasons
@@ -221,6 +225,14 @@ func drpcPred() predicate.Funcs {
 func DRPCUpdateOfInterest(oldDRPC, newDRPC *ramen.DRPlacementControl) bool {
 	log := ctrl.Log.WithName("Predicate").WithName("DRPC")
 
+	// Process DRPC, if the relocate or resync maintenance mode it requires of its peer clusters changed
+	oldMode, _ := drpcMModeRequired(oldDRPC, "")
+	newMode, _ := drpcMModeRequired(newDRPC, "")
+
+	if oldMode != newMode {
+		return true
+	}
+
 	// Ignore DRPC if it is not failing over
 	if newDRPC.Spec.Action != ramen.ActionFailover {
 		return false
@@ -263,20 +275,25 @@ func DRPCUpdateOfInterest(oldDRPC, newDRPC *ramen.DRPlacementControl) bool {
 	return true
 }
 
-// filterDRPC relies on the predicate DRPCIpdateOfInterest to filter out any DRPC other than ones failing over, as a
-// result the filter function just uses the failoverCluster value to start the appropriate DRCluster reconcile
+// filterDRPC relies on the predicate DRPCIpdateOfInterest to filter out any DRPC other than ones failing over,
+// relocating or cleaning up, as a result the filter function just uses the failoverCluster and preferredCluster
+// values to start the appropriate DRCluster reconciles
 func filterDRPC(drpc *ramen.DRPlacementControl) []ctrl.Request {
-	if drpc.Spec.FailoverCluster == "" {
-		return []ctrl.Request{}
-	}
+	requests := []ctrl.Request{}
 
-	return []ctrl.Request{
-		reconcile.Request{
+	for _, cluster := range []string{drpc.Spec.FailoverCluster, drpc.Spec.PreferredCluster} {
+		if cluster == "" || (len(requests) != 0 && requests[0].Name == cluster) {
+			continue
+		}
+
+		requests = append(requests, reconcile.Request{
 			NamespacedName: types.NamespacedName{
-				Name: drpc.Spec.FailoverCluster,
+				Name: cluster,
 			},
-		},
+		})
 	}
+
+	return requests
 }
 
 func filterDRClusterMW(mw *ocmworkv1.ManifestWork) []ctrl.Request {
@@ -447,6 +464,18 @@ func (r DRClusterReconciler) processCreateOrUpdate(u *drclusterInstance) (ctrl.R
 			u.validatedSetFalseAndUpdate(ReasonValidationFailed, err))
 	}
 
+	if err := u.cidrsDiscover(); err != nil {
+		u.requeue = true
+
+		u.log.Info("Error during discovering CIDRs", "error", err)
+	}
+
+	if err := u.capabilitiesUpdate(); err != nil {
+		u.requeue = true
+
+		u.log.Info("Error during updating capabilities", "error", err)
+	}
+
 	requeue, err = u.clusterFenceHandle()
 	if err != nil {
 		u.log.Info("Error during processing fencing", "error", err)
@@ -632,8 +661,10 @@ func (r DRClusterReconciler) processDeletion(u *drclusterInstance) (ctrl.Result,
 		return ctrl.Result{}, fmt.Errorf("drclusters undeploy: %w", err)
 	}
 
-	if u.object.Spec.ClusterFence == ramen.ClusterFenceStateFenced ||
-		u.object.Spec.ClusterFence == ramen.ClusterFenceStateUnfenced {
+	// only NetworkFence fencing leaves resources on the peer cluster to clean up, whichever provider is selected since
+	if (u.object.Spec.ClusterFence == ramen.ClusterFenceStateFenced ||
+		u.object.Spec.ClusterFence == ramen.ClusterFenceStateUnfenced) &&
+		u.fencedProviderName() == ramen.FencingProviderNetworkFence {
 		requeue, err := u.handleDeletion()
 		if err != nil {
 			return ctrl.Result{}, fmt.Errorf("cleanup update: %w", err)
@@ -760,7 +791,8 @@ func (u *drclusterInstance) generateDRClusterConfig() (*ramen.DRClusterConfig, e
 			Name: u.object.GetName(),
 		},
 		Spec: ramen.DRClusterConfigSpec{
-			ClusterID: clusterID,
+			ClusterID:             clusterID,
+			DiscoverNodeAddresses: u.object.Spec.CIDRsDiscovery != nil,
 		},
 	}
 
@@ -780,26 +812,37 @@ func (u *drclusterInstance) generateDRClusterConfig() (*ramen.DRClusterConfig, e
 			continue
 		}
 
-		if drpolicies.Items[idx].Spec.SchedulingInterval == "" {
-			continue
-		}
+		for _, schedulingInterval := range drClusterConfigSchedulingIntervals(&drpolicies.Items[idx],
+			u.object.GetName()) {
+			if exists, ok := added[schedulingInterval]; !ok || !exists {
+				drcConfig.Spec.ReplicationSchedules = append(drcConfig.Spec.ReplicationSchedules, schedulingInterval)
 
-		if !util.DrpolicyContainsDrcluster(&drpolicies.Items[idx], u.object.GetName()) {
-			continue
+				added[schedulingInterval] = true
+
+				u.log.Info(fmt.Sprintf("added %s", schedulingInterval))
+			}
 		}
+	}
 
-		if exists, ok := added[drpolicies.Items[idx].Spec.SchedulingInterval]; !ok || !exists {
-			drcConfig.Spec.ReplicationSchedules = append(
-				drcConfig.Spec.ReplicationSchedules,
-				drpolicies.Items[idx].Spec.SchedulingInterval)
+	return &drcConfig, nil
+}
 
-			added[drpolicies.Items[idx].Spec.SchedulingInterval] = true
+// drClusterConfigSchedulingIntervals returns the scheduling intervals of the passed in policy that the cluster
+// replicates at, which are the intervals across its peers and, if it has async peers, the intervals of the policy
+// scheduling tiers
+func drClusterConfigSchedulingIntervals(drpolicy *ramen.DRPolicy, cluster string) []string {
+	schedulingIntervals := util.DRPolicyClusterSchedulingIntervals(drpolicy, cluster)
+	if len(schedulingIntervals) == 0 {
+		return schedulingIntervals
+	}
 
-			u.log.Info(fmt.Sprintf("added %s", drpolicies.Items[idx].Spec.SchedulingInterval))
+	for _, tier := range drpolicy.Spec.SchedulingTiers {
+		if !slices.Contains(schedulingIntervals, tier.SchedulingInterval) {
+			schedulingIntervals = append(schedulingIntervals, tier.SchedulingInterval)
 		}
 	}
 
-	return &drcConfig, nil
+	return schedulingIntervals
 }
 
 // TODO:
@@ -815,9 +858,13 @@ func (u *drclusterInstance) generateDRClusterConfig() (*ramen.DRClusterConfig, e
 //
 // 3) Handle Ramen driven fencing here
 func (u *drclusterInstance) clusterFenceHandle() (bool, error) {
+	if u.object.Spec.ClusterFence != ramen.ClusterFenceStateFenced {
+		u.fenceVerifiedConditionRemove()
+	}
+
 	switch u.object.Spec.ClusterFence {
 	case ramen.ClusterFenceStateUnfenced:
-		return u.clusterUnfence()
+		return u.clusterUnfenceByProvider()
 
 	case ramen.ClusterFenceStateManuallyFenced:
 		setDRClusterFencedCondition(&u.object.Status.Conditions, u.object.Generation, "Cluster Manually fenced")
@@ -833,7 +880,12 @@ func (u *drclusterInstance) clusterFenceHandle() (bool, error) {
 		return false, nil
 
 	case ramen.ClusterFenceStateFenced:
-		return u.clusterFence()
+		requeue, err := u.clusterFenceByProvider()
+		if requeue || err != nil {
+			return requeue, err
+		}
+
+		return u.clusterFenceVerify()
 
 	default:
 		// This is needed when a DRCluster is created fresh without any fencing related information.
@@ -1096,6 +1148,11 @@ func (u *drclusterInstance) fenceClusterOnCluster(peerCluster *ramen.DRCluster,
 		return true, nil
 	}
 
+	if err := u.fenceCIDRsReconcile(peerCluster, networkFenceClassName); err != nil {
+		return true, fmt.Errorf("failed to reconcile the NetworkFence CIDRs on cluster %s to fence %s: %w",
+			peerCluster.Name, u.object.Name, err)
+	}
+
 	annotations := make(map[string]string)
 	annotations[DRClusterNameAnnotation] = u.object.Name
 
@@ -1328,6 +1385,12 @@ func getPeerFromPolicy(ctx context.Context, reconciler *DRClusterReconciler, log
 			continue
 		}
 
+		// skip peers that are declared to replicate asynchronously with this cluster
+		if peer := util.FindDRPolicyPeer(drPolicy.Spec.Peers, drCluster.Name, cluster); peer != nil &&
+			peer.ReplicationType == ramen.DRPolicyReplicationAsync {
+			continue
+		}
+
 		// search for the drCluster object for the peer cluster in the
 		// same namespace as this cluster
 		if err := reconciler.APIReader.Get(ctx,
@@ -1668,12 +1731,13 @@ func fillStorageDetails(cluster *ramen.DRCluster, nf *csiaddonsv1alpha1.NetworkF
 
 // generateNF creates a NetworkFence resource for the target cluster. When a NetworkFenceClassName
 // is provided, it's included in the resource; otherwise, it falls back to filling storage details directly.
-// The resource includes CIDRs and fence state from the DRCluster specification.
+// The resource includes the CIDRs to fence, see fencingCIDRs, and fence state from the DRCluster specification.
 // Resource naming pattern:
 //   - Without NetworkFenceClass: "network-fence-" + cluster name
 //   - With NetworkFenceClass: "network-fence-" + NFClass name + "-" + cluster name
 func generateNF(targetCluster *ramen.DRCluster, networkFenceClassName string) (csiaddonsv1alpha1.NetworkFence, error) {
-	if len(targetCluster.Spec.CIDRs) == 0 {
+	cidrs := fencingCIDRs(targetCluster)
+	if len(cidrs) == 0 {
 		return csiaddonsv1alpha1.NetworkFence{}, fmt.Errorf("CIDRs has no values")
 	}
 
@@ -1684,7 +1748,7 @@ func generateNF(targetCluster *ramen.DRCluster, networkFenceClassName string) (c
 		ObjectMeta: metav1.ObjectMeta{Name: resourceName},
 		Spec: csiaddonsv1alpha1.NetworkFenceSpec{
 			FenceState: csiaddonsv1alpha1.FenceState(targetCluster.Spec.ClusterFence),
-			Cidrs:      targetCluster.Spec.CIDRs,
+			Cidrs:      cidrs,
 		},
 	}
 	util.AddLabel(&nf, util.CreatedByRamenLabel, "true")
@@ -5,6 +5,7 @@ package controllers_test
 
 import (
 	"context"
+	"fmt"
 	"strings"
 
 	csiaddonsv1alpha1 "github.com/csi-addons/kubernetes-csi-addons/api/csiaddons/v1alpha1"
@@ -200,6 +201,45 @@ func inspectClusterManifestSubscriptionCSV(match bool, value string, drcluster *
 	}
 }
 
+const fakeFencingProviderName = "Fake"
+
+// fakeFencingProvider fences and unfences a cluster on the reconcile after the one that starts to, unless its
+// fencing parameters request it to fail
+type fakeFencingProvider struct{}
+
+func (fakeFencingProvider) Fence(fencing *controllers.Fencing) (bool, error) {
+	return fakeFencingOperate(fencing, "fence")
+}
+
+func (fakeFencingProvider) Unfence(fencing *controllers.Fencing) (bool, error) {
+	requeue, err := fakeFencingOperate(fencing, "unfence")
+	if requeue || err != nil {
+		return requeue, err
+	}
+
+	fencing.Cleaned("fake unfence cleaned")
+
+	return false, nil
+}
+
+func fakeFencingOperate(fencing *controllers.Fencing, operation string) (bool, error) {
+	if fencing.Parameters["fail"] == "true" {
+		fencing.Failed("fake " + operation + " failed")
+
+		return true, fmt.Errorf("fake %s failed", operation)
+	}
+
+	if !fencing.Started() {
+		fencing.Progressing("fake " + operation + " started")
+
+		return true, nil
+	}
+
+	fencing.Succeeded("fake " + operation + " succeeded")
+
+	return false, nil
+}
+
 var _ = Describe("DRClusterController", func() {
 	drclusterDelete := func(drcluster *ramen.DRCluster) {
 		clusterName := drcluster.Name
@@ -743,9 +783,75 @@ var _ = Describe("DRClusterController", func() {
 				)
 			})
 		})
+		When("provided Fencing value is Fenced with a fencing provider that fails", func() {
+			It("reports fence error", func() {
+				drcluster.Spec.ClusterFence = ramen.ClusterFenceStateFenced
+				drcluster.Spec.Fencing = &ramen.DRClusterFencingSpec{
+					Provider:   fakeFencingProviderName,
+					Parameters: map[string]string{"fail": "true"},
+				}
+				drcluster = updateDRClusterParameters(drcluster)
+				objectConditionExpectEventually(
+					apiReader,
+					drcluster,
+					metav1.ConditionFalse,
+					Equal(controllers.DRClusterConditionReasonFenceError),
+					Equal("fake fence failed"),
+					ramen.DRClusterConditionTypeFenced,
+					false,
+				)
+			})
+		})
+		When("provided Fencing value is Fenced with a fencing provider", func() {
+			It("reports fenced by the fencing provider", func() {
+				drcluster.Spec.Fencing.Parameters = nil
+				drcluster = updateDRClusterParameters(drcluster)
+				objectConditionExpectEventually(
+					apiReader,
+					drcluster,
+					metav1.ConditionTrue,
+					Equal(controllers.DRClusterConditionReasonFenced),
+					Equal("fake fence succeeded"),
+					ramen.DRClusterConditionTypeFenced,
+					false,
+				)
+			})
+		})
+		When("provided Fencing value is Unfenced with a fencing provider", func() {
+			It("reports unfenced and clean by the fencing provider", func() {
+				drcluster.Spec.ClusterFence = ramen.ClusterFenceStateUnfenced
+				drcluster = updateDRClusterParameters(drcluster)
+				objectConditionExpectEventually(
+					apiReader,
+					drcluster,
+					metav1.ConditionFalse,
+					Equal(controllers.DRClusterConditionReasonClean),
+					Equal("fake unfence cleaned"),
+					ramen.DRClusterConditionTypeFenced,
+					false,
+				)
+			})
+		})
+		When("provided Fencing value is Fenced with a fencing provider not registered", func() {
+			It("reports fence error", func() {
+				drcluster.Spec.ClusterFence = ramen.ClusterFenceStateFenced
+				drcluster.Spec.Fencing.Provider = "Unregistered"
+				drcluster = updateDRClusterParameters(drcluster)
+				objectConditionExpectEventually(
+					apiReader,
+					drcluster,
+					metav1.ConditionFalse,
+					Equal(controllers.DRClusterConditionReasonFenceError),
+					Equal("fencing provider Unregistered not registered"),
+					ramen.DRClusterConditionTypeFenced,
+					false,
+				)
+			})
+		})
 		When("provided Fencing value is empty", func() {
 			It("reports validated with status fencing as Unfenced", func() {
 				drcluster.Spec.ClusterFence = ""
+				drcluster.Spec.Fencing = nil
 				drcluster = updateDRClusterParameters(drcluster)
 				objectConditionExpectEventually(
 					apiReader,
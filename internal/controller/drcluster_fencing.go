@@ -0,0 +1,215 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ramen "github.com/ramendr/ramen/api/v1alpha1"
+)
+
+// FencingProvider fences a DRCluster, e.g. off its storage or by powering off its nodes, and unfences it. Its
+// methods are called on each reconcile of the DRCluster until they no longer request a requeue, and report the
+// progress of the operation in the DRCluster's Fenced and Clean conditions through the Fencing passed to them.
+type FencingProvider interface {
+	// Fence starts fencing the cluster, unless started already, or checks whether it is fenced. It returns whether
+	// to requeue, until the cluster is fenced.
+	Fence(fencing *Fencing) (bool, error)
+
+	// Unfence starts unfencing the cluster, unless started already, or checks whether it is unfenced, and then
+	// removes what fencing left behind. It returns whether to requeue, until the cluster is unfenced and clean.
+	Unfence(fencing *Fencing) (bool, error)
+}
+
+// FenceVerifyingProvider is a FencingProvider that verifies by itself that a cluster it fenced can no longer write,
+// e.g. as it confirmed that the cluster's nodes are powered off. Fencing of clusters fenced by other providers is
+// verified by the reports of the storage of their peer cluster.
+type FenceVerifyingProvider interface {
+	FencingProvider
+
+	// VerifyFence returns whether the fenced cluster can no longer write, and why, or why not
+	VerifyFence(fencing *Fencing) (bool, string, error)
+}
+
+// Fencing is a fence or unfence operation of a DRCluster by a fencing provider
+type Fencing struct {
+	Context context.Context
+	Log     logr.Logger
+
+	// Client of the hub cluster
+	Client client.Client
+
+	// Cluster to fence or unfence
+	Cluster *ramen.DRCluster
+
+	// Parameters of the fencing provider, from the cluster's fencing spec
+	Parameters map[string]string
+
+	instance *drclusterInstance
+	unfence  bool
+}
+
+// Started returns whether the operation was started already, i.e. whether the cluster is being fenced or is fenced
+// when fencing, or is being unfenced or is unfenced when unfencing
+func (f *Fencing) Started() bool {
+	if f.unfence {
+		return f.instance.isUnfencingOrUnfenced()
+	}
+
+	return f.instance.isFencingOrFenced()
+}
+
+// Progressing reports that the operation was started, and is not complete yet
+func (f *Fencing) Progressing(message string) {
+	u := f.instance
+
+	if f.unfence {
+		setDRClusterUnfencingCondition(&u.object.Status.Conditions, u.object.Generation, message)
+		u.setDRClusterPhase(ramen.Unfencing)
+
+		return
+	}
+
+	setDRClusterFencingCondition(&u.object.Status.Conditions, u.object.Generation, message)
+	u.setDRClusterPhase(ramen.Fencing)
+}
+
+// Succeeded reports that the cluster is fenced, or unfenced
+func (f *Fencing) Succeeded(message string) {
+	u := f.instance
+
+	if f.unfence {
+		setDRClusterUnfencedCondition(&u.object.Status.Conditions, u.object.Generation, message)
+	} else {
+		setDRClusterFencedCondition(&u.object.Status.Conditions, u.object.Generation, message)
+	}
+
+	u.advanceToNextPhase()
+}
+
+// Failed reports that the operation failed, and is to be retried
+func (f *Fencing) Failed(message string) {
+	u := f.instance
+
+	if f.unfence {
+		setDRClusterUnfencingFailedCondition(&u.object.Status.Conditions, u.object.Generation, message)
+
+		return
+	}
+
+	setDRClusterFencingFailedCondition(&u.object.Status.Conditions, u.object.Generation, message)
+}
+
+// Cleaning reports that what fencing left behind is being removed, once the cluster is unfenced
+func (f *Fencing) Cleaning(message string) {
+	setDRClusterCleaningCondition(&f.instance.object.Status.Conditions, f.instance.object.Generation, message)
+}
+
+// Cleaned reports that what fencing left behind is removed, once the cluster is unfenced
+func (f *Fencing) Cleaned(message string) {
+	setDRClusterCleanCondition(&f.instance.object.Status.Conditions, f.instance.object.Generation, message)
+}
+
+// networkFenceProvider fences a cluster off its storage by NetworkFences of its CIDRs, created by ManifestWorks on
+// its peer cluster
+type networkFenceProvider struct{}
+
+func (networkFenceProvider) Fence(fencing *Fencing) (bool, error) {
+	return fencing.instance.clusterFence()
+}
+
+func (networkFenceProvider) Unfence(fencing *Fencing) (bool, error) {
+	return fencing.instance.clusterUnfence()
+}
+
+// DefaultFencingProviders returns the fencing providers that DRClusters may select by default, by name
+func DefaultFencingProviders() map[string]FencingProvider {
+	return map[string]FencingProvider{ramen.FencingProviderNetworkFence: networkFenceProvider{}}
+}
+
+// fencingProviderName returns the name of the cluster's fencing provider
+func (u *drclusterInstance) fencingProviderName() string {
+	if fencing := u.object.Spec.Fencing; fencing != nil && fencing.Provider != "" {
+		return fencing.Provider
+	}
+
+	return ramen.FencingProviderNetworkFence
+}
+
+// fencedProviderName returns the name of the fencing provider that fenced the cluster last, or else of the cluster's
+// fencing provider
+func (u *drclusterInstance) fencedProviderName() string {
+	if u.object.Status.FencingProvider != "" {
+		return u.object.Status.FencingProvider
+	}
+
+	return u.fencingProviderName()
+}
+
+// fencingProvider returns the fencing provider of a name, as registered with the reconciler, or the NetworkFence
+// provider if not overridden
+func (u *drclusterInstance) fencingProvider(name string) (FencingProvider, error) {
+	if provider, ok := u.reconciler.FencingProviders[name]; ok {
+		return provider, nil
+	}
+
+	if name == ramen.FencingProviderNetworkFence {
+		return networkFenceProvider{}, nil
+	}
+
+	return nil, fmt.Errorf("fencing provider %s not registered", name)
+}
+
+func (u *drclusterInstance) fencing(providerName string, unfence bool) *Fencing {
+	fencing := &Fencing{
+		Context:  u.ctx,
+		Log:      u.log.WithValues("fencingProvider", providerName),
+		Client:   u.client,
+		Cluster:  u.object,
+		instance: u,
+		unfence:  unfence,
+	}
+
+	if u.object.Spec.Fencing != nil {
+		fencing.Parameters = u.object.Spec.Fencing.Parameters
+	}
+
+	return fencing
+}
+
+// clusterFenceByProvider fences the cluster by its fencing provider, and records it as the provider that fenced it
+func (u *drclusterInstance) clusterFenceByProvider() (bool, error) {
+	name := u.fencingProviderName()
+	fencing := u.fencing(name, false)
+
+	provider, err := u.fencingProvider(name)
+	if err != nil {
+		fencing.Failed(err.Error())
+
+		return true, err
+	}
+
+	u.object.Status.FencingProvider = name
+
+	return provider.Fence(fencing)
+}
+
+// clusterUnfenceByProvider unfences the cluster by the fencing provider that fenced it
+func (u *drclusterInstance) clusterUnfenceByProvider() (bool, error) {
+	name := u.fencedProviderName()
+	fencing := u.fencing(name, true)
+
+	provider, err := u.fencingProvider(name)
+	if err != nil {
+		fencing.Failed(err.Error())
+
+		return true, err
+	}
+
+	return provider.Unfence(fencing)
+}
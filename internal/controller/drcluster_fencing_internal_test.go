@@ -0,0 +1,119 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/meta"
+
+	ramen "github.com/ramendr/ramen/api/v1alpha1"
+)
+
+var _ = Describe("Fencing providers", func() {
+	instance := func(fencing *ramen.DRClusterFencingSpec, providers map[string]FencingProvider) *drclusterInstance {
+		return &drclusterInstance{
+			ctx:        context.TODO(),
+			object:     &ramen.DRCluster{Spec: ramen.DRClusterSpec{Fencing: fencing}},
+			log:        logr.Discard(),
+			reconciler: &DRClusterReconciler{FencingProviders: providers},
+		}
+	}
+
+	It("selects NetworkFence unless another provider is selected", func() {
+		provider, err := instance(nil, nil).fencingProvider(ramen.FencingProviderNetworkFence)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(provider).To(Equal(networkFenceProvider{}))
+
+		_, err = instance(&ramen.DRClusterFencingSpec{Provider: "Power"}, nil).fencingProvider("Power")
+		Expect(err).To(MatchError("fencing provider Power not registered"))
+
+		provider, err = instance(&ramen.DRClusterFencingSpec{Provider: "Power"},
+			map[string]FencingProvider{"Power": nil, ramen.FencingProviderNetworkFence: nil},
+		).fencingProvider("Power")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(provider).To(BeNil())
+	})
+
+	It("unfences by the provider that fenced the cluster, even if another one is selected since", func() {
+		fenced, unfenced := []string{}, []string{}
+		providers := map[string]FencingProvider{
+			"Power":   recordingFencingProvider{name: "Power", fenced: &fenced, unfenced: &unfenced},
+			"Storage": recordingFencingProvider{name: "Storage", fenced: &fenced, unfenced: &unfenced},
+		}
+		u := instance(&ramen.DRClusterFencingSpec{Provider: "Power"}, providers)
+		Expect(u.fencedProviderName()).To(Equal("Power"))
+
+		_, err := u.clusterFenceByProvider()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(u.object.Status.FencingProvider).To(Equal("Power"))
+
+		u.object.Spec.Fencing.Provider = "Storage"
+		_, err = u.clusterUnfenceByProvider()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(fenced).To(HaveExactElements("Power"))
+		Expect(unfenced).To(HaveExactElements("Power"))
+		Expect(u.fencedProviderName()).To(Equal("Power"))
+	})
+
+	It("registers NetworkFence by default", func() {
+		Expect(DefaultFencingProviders()).To(HaveKeyWithValue(ramen.FencingProviderNetworkFence,
+			networkFenceProvider{}))
+	})
+
+	It("reports the progress of fencing and unfencing in the conditions and phase", func() {
+		u := instance(&ramen.DRClusterFencingSpec{Provider: "Power", Parameters: map[string]string{"a": "b"}}, nil)
+		fenced := func() string {
+			return meta.FindStatusCondition(u.object.Status.Conditions, ramen.DRClusterConditionTypeFenced).Reason
+		}
+
+		fencing := u.fencing("Power", false)
+		Expect(fencing.Parameters).To(Equal(map[string]string{"a": "b"}))
+		Expect(fencing.Started()).To(BeFalse())
+
+		fencing.Progressing("powering off")
+		Expect(fencing.Started()).To(BeTrue())
+		Expect(u.object.Status.Phase).To(Equal(ramen.Fencing))
+		Expect(fenced()).To(Equal(DRClusterConditionReasonFencing))
+
+		fencing.Succeeded("powered off")
+		Expect(u.object.Status.Phase).To(Equal(ramen.Fenced))
+		Expect(fenced()).To(Equal(DRClusterConditionReasonFenced))
+
+		unfencing := u.fencing("Power", true)
+		Expect(unfencing.Started()).To(BeFalse())
+
+		unfencing.Failed("power on failed")
+		Expect(fenced()).To(Equal(DRClusterConditionReasonUnfenceError))
+
+		unfencing.Progressing("powering on")
+		unfencing.Succeeded("powered on")
+		Expect(u.object.Status.Phase).To(Equal(ramen.Unfenced))
+		Expect(fenced()).To(Equal(DRClusterConditionReasonUnfenced))
+
+		unfencing.Cleaned("clean")
+		Expect(fenced()).To(Equal(DRClusterConditionReasonClean))
+	})
+})
+
+// recordingFencingProvider records the fence and unfence calls by its name
+type recordingFencingProvider struct {
+	name             string
+	fenced, unfenced *[]string
+}
+
+func (p recordingFencingProvider) Fence(*Fencing) (bool, error) {
+	*p.fenced = append(*p.fenced, p.name)
+
+	return false, nil
+}
+
+func (p recordingFencingProvider) Unfence(*Fencing) (bool, error) {
+	*p.unfenced = append(*p.unfenced, p.name)
+
+	return false, nil
+}
@@ -0,0 +1,191 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	ramen "github.com/ramendr/ramen/api/v1alpha1"
+	"github.com/ramendr/ramen/internal/controller/util"
+)
+
+// DRCluster FenceVerified condition reasons
+const (
+	DRClusterConditionReasonFenceVerified             = "Verified"
+	DRClusterConditionReasonFenceNotVerified          = "NotVerified"
+	DRClusterConditionReasonFenceVerificationOverride = "Overridden"
+	DRClusterConditionReasonFenceVerificationError    = "VerificationError"
+)
+
+// clusterFenceVerify verifies, if required, that the fenced cluster can no longer write, as reported by the fencing
+// provider that fenced it, if it verifies fencing, or else by the storage of its peer cluster, and returns whether to
+// requeue until it is verified
+func (u *drclusterInstance) clusterFenceVerify() (bool, error) {
+	fencing := u.object.Spec.Fencing
+	if fencing == nil || !fencing.Verify {
+		u.fenceVerifiedConditionRemove()
+
+		return false, nil
+	}
+
+	if fencing.VerificationOverride {
+		u.fenceVerifiedConditionSet(metav1.ConditionTrue, DRClusterConditionReasonFenceVerificationOverride,
+			"Fence verification overridden by admin")
+
+		return false, nil
+	}
+
+	fenced := meta.FindStatusCondition(u.object.Status.Conditions, ramen.DRClusterConditionTypeFenced)
+	if fenced == nil || fenced.Status != metav1.ConditionTrue {
+		u.fenceVerifiedConditionSet(metav1.ConditionFalse, DRClusterConditionReasonFenceNotVerified,
+			"Cluster not fenced yet")
+
+		return true, nil
+	}
+
+	verified, message, err := u.fenceVerify(fenced.LastTransitionTime)
+	if err != nil {
+		u.fenceVerifiedConditionSet(metav1.ConditionFalse, DRClusterConditionReasonFenceVerificationError,
+			err.Error())
+
+		return true, err
+	}
+
+	if !verified {
+		u.log.Info("Fence not verified", "reason", message)
+		u.fenceVerifiedConditionSet(metav1.ConditionFalse, DRClusterConditionReasonFenceNotVerified, message)
+
+		return true, nil
+	}
+
+	u.fenceVerifiedConditionSet(metav1.ConditionTrue, DRClusterConditionReasonFenceVerified, message)
+
+	return false, nil
+}
+
+// fenceVerify returns whether the fenced cluster can no longer write, as verified by the fencing provider that fenced
+// it, if it verifies fencing, or else by the storage of its peer cluster since the cluster was fenced, and why, or
+// why not
+func (u *drclusterInstance) fenceVerify(fencedTime metav1.Time) (bool, string, error) {
+	name := u.fencedProviderName()
+
+	provider, err := u.fencingProvider(name)
+	if err != nil {
+		return false, "", err
+	}
+
+	if verifier, ok := provider.(FenceVerifyingProvider); ok {
+		return verifier.VerifyFence(u.fencing(name, false))
+	}
+
+	verifications, err := u.fenceVerificationsGet()
+	if err != nil {
+		return false, "", err
+	}
+
+	verified, message := fenceVerified(verifications, fencingCIDRs(u.object), fencedTime)
+
+	return verified, message, nil
+}
+
+// fenceVerificationsGet returns the fence verifications that the storage of the peer cluster reports
+func (u *drclusterInstance) fenceVerificationsGet() ([]ramen.FenceVerification, error) {
+	drpolicies, err := util.GetAllDRPolicies(u.ctx, u.reconciler.APIReader)
+	if err != nil {
+		return nil, fmt.Errorf("getting all drpolicies failed: %w", err)
+	}
+
+	peerCluster, err := getPeerCluster(u.ctx, drpolicies, u.reconciler, u.object, u.log)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the peer cluster for the cluster %s: %w", u.object.Name, err)
+	}
+
+	drcConfig, err := u.getDRCCFromCluster(&peerCluster)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the DRClusterConfig of the peer cluster %s: %w", peerCluster.Name, err)
+	}
+
+	return drcConfig.Status.FenceVerifications, nil
+}
+
+// fenceVerified returns whether the storage backends' verifications, checked since the cluster was fenced, cover
+// each of its CIDRs and report no active clients of them, and why, or why not
+func fenceVerified(verifications []ramen.FenceVerification, cidrs []string, fencedTime metav1.Time,
+) (bool, string) {
+	if len(cidrs) == 0 {
+		return false, "Cluster has no CIDRs to verify"
+	}
+
+	provisioners := []string{}
+
+	for _, cidr := range cidrs {
+		verified := false
+
+		for _, verification := range verifications {
+			if !slices.Contains(verification.CIDRs, cidr) || verification.CheckTime.Before(&fencedTime) {
+				continue
+			}
+
+			if len(verification.ActiveClients) > 0 {
+				return false, fmt.Sprintf("Storage provisioner %s reports active clients of CIDR %s: %s",
+					verification.StorageProvisioner, cidr, strings.Join(verification.ActiveClients, ", "))
+			}
+
+			verified = true
+
+			if !slices.Contains(provisioners, verification.StorageProvisioner) {
+				provisioners = append(provisioners, verification.StorageProvisioner)
+			}
+		}
+
+		if !verified {
+			return false, fmt.Sprintf("CIDR %s not verified since the cluster was fenced", cidr)
+		}
+	}
+
+	return true, "Storage provisioners " + strings.Join(provisioners, ", ") +
+		" report no active clients of the cluster's CIDRs"
+}
+
+func (u *drclusterInstance) fenceVerifiedConditionSet(status metav1.ConditionStatus, reason, message string) {
+	util.SetStatusCondition(&u.object.Status.Conditions, metav1.Condition{
+		Type:               ramen.DRClusterConditionTypeFenceVerified,
+		Reason:             reason,
+		ObservedGeneration: u.object.Generation,
+		Status:             status,
+		Message:            message,
+	})
+}
+
+func (u *drclusterInstance) fenceVerifiedConditionRemove() {
+	meta.RemoveStatusCondition(&u.object.Status.Conditions, ramen.DRClusterConditionTypeFenceVerified)
+}
+
+// drClusterFenceVerified returns whether failover from a fenced cluster may proceed as far as the verification of
+// its fencing is concerned, and if not, why not
+func drClusterFenceVerified(drCluster *ramen.DRCluster) (bool, string) {
+	if drCluster.Spec.Fencing == nil || !drCluster.Spec.Fencing.Verify {
+		return true, ""
+	}
+
+	condition := util.FindCondition(drCluster.Status.Conditions, ramen.DRClusterConditionTypeFenceVerified)
+	if condition == nil {
+		return false, "fence verification not reported"
+	}
+
+	if condition.ObservedGeneration != drCluster.Generation {
+		return false, "fence verification not reported for the current generation"
+	}
+
+	if condition.Status != metav1.ConditionTrue {
+		return false, condition.Message
+	}
+
+	return true, ""
+}
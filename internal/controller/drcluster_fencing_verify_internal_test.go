@@ -0,0 +1,207 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clusterv1alpha1 "open-cluster-management.io/api/cluster/v1alpha1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	ramen "github.com/ramendr/ramen/api/v1alpha1"
+)
+
+var _ = Describe("Fence verification", func() {
+	fencedTime := metav1.NewTime(time.Now().Truncate(time.Second))
+	before := metav1.NewTime(fencedTime.Add(-time.Minute))
+	after := metav1.NewTime(fencedTime.Add(time.Minute))
+	cidrs := []string{"10.0.0.0/24", "10.0.1.0/24"}
+
+	verification := func(provisioner string, checkTime metav1.Time, activeClients ...string) ramen.FenceVerification {
+		return ramen.FenceVerification{
+			StorageProvisioner: provisioner,
+			CIDRs:              cidrs,
+			ActiveClients:      activeClients,
+			CheckTime:          checkTime,
+		}
+	}
+
+	It("is not verified without CIDRs or verifications", func() {
+		verified, _ := fenceVerified([]ramen.FenceVerification{verification("a", after)}, nil, fencedTime)
+		Expect(verified).To(BeFalse())
+
+		verified, message := fenceVerified(nil, cidrs, fencedTime)
+		Expect(verified).To(BeFalse())
+		Expect(message).To(Equal("CIDR 10.0.0.0/24 not verified since the cluster was fenced"))
+	})
+
+	It("ignores verifications checked before the cluster was fenced", func() {
+		verified, _ := fenceVerified([]ramen.FenceVerification{verification("a", before)}, cidrs, fencedTime)
+		Expect(verified).To(BeFalse())
+	})
+
+	It("is not verified while any provisioner reports active clients", func() {
+		verified, message := fenceVerified([]ramen.FenceVerification{
+			verification("a", after),
+			verification("b", after, "client.1", "client.2"),
+		}, cidrs, fencedTime)
+		Expect(verified).To(BeFalse())
+		Expect(message).To(Equal("Storage provisioner b reports active clients of CIDR 10.0.0.0/24: client.1, client.2"))
+	})
+
+	It("is verified when all CIDRs are reported without active clients", func() {
+		verified, _ := fenceVerified([]ramen.FenceVerification{verification("a", fencedTime)}, cidrs, fencedTime)
+		Expect(verified).To(BeTrue())
+	})
+
+	It("blocks failover until the current generation is verified, unless not required", func() {
+		verified := func(drCluster *ramen.DRCluster) bool {
+			verified, _ := drClusterFenceVerified(drCluster)
+
+			return verified
+		}
+
+		drCluster := &ramen.DRCluster{}
+		drCluster.Generation = 2
+		Expect(verified(drCluster)).To(BeTrue())
+
+		drCluster.Spec.Fencing = &ramen.DRClusterFencingSpec{Verify: true}
+		Expect(verified(drCluster)).To(BeFalse())
+
+		condition := metav1.Condition{
+			Type:               ramen.DRClusterConditionTypeFenceVerified,
+			Status:             metav1.ConditionTrue,
+			ObservedGeneration: 1,
+		}
+		drCluster.Status.Conditions = []metav1.Condition{condition}
+		Expect(verified(drCluster)).To(BeFalse())
+
+		drCluster.Status.Conditions[0].ObservedGeneration = 2
+		Expect(verified(drCluster)).To(BeTrue())
+	})
+
+	It("blocks failover from a fenced cluster until its fencing is verified, whatever the DR type", func() {
+		d := &DRPCInstance{drClusters: []ramen.DRCluster{{
+			ObjectMeta: metav1.ObjectMeta{Name: "east"},
+			Spec: ramen.DRClusterSpec{
+				ClusterFence: ramen.ClusterFenceStateFenced,
+				Fencing:      &ramen.DRClusterFencingSpec{Verify: true},
+			},
+		}}}
+		Expect(d.checkFailoverFenceVerified("east")).To(MatchError(ContainSubstring("fencing is not verified")))
+		Expect(d.checkFailoverFenceVerified("west")).To(Succeed())
+
+		d.drClusters[0].Spec.ClusterFence = ramen.ClusterFenceStateUnfenced
+		Expect(d.checkFailoverFenceVerified("east")).To(Succeed())
+	})
+
+	It("reports the verifications of ClusterClaims and registered verifiers", func() {
+		scheme := runtime.NewScheme()
+		Expect(clusterv1alpha1.AddToScheme(scheme)).To(Succeed())
+
+		claim := func(name, provisioner, cidrs, activeClients, checkTime string) *clusterv1alpha1.ClusterClaim {
+			return &clusterv1alpha1.ClusterClaim{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:   name,
+					Labels: map[string]string{FenceVerificationLabel: ""},
+					Annotations: map[string]string{
+						FenceVerificationCIDRsAnnotation:         cidrs,
+						FenceVerificationActiveClientsAnnotation: activeClients,
+						FenceVerificationCheckTimeAnnotation:     checkTime,
+					},
+				},
+				Spec: clusterv1alpha1.ClusterClaimSpec{Value: provisioner},
+			}
+		}
+		r := &DRClusterConfigReconciler{
+			Log: logr.Discard(),
+			Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+				claim("b", "b", "10.0.1.0/24, 10.0.0.0/24", "client.1", after.Format(time.RFC3339)),
+				claim("a", "a", "10.0.1.0/24,10.0.0.0/24", "", after.Format(time.RFC3339)),
+			).Build(),
+			FenceVerifiers: []FenceVerifier{
+				staticFenceVerifier{verifications: []ramen.FenceVerification{verification("c", after)}},
+				staticFenceVerifier{err: errors.New("unreachable")},
+			},
+		}
+
+		verifications := r.listFenceVerifications(context.TODO())
+		Expect(verifications).To(HaveExactElements(
+			verification("a", after),
+			verification("b", after, "client.1"),
+			verification("c", after),
+		))
+
+		verified, message := fenceVerified(verifications, cidrs, fencedTime)
+		Expect(verified).To(BeFalse())
+		Expect(message).To(Equal("Storage provisioner b reports active clients of CIDR 10.0.0.0/24: client.1"))
+
+		Expect(r.Client.Create(context.TODO(), claim("d", "d", "10.0.0.0/24", "", "yesterday"))).To(Succeed())
+		Expect(r.listFenceVerifications(context.TODO())).To(HaveExactElements(verification("c", after)))
+	})
+
+	It("lets a fencing provider that verifies fencing verify it instead of the peer's storage", func() {
+		u := &drclusterInstance{
+			ctx: context.TODO(),
+			object: &ramen.DRCluster{
+				Spec: ramen.DRClusterSpec{Fencing: &ramen.DRClusterFencingSpec{Provider: "Power", Verify: true}},
+				Status: ramen.DRClusterStatus{
+					FencingProvider: "Power",
+					Conditions: []metav1.Condition{{
+						Type:   ramen.DRClusterConditionTypeFenced,
+						Status: metav1.ConditionTrue,
+					}},
+				},
+			},
+			log: logr.Discard(),
+			reconciler: &DRClusterReconciler{FencingProviders: map[string]FencingProvider{
+				"Power": verifyingFencingProvider{message: "Nodes powered off"},
+			}},
+		}
+
+		requeue, err := u.clusterFenceVerify()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(requeue).To(BeFalse())
+		Expect(drClusterFenceVerified(u.object)).To(BeTrue())
+
+		u.reconciler.FencingProviders["Power"] = verifyingFencingProvider{message: "Node 1 powered on"}
+		requeue, err = u.clusterFenceVerify()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(requeue).To(BeTrue())
+
+		verified, message := drClusterFenceVerified(u.object)
+		Expect(verified).To(BeFalse())
+		Expect(message).To(Equal("Node 1 powered on"))
+	})
+})
+
+// staticFenceVerifier reports its verifications, or fails with its error
+type staticFenceVerifier struct {
+	verifications []ramen.FenceVerification
+	err           error
+}
+
+func (staticFenceVerifier) Name() string { return "static" }
+
+func (v staticFenceVerifier) FenceVerifications(context.Context, client.Reader) ([]ramen.FenceVerification, error) {
+	return v.verifications, v.err
+}
+
+// verifyingFencingProvider verifies fencing, as long as its message reports that the nodes are powered off
+type verifyingFencingProvider struct {
+	recordingFencingProvider
+	message string
+}
+
+func (p verifyingFencingProvider) VerifyFence(*Fencing) (bool, string, error) {
+	return p.message == "Nodes powered off", p.message, nil
+}
@@ -4,6 +4,8 @@
 package controllers
 
 import (
+	"slices"
+
 	"github.com/go-logr/logr"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
@@ -15,11 +17,63 @@ import (
 	"github.com/ramendr/ramen/internal/controller/util"
 )
 
+// mModeActivation is a maintenance mode resource required on the cluster for a storage instance, with the modes
+// that DRPCs require of it
+type mModeActivation struct {
+	storageIdentifiers ramen.StorageIdentifiers
+	modes              []ramen.MMode
+}
+
+// mModeActivations are the maintenance mode activations required on a cluster, keyed by
+// <ProvisionerName>+<ReplicationID>
+type mModeActivations map[string]mModeActivation
+
+// add adds the mode to the activations for the passed in storage identifiers
+func (activations mModeActivations) add(
+	activationsRequired map[string]ramen.StorageIdentifiers,
+	mode ramen.MMode,
+) {
+	for key, storageIdentifiers := range activationsRequired {
+		activation, ok := activations[key]
+		if !ok {
+			activation = mModeActivation{storageIdentifiers: storageIdentifiers}
+		}
+
+		if !slices.Contains(activation.modes, mode) {
+			activation.modes = append(activation.modes, mode)
+			slices.Sort(activation.modes)
+		}
+
+		activations[key] = activation
+	}
+}
+
+// activated checks if all the activations are reported as activated, with their modes, in the status of the cluster
+func (activations mModeActivations) activated(drCluster *ramen.DRCluster, log logr.Logger) bool {
+	for _, activation := range activations {
+		reported := slices.ContainsFunc(drCluster.Status.MaintenanceModes, func(mMode ramen.ClusterMaintenanceMode) bool {
+			return mMode.StorageProvisioner == activation.storageIdentifiers.StorageProvisioner &&
+				mMode.TargetID == activation.storageIdentifiers.ReplicationID.ID &&
+				slices.Equal(mMode.Modes, activation.modes)
+		})
+		if !reported {
+			return false
+		}
+
+		for _, mode := range activation.modes {
+			if !checkActivationForStorageIdentifier(drCluster.Status.MaintenanceModes, activation.storageIdentifiers,
+				mModeActivatedCondition(mode), log) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
 // clusterMModeHandler handles all related maintenance modes that the DRCluster needs
-// to manage
-// NOTE: Currently this is limited in implementation to just handling the Failover mode
-// during regional DR failovers, and parts of the implementation are not generic to handle
-// an arbitrary future mode
+// to manage: Failover during regional DR failovers to the cluster, Relocate during relocations from it, and Resync
+// while it is cleaned up after failovers or relocations to a peer cluster
 func (u *drclusterInstance) clusterMModeHandler() error {
 	allActivations, err := u.mModeActivationsRequired()
 	if err != nil {
@@ -28,8 +82,8 @@ func (u *drclusterInstance) clusterMModeHandler() error {
 		return err
 	}
 
-	if activated := checkFailoverMaintenanceActivations(*u.object, allActivations, u.log); !activated {
-		u.activateRegionalFailoverPrequisites(allActivations)
+	if activated := allActivations.activated(u.object, u.log); !activated {
+		u.activateMModes(allActivations)
 	}
 
 	survivors, err := u.pruneMModesActivations(allActivations)
@@ -47,10 +101,10 @@ func (u *drclusterInstance) clusterMModeHandler() error {
 }
 
 // mModeActivationsRequired determines all required maintenance modes for the current cluster based
-// on the DRPCs that are failing over to this cluster and their required maintenance modes. It returns
-// a map of StorageIdentifiers, with the key being the <ProvisionerName>+<ReplicationID>
-func (u *drclusterInstance) mModeActivationsRequired() (map[string]ramen.StorageIdentifiers, error) {
-	allActivations := map[string]ramen.StorageIdentifiers{}
+// on the DRPCs that are failing over to, relocating from, or cleaning up this cluster and their required
+// maintenance modes. It returns the activations keyed by <ProvisionerName>+<ReplicationID>
+func (u *drclusterInstance) mModeActivationsRequired() (mModeActivations, error) {
+	allActivations := mModeActivations{}
 
 	drpcCollections, err := DRPCsFailingOverToCluster(u.client, u.log, u.object.GetName())
 	if err != nil {
@@ -95,13 +149,11 @@ func (u *drclusterInstance) mModeActivationsRequired() (map[string]ramen.Storage
 			continue
 		}
 
-		for key, storageIdentifiers := range activationsRequired {
-			if _, ok := allActivations[key]; ok {
-				continue
-			}
+		allActivations.add(activationsRequired, ramen.MModeFailover)
+	}
 
-			allActivations[key] = storageIdentifiers
-		}
+	if err := u.mModeRelocateAndResyncActivationsRequired(allActivations); err != nil {
+		return nil, err
 	}
 
 	u.log.Info("Activations required", "count", len(allActivations))
@@ -109,6 +161,45 @@ func (u *drclusterInstance) mModeActivationsRequired() (map[string]ramen.Storage
 	return allActivations, nil
 }
 
+// mModeRelocateAndResyncActivationsRequired adds the maintenance modes required for the current cluster by the DRPCs
+// that are relocating from it, as reported by its primary VRG, or cleaning it up, as reported by the primary VRG on
+// the cluster they failed over or relocated to
+func (u *drclusterInstance) mModeRelocateAndResyncActivationsRequired(allActivations mModeActivations) error {
+	drpcCollections, err := DRPCsRequiringMModesOnCluster(u.client, u.log, u.object)
+	if err != nil {
+		return err
+	}
+
+	for _, drpcCollection := range drpcCollections {
+		mode, targetCluster := drpcMModeRequired(drpcCollection.drpc, u.object.GetName())
+
+		vrgs, err := u.getVRGs(drpcCollection)
+		if err != nil {
+			u.log.Info("Failed to get VRGs for DRPC that requires maintenance modes",
+				"DRPCName", drpcCollection.drpc.GetName(),
+				"DRPCNamespace", drpcCollection.drpc.GetNamespace(),
+				"mode", mode)
+
+			u.requeue = true
+
+			continue
+		}
+
+		vrg := vrgs[targetCluster]
+		if mode == ramen.MModeRelocate {
+			vrg = vrgs[u.object.GetName()]
+		}
+
+		if vrg == nil || !isVRGPrimary(vrg) {
+			continue
+		}
+
+		allActivations.add(mModeActivationsRequiredByVRG(vrg, mode), mode)
+	}
+
+	return nil
+}
+
 // getVRGs is a helper function to get the VRGs for the passed in DRPC and DRPolicy association
 func (u *drclusterInstance) getVRGs(drpcCollection DRPCAndPolicy) (map[string]*ramen.VolumeReplicationGroup, error) {
 	drClusters, err := GetDRClusters(u.ctx, u.client, drpcCollection.drPolicy)
@@ -144,17 +235,17 @@ func (u *drclusterInstance) getVRGs(drpcCollection DRPCAndPolicy) (map[string]*r
 	return vrgs, nil
 }
 
-// activateRegionalFailoverPrequisites activates all regional failover maintenance modes as desired
-// by the passed in required activations
-func (u *drclusterInstance) activateRegionalFailoverPrequisites(
-	activationsRequired map[string]ramen.StorageIdentifiers,
-) {
-	for _, identifier := range activationsRequired {
+// activateMModes activates all maintenance modes as desired by the passed in required activations
+func (u *drclusterInstance) activateMModes(activationsRequired mModeActivations) {
+	for _, activation := range activationsRequired {
+		identifier := activation.storageIdentifiers
+
 		u.log.Info("Activating maintenance mode",
 			"provisioner", identifier.StorageProvisioner,
-			"ReplciationID", identifier.ReplicationID)
+			"ReplciationID", identifier.ReplicationID,
+			"modes", activation.modes)
 
-		if err := u.activateRegionalFailoverPrequisite(identifier); err != nil {
+		if err := u.activateMMode(activation); err != nil {
 			u.log.Error(err, "Error activating maintenance mode",
 				"provisioner", identifier.StorageProvisioner,
 				"ReplciationID", identifier.ReplicationID)
@@ -166,16 +257,17 @@ func (u *drclusterInstance) activateRegionalFailoverPrequisites(
 	}
 }
 
-// activateRegionalFailoverPrequisite activates a regional failover maintenance mode as desired
-// for the passed in storage identifier
-func (u *drclusterInstance) activateRegionalFailoverPrequisite(identifier ramen.StorageIdentifiers) error {
+// activateMMode activates the maintenance modes as desired for the storage identifier of the passed in activation
+func (u *drclusterInstance) activateMMode(activation mModeActivation) error {
+	identifier := activation.storageIdentifiers
+
 	mMode := ramen.MaintenanceMode{
 		TypeMeta:   metav1.TypeMeta{Kind: "MaintenanceMode", APIVersion: "ramendr.openshift.io/v1alpha1"},
 		ObjectMeta: metav1.ObjectMeta{Name: identifier.ReplicationID.ID},
 		Spec: ramen.MaintenanceModeSpec{
 			StorageProvisioner: identifier.StorageProvisioner,
 			TargetID:           identifier.ReplicationID.ID,
-			Modes:              []ramen.MMode{ramen.MModeFailover},
+			Modes:              activation.modes,
 		},
 	}
 
@@ -196,7 +288,7 @@ func (u *drclusterInstance) activateRegionalFailoverPrequisite(identifier ramen.
 // those that are currently required. It returns a map of maintenance mode manifest work that
 // are still required and not pruned, the keys being the targetID for the maintenance mode.
 func (u *drclusterInstance) pruneMModesActivations(
-	activationsRequired map[string]ramen.StorageIdentifiers,
+	activationsRequired mModeActivations,
 ) (map[string]*ocmworkv1.ManifestWork, error) {
 	mModeMWs, err := u.mwUtil.ListMModeManifests(u.object.GetName())
 	if err != nil {
@@ -298,12 +390,14 @@ func (u *drclusterInstance) updateMModeActivationStatus(survivors map[string]*oc
 			clusterMaintenanceMode = ramen.ClusterMaintenanceMode{
 				StorageProvisioner: mMode.Spec.StorageProvisioner,
 				TargetID:           mMode.Spec.TargetID,
+				Modes:              mMode.Spec.Modes,
 				State:              ramen.MModeStateUnknown,
 			}
 		} else {
 			clusterMaintenanceMode = ramen.ClusterMaintenanceMode{
 				StorageProvisioner: mMode.Spec.StorageProvisioner,
 				TargetID:           mMode.Spec.TargetID,
+				Modes:              mMode.Spec.Modes,
 				State:              mMode.Status.State,
 				Conditions:         mMode.Status.Conditions,
 			}
@@ -0,0 +1,92 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	ramen "github.com/ramendr/ramen/api/v1alpha1"
+)
+
+var _ = Describe("Maintenance modes", func() {
+	storageIdentifiers := ramen.StorageIdentifiers{
+		StorageProvisioner: "provisioner1",
+		ReplicationID:      ramen.Identifier{ID: "id1"},
+	}
+	activationsRequired := map[string]ramen.StorageIdentifiers{"provisioner1id1": storageIdentifiers}
+
+	It("parses the relocate and resync modes from labels", func() {
+		Expect(MModesFromCSV("Failover,Unknown,Resync,Relocate")).To(Equal(
+			[]ramen.MMode{ramen.MModeFailover, ramen.MModeResync, ramen.MModeRelocate}))
+	})
+
+	It("requests all the modes that DRPCs require of a storage instance in one activation", func() {
+		activations := mModeActivations{}
+		activations.add(activationsRequired, ramen.MModeResync)
+		activations.add(activationsRequired, ramen.MModeFailover)
+		activations.add(activationsRequired, ramen.MModeResync)
+
+		Expect(activations).To(HaveLen(1))
+		Expect(activations["provisioner1id1"].modes).To(Equal([]ramen.MMode{ramen.MModeFailover, ramen.MModeResync}))
+	})
+
+	It("is activated once all modes are reported activated for the requested modes", func() {
+		activations := mModeActivations{}
+		activations.add(activationsRequired, ramen.MModeRelocate)
+
+		drCluster := &ramen.DRCluster{}
+		Expect(activations.activated(drCluster, logr.Discard())).To(BeFalse())
+
+		drCluster.Status.MaintenanceModes = []ramen.ClusterMaintenanceMode{{
+			StorageProvisioner: "provisioner1",
+			TargetID:           "id1",
+			Modes:              []ramen.MMode{ramen.MModeFailover},
+			Conditions: []metav1.Condition{
+				{Type: string(ramen.MModeConditionFailoverActivated), Status: metav1.ConditionTrue},
+			},
+		}}
+		Expect(activations.activated(drCluster, logr.Discard())).To(BeFalse())
+
+		drCluster.Status.MaintenanceModes[0].Modes = []ramen.MMode{ramen.MModeRelocate}
+		Expect(activations.activated(drCluster, logr.Discard())).To(BeFalse())
+
+		drCluster.Status.MaintenanceModes[0].Conditions = []metav1.Condition{
+			{Type: string(ramen.MModeConditionRelocateActivated), Status: metav1.ConditionTrue},
+		}
+		Expect(activations.activated(drCluster, logr.Discard())).To(BeTrue())
+	})
+
+	It("requires relocate of the cluster relocated from and resync of the peers cleaned up", func() {
+		drpc := &ramen.DRPlacementControl{
+			Spec: ramen.DRPlacementControlSpec{
+				Action:           ramen.ActionRelocate,
+				PreferredCluster: "cluster1",
+				FailoverCluster:  "cluster2",
+			},
+		}
+
+		mode, targetCluster := drpcMModeRequired(drpc, "cluster2")
+		Expect(mode).To(Equal(ramen.MModeRelocate))
+		Expect(targetCluster).To(Equal("cluster1"))
+
+		mode, _ = drpcMModeRequired(drpc, "cluster1")
+		Expect(mode).To(BeEmpty())
+
+		drpc.Status.Conditions = []metav1.Condition{{Type: ramen.ConditionAvailable, Status: metav1.ConditionTrue}}
+		mode, _ = drpcMModeRequired(drpc, "cluster2")
+		Expect(mode).To(BeEmpty())
+
+		drpc.Status.Progression = ramen.ProgressionCleaningUp
+		mode, _ = drpcMModeRequired(drpc, "cluster2")
+		Expect(mode).To(Equal(ramen.MModeResync))
+
+		drpc.Spec.Action = ramen.ActionFailover
+		mode, targetCluster = drpcMModeRequired(drpc, "cluster1")
+		Expect(mode).To(Equal(ramen.MModeResync))
+		Expect(targetCluster).To(Equal("cluster2"))
+	})
+})
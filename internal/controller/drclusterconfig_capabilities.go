@@ -0,0 +1,257 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	clusterv1alpha1 "open-cluster-management.io/api/cluster/v1alpha1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	ramen "github.com/ramendr/ramen/api/v1alpha1"
+	"github.com/ramendr/ramen/internal/controller/util"
+)
+
+const (
+	// CapabilityLabel marks ClusterClaims that report a capability of the cluster, e.g. by a storage vendor to report
+	// the health of its mirroring daemon, with the claim's name and value as the capability's name and value
+	CapabilityLabel = "ramendr.openshift.io/capability"
+
+	// CapabilityStatusAnnotation, CapabilityBlocksFailoverAnnotation and CapabilityMessageAnnotation on a ClusterClaim
+	// report the status, whether it blocks failover when unhealthy, and the message of its capability
+	CapabilityStatusAnnotation         = "ramendr.openshift.io/capability-status"
+	CapabilityBlocksFailoverAnnotation = "ramendr.openshift.io/capability-blocks-failover"
+	CapabilityMessageAnnotation        = "ramendr.openshift.io/capability-message"
+
+	capabilityNameStorageClassFreeCapacity = "storageclass.capacity.free/"
+	capabilityNameVolSync                  = "volsync"
+
+	volSyncCRDName = "replicationsources.volsync.backube"
+
+	// capabilitiesRefreshInterval is the interval to refresh capabilities at that are not watched, i.e. the free
+	// capacity of StorageClasses and the capabilities of registered providers
+	capabilitiesRefreshInterval = 5 * time.Minute
+)
+
+// CapabilityProvider reports health and capacity facts about storage or DR components of the cluster, for the hub to
+// surface them on the DRCluster and check failover readiness with them
+type CapabilityProvider interface {
+	// Name of the provider, reported with its capabilities
+	Name() string
+
+	// Capabilities returns the facts that the provider reports
+	Capabilities(ctx context.Context, reader client.Reader) ([]ramen.ClusterCapability, error)
+}
+
+// capabilityProviders returns the built in capability providers, followed by those registered with the reconciler
+func (r *DRClusterConfigReconciler) capabilityProviders() []CapabilityProvider {
+	return append([]CapabilityProvider{
+		clusterClaimCapabilityProvider{},
+		storageClassCapacityProvider{},
+		volSyncCapabilityProvider{},
+	}, r.CapabilityProviders...)
+}
+
+// capabilitiesRefreshed returns whether the capabilities are to be refreshed periodically, i.e. whether the free
+// capacity of StorageClasses is reported, as CSIStorageCapacities are not watched, or providers are registered
+func (r *DRClusterConfigReconciler) capabilitiesRefreshed(capabilities []ramen.ClusterCapability) bool {
+	if len(r.CapabilityProviders) > 0 {
+		return true
+	}
+
+	return slices.ContainsFunc(capabilities, func(capability ramen.ClusterCapability) bool {
+		return strings.HasPrefix(capability.Name, capabilityNameStorageClassFreeCapacity)
+	})
+}
+
+// reportClaimPredicate filters ClusterClaim events to those of the claims that report capabilities or fence
+// verifications
+func reportClaimPredicate() predicate.Predicate {
+	return predicate.NewPredicateFuncs(func(object client.Object) bool {
+		return util.HasLabel(object, CapabilityLabel) || util.HasLabel(object, FenceVerificationLabel)
+	})
+}
+
+// listCapabilities returns the capabilities that all providers report, sorted by name. A provider that fails to
+// report is reported with an Unknown capability named after it, rather than failing the status update.
+func (r *DRClusterConfigReconciler) listCapabilities(ctx context.Context) []ramen.ClusterCapability {
+	capabilities := []ramen.ClusterCapability{}
+
+	for _, provider := range r.capabilityProviders() {
+		providerCapabilities, err := provider.Capabilities(ctx, r.Client)
+		if err != nil {
+			r.Log.Info("Failed to list capabilities", "provider", provider.Name(), "error", err)
+
+			capabilities = append(capabilities, ramen.ClusterCapability{
+				Name:     provider.Name(),
+				Provider: provider.Name(),
+				Status:   ramen.ClusterCapabilityUnknown,
+				Message:  err.Error(),
+			})
+
+			continue
+		}
+
+		for i := range providerCapabilities {
+			providerCapabilities[i].Provider = provider.Name()
+		}
+
+		capabilities = append(capabilities, providerCapabilities...)
+	}
+
+	slices.SortFunc(capabilities, func(a, b ramen.ClusterCapability) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	return capabilities
+}
+
+// clusterClaimCapabilityProvider reports the capabilities of ClusterClaims that carry the capability label
+type clusterClaimCapabilityProvider struct{}
+
+func (clusterClaimCapabilityProvider) Name() string { return "ClusterClaim" }
+
+func (clusterClaimCapabilityProvider) Capabilities(ctx context.Context, reader client.Reader,
+) ([]ramen.ClusterCapability, error) {
+	claims := &clusterv1alpha1.ClusterClaimList{}
+	if err := reader.List(ctx, claims, client.HasLabels{CapabilityLabel}); err != nil {
+		return nil, fmt.Errorf("failed to list ClusterClaims, %w", err)
+	}
+
+	capabilities := make([]ramen.ClusterCapability, 0, len(claims.Items))
+
+	for i := range claims.Items {
+		claim := &claims.Items[i]
+		annotations := claim.GetAnnotations()
+
+		status := ramen.ClusterCapabilityStatus(annotations[CapabilityStatusAnnotation])
+		if status == "" {
+			status = ramen.ClusterCapabilityUnknown
+		}
+
+		capabilities = append(capabilities, ramen.ClusterCapability{
+			Name:           claim.Name,
+			Value:          claim.Spec.Value,
+			Status:         status,
+			BlocksFailover: annotations[CapabilityBlocksFailoverAnnotation] == "true",
+			Message:        annotations[CapabilityMessageAnnotation],
+		})
+	}
+
+	return capabilities, nil
+}
+
+// storageClassCapacityProvider reports the free capacity of the DR supported StorageClasses, as the CSIStorageCapacity
+// objects of their CSI drivers report it. A StorageClass without free capacity blocks failover.
+type storageClassCapacityProvider struct{}
+
+func (storageClassCapacityProvider) Name() string { return "CSIStorageCapacity" }
+
+func (storageClassCapacityProvider) Capabilities(ctx context.Context, reader client.Reader,
+) ([]ramen.ClusterCapability, error) {
+	storageCapacities := &storagev1.CSIStorageCapacityList{}
+	if err := reader.List(ctx, storageCapacities); err != nil {
+		return nil, fmt.Errorf("failed to list CSIStorageCapacities, %w", err)
+	}
+
+	freeCapacities := map[string]*resource.Quantity{}
+
+	for i := range storageCapacities.Items {
+		storageCapacity := &storageCapacities.Items[i]
+
+		freeCapacity, ok := freeCapacities[storageCapacity.StorageClassName]
+		if !ok {
+			freeCapacity = resource.NewQuantity(0, resource.BinarySI)
+			freeCapacities[storageCapacity.StorageClassName] = freeCapacity
+		}
+
+		if storageCapacity.Capacity != nil {
+			freeCapacity.Add(*storageCapacity.Capacity)
+		}
+	}
+
+	storageClasses := &storagev1.StorageClassList{}
+	if err := reader.List(ctx, storageClasses); err != nil {
+		return nil, fmt.Errorf("failed to list StorageClasses, %w", err)
+	}
+
+	capabilities := []ramen.ClusterCapability{}
+
+	for i := range storageClasses.Items {
+		storageClass := &storageClasses.Items[i]
+
+		freeCapacity, ok := freeCapacities[storageClass.Name]
+		if !ok || !util.HasLabel(storageClass, StorageIDLabel) {
+			continue
+		}
+
+		capability := ramen.ClusterCapability{
+			Name:           capabilityNameStorageClassFreeCapacity + storageClass.Name,
+			Value:          freeCapacity.String(),
+			Status:         ramen.ClusterCapabilityHealthy,
+			BlocksFailover: true,
+		}
+
+		if freeCapacity.IsZero() {
+			capability.Status = ramen.ClusterCapabilityUnhealthy
+			capability.Message = "StorageClass has no free capacity"
+		}
+
+		capabilities = append(capabilities, capability)
+	}
+
+	return capabilities, nil
+}
+
+// volSyncCapabilityProvider reports whether VolSync is installed, with its version if its deployment is labeled
+// with it
+type volSyncCapabilityProvider struct{}
+
+func (volSyncCapabilityProvider) Name() string { return "VolSync" }
+
+func (volSyncCapabilityProvider) Capabilities(ctx context.Context, reader client.Reader,
+) ([]ramen.ClusterCapability, error) {
+	if !util.IsCRDInstalled(ctx, reader, volSyncCRDName) {
+		return []ramen.ClusterCapability{{
+			Name:    capabilityNameVolSync,
+			Status:  ramen.ClusterCapabilityUnknown,
+			Message: "VolSync is not installed",
+		}}, nil
+	}
+
+	deployments := &appsv1.DeploymentList{}
+	if err := reader.List(ctx, deployments, client.MatchingLabels{"app.kubernetes.io/name": "volsync"}); err != nil {
+		return nil, fmt.Errorf("failed to list VolSync Deployments, %w", err)
+	}
+
+	capability := ramen.ClusterCapability{
+		Name:    capabilityNameVolSync,
+		Status:  ramen.ClusterCapabilityHealthy,
+		Message: "VolSync is installed",
+	}
+
+	for i := range deployments.Items {
+		deployment := &deployments.Items[i]
+
+		capability.Value = deployment.Labels["app.kubernetes.io/version"]
+
+		if deployment.Status.AvailableReplicas == 0 {
+			capability.Status = ramen.ClusterCapabilityUnhealthy
+			capability.Message = fmt.Sprintf("VolSync Deployment %s/%s has no available replicas",
+				deployment.Namespace, deployment.Name)
+		}
+
+		break
+	}
+
+	return []ramen.ClusterCapability{capability}, nil
+}
@@ -0,0 +1,161 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
+	clusterv1alpha1 "open-cluster-management.io/api/cluster/v1alpha1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	ramen "github.com/ramendr/ramen/api/v1alpha1"
+)
+
+type failingCapabilityProvider struct{}
+
+func (failingCapabilityProvider) Name() string { return "Failing" }
+
+func (failingCapabilityProvider) Capabilities(context.Context, client.Reader) ([]ramen.ClusterCapability, error) {
+	return nil, context.DeadlineExceeded
+}
+
+var _ = Describe("Capabilities", func() {
+	reconciler := func(objects ...client.Object) *DRClusterConfigReconciler {
+		scheme := runtime.NewScheme()
+		Expect(clientgoscheme.AddToScheme(scheme)).To(Succeed())
+		Expect(apiextensionsv1.AddToScheme(scheme)).To(Succeed())
+		Expect(clusterv1alpha1.AddToScheme(scheme)).To(Succeed())
+
+		return &DRClusterConfigReconciler{
+			Client:              fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build(),
+			Log:                 logr.Discard(),
+			CapabilityProviders: []CapabilityProvider{failingCapabilityProvider{}},
+		}
+	}
+
+	storageClass := func(name string, drSupported bool) *storagev1.StorageClass {
+		storageClass := &storagev1.StorageClass{ObjectMeta: metav1.ObjectMeta{Name: name}, Provisioner: "p"}
+		if drSupported {
+			storageClass.Labels = map[string]string{StorageIDLabel: "id"}
+		}
+
+		return storageClass
+	}
+
+	storageCapacity := func(name, storageClassName, capacity string) *storagev1.CSIStorageCapacity {
+		return &storagev1.CSIStorageCapacity{
+			ObjectMeta:       metav1.ObjectMeta{Namespace: "csi", Name: name},
+			StorageClassName: storageClassName,
+			Capacity:         ptr.To(resource.MustParse(capacity)),
+		}
+	}
+
+	It("reports the capabilities of all providers, sorted by name", func() {
+		claim := &clusterv1alpha1.ClusterClaim{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   "rbd-mirror.health",
+				Labels: map[string]string{CapabilityLabel: "true"},
+				Annotations: map[string]string{
+					CapabilityStatusAnnotation:  string(ramen.ClusterCapabilityDegraded),
+					CapabilityMessageAnnotation: "peer unreachable",
+				},
+			},
+			Spec: clusterv1alpha1.ClusterClaimSpec{Value: "WARNING"},
+		}
+		unlabeledClaim := &clusterv1alpha1.ClusterClaim{ObjectMeta: metav1.ObjectMeta{Name: "id.k8s.io"}}
+
+		capabilities := reconciler(
+			claim, unlabeledClaim,
+			storageClass("dr", true), storageClass("full", true), storageClass("other", false),
+			storageCapacity("dr-a", "dr", "1Gi"), storageCapacity("dr-b", "dr", "512Mi"),
+			storageCapacity("full", "full", "0"), storageCapacity("other", "other", "1Gi"),
+		).listCapabilities(context.TODO())
+
+		Expect(capabilities).To(HaveExactElements(
+			ramen.ClusterCapability{
+				Name: "Failing", Provider: "Failing", Status: ramen.ClusterCapabilityUnknown,
+				Message: context.DeadlineExceeded.Error(),
+			},
+			ramen.ClusterCapability{
+				Name: "rbd-mirror.health", Provider: "ClusterClaim", Value: "WARNING",
+				Status: ramen.ClusterCapabilityDegraded, Message: "peer unreachable",
+			},
+			ramen.ClusterCapability{
+				Name: "storageclass.capacity.free/dr", Provider: "CSIStorageCapacity", Value: "1536Mi",
+				Status: ramen.ClusterCapabilityHealthy, BlocksFailover: true,
+			},
+			ramen.ClusterCapability{
+				Name: "storageclass.capacity.free/full", Provider: "CSIStorageCapacity", Value: "0",
+				Status: ramen.ClusterCapabilityUnhealthy, BlocksFailover: true,
+				Message: "StorageClass has no free capacity",
+			},
+			ramen.ClusterCapability{
+				Name: "volsync", Provider: "VolSync", Status: ramen.ClusterCapabilityUnknown,
+				Message: "VolSync is not installed",
+			},
+		))
+	})
+
+	It("reports the VolSync version and health once installed", func() {
+		crd := &apiextensionsv1.CustomResourceDefinition{ObjectMeta: metav1.ObjectMeta{Name: volSyncCRDName}}
+		deployment := &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "volsync-system",
+				Name:      "volsync",
+				Labels:    map[string]string{"app.kubernetes.io/name": "volsync", "app.kubernetes.io/version": "0.13.0"},
+			},
+		}
+
+		capabilities, err := volSyncCapabilityProvider{}.Capabilities(context.TODO(), reconciler(crd, deployment).Client)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(capabilities).To(HaveExactElements(ramen.ClusterCapability{
+			Name: "volsync", Value: "0.13.0", Status: ramen.ClusterCapabilityUnhealthy,
+			Message: "VolSync Deployment volsync-system/volsync has no available replicas",
+		}))
+	})
+
+	It("blocks failover to a cluster with unhealthy capabilities that block failover only", func() {
+		drCluster := &ramen.DRCluster{Status: ramen.DRClusterStatus{Capabilities: []ramen.ClusterCapability{
+			{Name: "a", Status: ramen.ClusterCapabilityUnhealthy, Message: "a is down"},
+			{Name: "b", Status: ramen.ClusterCapabilityDegraded, BlocksFailover: true},
+		}}}
+		Expect(failoverBlockingCapabilities(drCluster, nil)).To(BeEmpty())
+
+		drCluster.Status.Capabilities[0].BlocksFailover = true
+		Expect(failoverBlockingCapabilities(drCluster, nil)).To(Equal("a (a is down)"))
+	})
+
+	It("blocks failover for the free capacity of the StorageClasses of the PVCs to fail over only", func() {
+		drCluster := &ramen.DRCluster{Status: ramen.DRClusterStatus{Capabilities: []ramen.ClusterCapability{{
+			Name: capabilityNameStorageClassFreeCapacity + "fast", Status: ramen.ClusterCapabilityUnhealthy,
+			BlocksFailover: true, Message: "StorageClass has no free capacity",
+		}}}}
+		Expect(failoverBlockingCapabilities(drCluster, []string{"slow"})).To(BeEmpty())
+		Expect(failoverBlockingCapabilities(drCluster, []string{"slow", "fast"})).To(
+			Equal("storageclass.capacity.free/fast (StorageClass has no free capacity)"))
+	})
+
+	It("refreshes capabilities periodically only if capacity is reported or providers are registered", func() {
+		r := &DRClusterConfigReconciler{}
+		Expect(r.capabilitiesRefreshed([]ramen.ClusterCapability{{Name: capabilityNameVolSync}})).To(BeFalse())
+		Expect(r.capabilitiesRefreshed([]ramen.ClusterCapability{
+			{Name: capabilityNameStorageClassFreeCapacity + "fast"},
+		})).To(BeTrue())
+
+		r.CapabilityProviders = []CapabilityProvider{failingCapabilityProvider{}}
+		Expect(r.capabilitiesRefreshed(nil)).To(BeTrue())
+	})
+})
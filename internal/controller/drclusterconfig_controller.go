@@ -6,6 +6,7 @@ package controllers
 import (
 	"context"
 	"fmt"
+	"net"
 	"reflect"
 	"slices"
 	"time"
@@ -16,6 +17,7 @@ import (
 	snapv1 "github.com/kubernetes-csi/external-snapshotter/client/v8/apis/volumesnapshot/v1"
 	groupsnapv1beta1 "github.com/red-hat-storage/external-snapshotter/client/v8/apis/volumegroupsnapshot/v1beta1"
 	"golang.org/x/time/rate"
+	corev1 "k8s.io/api/core/v1"
 	storagev1 "k8s.io/api/storage/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -26,6 +28,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	ctrlcontroller "sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
@@ -59,6 +62,13 @@ type DRClusterConfigReconciler struct {
 	Scheme      *runtime.Scheme
 	Log         logr.Logger
 	RateLimiter *workqueue.TypedRateLimiter[reconcile.Request]
+
+	// CapabilityProviders report capabilities of the cluster in addition to the built in providers
+	CapabilityProviders []CapabilityProvider
+
+	// FenceVerifiers report fence verifications of storage backends of the cluster in addition to the built in
+	// verifiers
+	FenceVerifiers []FenceVerifier
 }
 
 //nolint:lll
@@ -71,6 +81,10 @@ type DRClusterConfigReconciler struct {
 // +kubebuilder:rbac:groups=cluster.open-cluster-management.io,resources=clusterclaims,verbs=get;list;watch;create;update;delete
 // +kubebuilder:rbac:groups=csiaddons.openshift.io,resources=networkfenceclasses,verbs=get;list;watch
 // +kubebuilder:rbac:groups=csiaddons.openshift.io,resources=csiaddonsnodes,verbs=get;list;watch
+// +kubebuilder:rbac:groups=core,resources=nodes,verbs=get;list;watch
+// +kubebuilder:rbac:groups=storage.k8s.io,resources=csistoragecapacities,verbs=get;list;watch
+// +kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;list;watch
+// +kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions,verbs=get;list;watch
 
 func (r *DRClusterConfigReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	log := r.Log.WithValues("drcc", req.NamespacedName.Name, "rid", util.GetRID())
@@ -276,7 +290,16 @@ func (r *DRClusterConfigReconciler) processCreateOrUpdate(
 	setDRClusterConfigConfigurationProcessedCondition(&drCConfig.Status.Conditions, drCConfig.Generation,
 		"Configuration processed and validated", metav1.ConditionTrue, DRClusterConfigConditionConfigurationProcessed)
 
-	return ctrl.Result{}, nil
+	result := ctrl.Result{}
+	if r.capabilitiesRefreshed(drCConfig.Status.Capabilities) {
+		result.RequeueAfter = capabilitiesRefreshInterval
+	}
+
+	if len(r.FenceVerifiers) > 0 {
+		result.RequeueAfter = fenceVerificationsRefreshInterval
+	}
+
+	return result, nil
 }
 
 // UpdateStatus updates DRClusterConfig status with a list of storage related classes that are marked for DR
@@ -340,9 +363,66 @@ func (r *DRClusterConfigReconciler) UpdateStatus(
 
 	drCConfig.Status.StorageAccessDetails = storageAccessDetails
 
+	drCConfig.Status.Capabilities = r.listCapabilities(ctx)
+
+	drCConfig.Status.FenceVerifications = r.listFenceVerifications(ctx)
+
+	drCConfig.Status.NodeCIDRs = nil
+
+	if drCConfig.Spec.DiscoverNodeAddresses {
+		if drCConfig.Status.NodeCIDRs, err = r.listNodeCIDRs(ctx); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
+// listNodeCIDRs returns the sorted single address CIDRs of the internal addresses of the cluster's nodes
+func (r *DRClusterConfigReconciler) listNodeCIDRs(ctx context.Context) ([]string, error) {
+	nodes := &corev1.NodeList{}
+	if err := r.Client.List(ctx, nodes); err != nil {
+		return nil, fmt.Errorf("failed to list Nodes, %w", err)
+	}
+
+	cidrs := []string{}
+
+	for i := range nodes.Items {
+		for _, address := range nodes.Items[i].Status.Addresses {
+			if address.Type != corev1.NodeInternalIP {
+				continue
+			}
+
+			cidr, err := addressCIDR(address.Address)
+			if err != nil {
+				r.Log.Info("Skipping node address", "node", nodes.Items[i].Name, "error", err)
+
+				continue
+			}
+
+			cidrs = append(cidrs, cidr)
+		}
+	}
+
+	slices.Sort(cidrs)
+
+	return slices.Compact(cidrs), nil
+}
+
+// addressCIDR returns the CIDR of the address alone, i.e. with a prefix length of 32 for IPv4 or 128 for IPv6
+func addressCIDR(address string) (string, error) {
+	ip := net.ParseIP(address)
+	if ip == nil {
+		return "", fmt.Errorf("invalid IP address %s", address)
+	}
+
+	if ip4 := ip.To4(); ip4 != nil {
+		return (&net.IPNet{IP: ip4, Mask: net.CIDRMask(net.IPv4len*8, net.IPv4len*8)}).String(), nil
+	}
+
+	return (&net.IPNet{IP: ip, Mask: net.CIDRMask(net.IPv6len*8, net.IPv6len*8)}).String(), nil
+}
+
 // listDRSupportedSCs returns a list of StorageClasses that are marked as DR supported
 func (r *DRClusterConfigReconciler) listDRSupportedSCs(ctx context.Context) ([]string, error) {
 	scs := []string{}
@@ -581,5 +661,20 @@ func (r *DRClusterConfigReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		Watches(&groupsnapv1beta1.VolumeGroupSnapshotClass{}, drccMapFn, drccPredFn).
 		Watches(&csiaddonsv1alpha1.NetworkFenceClass{}, drccMapFn, drccPredFn).
 		Watches(&csiaddonsv1alpha1.CSIAddonsNode{}, drccMapFn, drccPredFn).
+		Watches(&corev1.Node{}, drccMapFn, builder.WithPredicates(nodeAddressesPredicate())).
+		Watches(&clusterv1alpha1.ClusterClaim{}, drccMapFn, builder.WithPredicates(reportClaimPredicate())).
 		Complete(r)
 }
+
+// nodeAddressesPredicate filters Node events to those that add or remove nodes or change their addresses, as
+// frequent status updates of nodes, e.g. heartbeats, otherwise trigger needless reconciles
+func nodeAddressesPredicate() predicate.Funcs {
+	return predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			oldNode, oldOk := e.ObjectOld.(*corev1.Node)
+			newNode, newOk := e.ObjectNew.(*corev1.Node)
+
+			return !oldOk || !newOk || !reflect.DeepEqual(oldNode.Status.Addresses, newNode.Status.Addresses)
+		},
+	}
+}
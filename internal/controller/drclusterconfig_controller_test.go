@@ -910,5 +910,34 @@ var _ = Describe("DRClusterConfigControllerTests", Ordered, func() {
 				)
 			})
 		})
+
+		When("node addresses discovery is requested", func() {
+			It("updates DRClusterConfig Status with the CIDRs of the nodes' internal addresses", func() {
+				By("creating a Node")
+
+				node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-1"}}
+				Expect(k8sClient.Create(context.TODO(), node)).To(Succeed())
+
+				node.Status.Addresses = []corev1.NodeAddress{
+					{Type: corev1.NodeInternalIP, Address: "10.0.0.11"},
+					{Type: corev1.NodeExternalIP, Address: "203.0.113.11"},
+					{Type: corev1.NodeHostName, Address: "node-1"},
+				}
+				Expect(k8sClient.Status().Update(context.TODO(), node)).To(Succeed())
+
+				By("requesting node addresses discovery")
+
+				Expect(apiReader.Get(context.TODO(), types.NamespacedName{Name: drCConfig.Name}, drCConfig)).To(Succeed())
+				drCConfig.Spec.DiscoverNodeAddresses = true
+				Expect(k8sClient.Update(context.TODO(), drCConfig)).To(Succeed())
+
+				Eventually(func(g Gomega) {
+					drClusterConfig := &ramen.DRClusterConfig{}
+					g.Expect(apiReader.Get(context.TODO(), types.NamespacedName{Name: drCConfig.Name},
+						drClusterConfig)).To(Succeed())
+					g.Expect(drClusterConfig.Status.NodeCIDRs).To(Equal([]string{"10.0.0.11/32"}))
+				}, timeout, interval).Should(Succeed())
+			})
+		})
 	})
 })
@@ -0,0 +1,123 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clusterv1alpha1 "open-cluster-management.io/api/cluster/v1alpha1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ramen "github.com/ramendr/ramen/api/v1alpha1"
+)
+
+const (
+	// FenceVerificationLabel marks ClusterClaims that report a fence verification, by a storage backend or an agent
+	// on its behalf, with the claim's value as the storage provisioner of the backend
+	FenceVerificationLabel = "ramendr.openshift.io/fence-verification"
+
+	// FenceVerificationCIDRsAnnotation, FenceVerificationActiveClientsAnnotation and
+	// FenceVerificationCheckTimeAnnotation on a ClusterClaim report the comma separated CIDRs whose clients were
+	// checked, the comma separated clients of them that hold open sessions or watchers, if any, and the RFC3339 time
+	// of the check
+	FenceVerificationCIDRsAnnotation         = "ramendr.openshift.io/fence-verification-cidrs"
+	FenceVerificationActiveClientsAnnotation = "ramendr.openshift.io/fence-verification-active-clients"
+	FenceVerificationCheckTimeAnnotation     = "ramendr.openshift.io/fence-verification-check-time"
+
+	// fenceVerificationsRefreshInterval is the interval to refresh the fence verifications of registered verifiers
+	// at, as they are not watched
+	fenceVerificationsRefreshInterval = time.Minute
+)
+
+// FenceVerifier reports whether clients of CIDRs, e.g. of a fenced peer cluster, still hold open sessions or watchers
+// on a storage backend of the cluster, for the hub to verify that the fenced cluster can no longer write
+type FenceVerifier interface {
+	// Name of the verifier
+	Name() string
+
+	// FenceVerifications returns the verifications that the verifier reports
+	FenceVerifications(ctx context.Context, reader client.Reader) ([]ramen.FenceVerification, error)
+}
+
+// fenceVerifiers returns the built in fence verifiers, followed by those registered with the reconciler
+func (r *DRClusterConfigReconciler) fenceVerifiers() []FenceVerifier {
+	return append([]FenceVerifier{clusterClaimFenceVerifier{}}, r.FenceVerifiers...)
+}
+
+// listFenceVerifications returns the verifications that all verifiers report, sorted by storage provisioner and
+// CIDRs. A verifier that fails to report is skipped, as the CIDRs it would verify are then not verified.
+func (r *DRClusterConfigReconciler) listFenceVerifications(ctx context.Context) []ramen.FenceVerification {
+	verifications := []ramen.FenceVerification{}
+
+	for _, verifier := range r.fenceVerifiers() {
+		verifierVerifications, err := verifier.FenceVerifications(ctx, r.Client)
+		if err != nil {
+			r.Log.Info("Failed to list fence verifications", "verifier", verifier.Name(), "error", err)
+
+			continue
+		}
+
+		verifications = append(verifications, verifierVerifications...)
+	}
+
+	slices.SortFunc(verifications, func(a, b ramen.FenceVerification) int {
+		return slices.Compare(append([]string{a.StorageProvisioner}, a.CIDRs...),
+			append([]string{b.StorageProvisioner}, b.CIDRs...))
+	})
+
+	return verifications
+}
+
+// clusterClaimFenceVerifier reports the fence verifications of ClusterClaims that carry the fence verification label
+type clusterClaimFenceVerifier struct{}
+
+func (clusterClaimFenceVerifier) Name() string { return "ClusterClaim" }
+
+func (clusterClaimFenceVerifier) FenceVerifications(ctx context.Context, reader client.Reader,
+) ([]ramen.FenceVerification, error) {
+	claims := &clusterv1alpha1.ClusterClaimList{}
+	if err := reader.List(ctx, claims, client.HasLabels{FenceVerificationLabel}); err != nil {
+		return nil, fmt.Errorf("failed to list ClusterClaims, %w", err)
+	}
+
+	verifications := make([]ramen.FenceVerification, 0, len(claims.Items))
+
+	for i := range claims.Items {
+		claim := &claims.Items[i]
+		annotations := claim.GetAnnotations()
+
+		checkTime, err := time.Parse(time.RFC3339, annotations[FenceVerificationCheckTimeAnnotation])
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s annotation on ClusterClaim %s, %w",
+				FenceVerificationCheckTimeAnnotation, claim.Name, err)
+		}
+
+		verifications = append(verifications, ramen.FenceVerification{
+			StorageProvisioner: claim.Spec.Value,
+			CIDRs:              slices.Sorted(slices.Values(splitList(annotations[FenceVerificationCIDRsAnnotation]))),
+			ActiveClients:      splitList(annotations[FenceVerificationActiveClientsAnnotation]),
+			CheckTime:          metav1.NewTime(checkTime.Local()),
+		})
+	}
+
+	return verifications, nil
+}
+
+// splitList returns the trimmed, non-empty elements of a comma separated list
+func splitList(list string) []string {
+	var elements []string
+
+	for _, element := range strings.Split(list, ",") {
+		if element = strings.TrimSpace(element); element != "" {
+			elements = append(elements, element)
+		}
+	}
+
+	return elements
+}
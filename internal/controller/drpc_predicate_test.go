@@ -207,6 +207,21 @@ var _ = Describe("DRPCPredicateDRCluster", func() {
 			})
 		})
 
+		When("new has a relocate maintenance mode activated, that only had failover activated in old", func() {
+			It("returns DRCluster update of interest as true", func() {
+				drClusterNew.Status.MaintenanceModes[0].Conditions = append(
+					drClusterNew.Status.MaintenanceModes[0].Conditions, metav1.Condition{
+						Type:               string(rmn.MModeConditionRelocateActivated),
+						Status:             metav1.ConditionTrue,
+						LastTransitionTime: metav1.NewTime(baseTime),
+						Reason:             string(rmn.MModeStateCompleted),
+						Message:            "testing",
+					})
+
+				Expect(controllers.DRClusterUpdateOfInterest(drClusterOld, drClusterNew)).To(BeTrue())
+			})
+		})
+
 		When("new has maintenance mode activated, and all maintenance modes were deactivated in old", func() {
 			It("returns DRCluster update of interest as true", func() {
 				drClusterOld.Status.MaintenanceModes = nil
@@ -108,15 +108,37 @@ func (d *DRPCInstance) startProcessing() bool {
 func (d *DRPCInstance) processPlacement() (bool, error) {
 	d.log.Info("Process DRPC Placement", "DRAction", d.instance.Spec.Action)
 
+	if err := d.schedulingIntervalChangeValidate(); err != nil {
+		addOrUpdateCondition(&d.instance.Status.Conditions, rmn.ConditionAvailable, d.instance.Generation,
+			d.getConditionStatusForTypeAvailable(), string(d.instance.Status.Phase), err.Error())
+
+		return false, err
+	}
+
+	if err := d.ensureDRPolicyMigrationStarted(); err != nil {
+		return false, err
+	}
+
+	var (
+		done bool
+		err  error
+	)
+
 	switch d.instance.Spec.Action {
 	case rmn.ActionFailover:
-		return d.RunFailover()
+		done, err = d.RunFailover()
 	case rmn.ActionRelocate:
-		return d.RunRelocate()
+		done, err = d.RunRelocate()
+	default:
+		// Not a failover or a relocation.  Must be an initial deployment.
+		done, err = d.RunInitialDeployment()
+	}
+
+	if !done || err != nil {
+		return done, err
 	}
 
-	// Not a failover or a relocation.  Must be an initial deployment.
-	return d.RunInitialDeployment()
+	return d.ensureDRPolicyMigrationCompleted()
 }
 
 //nolint:funlen
@@ -525,6 +547,13 @@ func (d *DRPCInstance) getCurrentHomeClusterName(toCluster string, drClusters []
 		return d.instance.Status.PreferredDecision.ClusterName
 	}
 
+	// otherwise, return the peer cluster where the VRG is primary, as the policy may have more than one peer
+	for clusterName, vrg := range d.vrgs {
+		if clusterName != toCluster && vrg != nil && isVRGPrimary(vrg) {
+			return clusterName
+		}
+	}
+
 	// otherwise, just return the peer cluster
 	for i := range drClusters {
 		if drClusters[i].Name != toCluster {
@@ -547,10 +576,21 @@ func (d *DRPCInstance) checkFailoverPrerequisites(curHomeCluster string) (bool,
 		err error
 	)
 
-	if d.drType == DRTypeSync {
-		met, err = d.checkMetroFailoverPrerequisites(curHomeCluster)
-	} else {
-		met = d.checkRegionalFailoverPrerequisites()
+	err = d.checkFailoverClusterCapabilities()
+	if err == nil {
+		err = d.checkFailoverClusterConstraints(curHomeCluster)
+	}
+
+	if err == nil {
+		if d.drType == DRTypeSync {
+			met, err = d.checkMetroFailoverPrerequisites(curHomeCluster)
+		} else {
+			met = d.checkRegionalFailoverPrerequisites()
+		}
+	}
+
+	if err == nil && met {
+		err = d.checkFailoverFenceVerified(curHomeCluster)
 	}
 
 	if err == nil && met {
@@ -572,6 +612,63 @@ func (d *DRPCInstance) checkFailoverPrerequisites(curHomeCluster string) (bool,
 	return met, err
 }
 
+// checkFailoverClusterCapabilities checks that the failoverCluster reports no unhealthy capabilities that block
+// failover to it, unless the DRPC ignores them
+func (d *DRPCInstance) checkFailoverClusterCapabilities() error {
+	if d.instance.GetAnnotations()[IgnoreUnhealthyCapabilitiesAnnotation] == IgnoreUnhealthyCapabilitiesAnnotationVal {
+		return nil
+	}
+
+	for i := range d.drClusters {
+		if d.drClusters[i].Name != d.instance.Spec.FailoverCluster {
+			continue
+		}
+
+		if blocking := failoverBlockingCapabilities(&d.drClusters[i], d.protectedStorageClassNames()); blocking != "" {
+			return fmt.Errorf("failover cluster %s reports unhealthy capabilities: %s", d.drClusters[i].Name, blocking)
+		}
+	}
+
+	return nil
+}
+
+// protectedStorageClassNames returns the names of the StorageClasses of the PVCs that the VRGs protect
+func (d *DRPCInstance) protectedStorageClassNames() []string {
+	storageClassNames := []string{}
+
+	for _, vrg := range d.vrgs {
+		if vrg == nil {
+			continue
+		}
+
+		for i := range vrg.Status.ProtectedPVCs {
+			storageClassName := vrg.Status.ProtectedPVCs[i].StorageClassName
+			if storageClassName != nil && !slices.Contains(storageClassNames, *storageClassName) {
+				storageClassNames = append(storageClassNames, *storageClassName)
+			}
+		}
+	}
+
+	return storageClassNames
+}
+
+// checkFailoverClusterConstraints checks that the failoverCluster satisfies the placement constraints of the
+// DRPolicy as a failover target of the curHomeCluster
+func (d *DRPCInstance) checkFailoverClusterConstraints(curHomeCluster string) error {
+	primary := findDRCluster(d.drClusters, curHomeCluster)
+	target := findDRCluster(d.drClusters, d.instance.Spec.FailoverCluster)
+
+	if primary == nil || target == nil {
+		return nil
+	}
+
+	if err := checkPlacementConstraints(d.drPolicy.Spec.PlacementConstraints, primary, target); err != nil {
+		return fmt.Errorf("failover cluster violates DRPolicy placementConstraints: %w", err)
+	}
+
+	return nil
+}
+
 // checkMetroFailoverPrerequisites checks for any MetroDR failover prerequisites that need to be met on the
 // failoverCluster before initiating a failover from the curHomeCluster.
 // Returns:
@@ -594,6 +691,22 @@ func (d *DRPCInstance) checkMetroFailoverPrerequisites(curHomeCluster string) (b
 	return met, nil
 }
 
+// checkFailoverFenceVerified checks that the fencing of the curHomeCluster is verified, if it is fenced, whatever
+// the DR type of the failover
+func (d *DRPCInstance) checkFailoverFenceVerified(curHomeCluster string) error {
+	for i := range d.drClusters {
+		if d.drClusters[i].Name != curHomeCluster || d.drClusters[i].Spec.ClusterFence != rmn.ClusterFenceStateFenced {
+			continue
+		}
+
+		if verified, reason := drClusterFenceVerified(&d.drClusters[i]); !verified {
+			return fmt.Errorf("current home cluster %s fencing is not verified: %s", curHomeCluster, reason)
+		}
+	}
+
+	return nil
+}
+
 // checkRegionalFailoverPrerequisites checks for any RegionalDR failover prerequisites that need to be met on the
 // failoverCluster before initiating a failover.
 // Returns:
@@ -654,12 +767,21 @@ func requiresRegionalFailoverPrerequisites(
 		}
 	}
 
-	for _, protectedPVC := range vrg.Status.ProtectedPVCs {
-		if len(protectedPVC.StorageIdentifiers.ReplicationID.Modes) == 0 {
-			continue
-		}
+	activationsRequired = mModeActivationsRequiredByVRG(vrg, rmn.MModeFailover)
 
-		if !slices.Contains(protectedPVC.StorageIdentifiers.ReplicationID.Modes, rmn.MModeFailover) {
+	return len(activationsRequired) != 0, activationsRequired
+}
+
+// mModeActivationsRequiredByVRG returns the storage identifiers of the protected PVCs of the VRG that require the
+// maintenance mode, keyed by <ProvisionerName>+<ReplicationID>
+func mModeActivationsRequiredByVRG(
+	vrg *rmn.VolumeReplicationGroup,
+	mode rmn.MMode,
+) map[string]rmn.StorageIdentifiers {
+	activationsRequired := map[string]rmn.StorageIdentifiers{}
+
+	for _, protectedPVC := range vrg.Status.ProtectedPVCs {
+		if !slices.Contains(protectedPVC.StorageIdentifiers.ReplicationID.Modes, mode) {
 			continue
 		}
 
@@ -670,7 +792,7 @@ func requiresRegionalFailoverPrerequisites(
 		}
 	}
 
-	return len(activationsRequired) != 0, activationsRequired
+	return activationsRequired
 }
 
 // getLastKnownPrimaryVRG gets the last known Primary VRG from the cluster that is not the current targetCluster
@@ -755,12 +877,21 @@ func GetLastKnownVRGPrimaryFromS3(
 func checkFailoverMaintenanceActivations(drCluster rmn.DRCluster,
 	activationsRequired map[string]rmn.StorageIdentifiers,
 	log logr.Logger,
+) bool {
+	return checkMaintenanceActivations(drCluster, activationsRequired, rmn.MModeFailover, log)
+}
+
+// checkMaintenanceActivations checks if all required storage backend maintenance activations of the mode are met
+func checkMaintenanceActivations(drCluster rmn.DRCluster,
+	activationsRequired map[string]rmn.StorageIdentifiers,
+	mode rmn.MMode,
+	log logr.Logger,
 ) bool {
 	for _, activationRequired := range activationsRequired {
 		if !checkActivationForStorageIdentifier(
 			drCluster.Status.MaintenanceModes,
 			activationRequired,
-			rmn.MModeConditionFailoverActivated,
+			mModeActivatedCondition(mode),
 			log,
 		) {
 			return false
@@ -770,6 +901,72 @@ func checkFailoverMaintenanceActivations(drCluster rmn.DRCluster,
 	return true
 }
 
+// mModeActivatedCondition returns the condition type that reports the maintenance mode as activated
+func mModeActivatedCondition(mode rmn.MMode) rmn.MModeStatusConditionType {
+	return rmn.MModeStatusConditionType(string(mode) + "Activated")
+}
+
+// checkRelocateMaintenanceActivations checks if the storage backend maintenance modes that the workload requires to
+// relocate from the home cluster are activated on it. They are required while its VRG is primary, i.e. until the
+// final sync is complete.
+func (d *DRPCInstance) checkRelocateMaintenanceActivations(homeCluster string) bool {
+	vrg, ok := d.vrgs[homeCluster]
+	if !ok || !isVRGPrimary(vrg) {
+		return true
+	}
+
+	activationsRequired := mModeActivationsRequiredByVRG(vrg, rmn.MModeRelocate)
+	if len(activationsRequired) == 0 {
+		return true
+	}
+
+	for _, drCluster := range d.drClusters {
+		if drCluster.Name != homeCluster {
+			continue
+		}
+
+		if checkMaintenanceActivations(drCluster, activationsRequired, rmn.MModeRelocate, d.log) {
+			return true
+		}
+
+		break
+	}
+
+	d.setProgression(rmn.ProgressionWaitForStorageMaintenanceActivation)
+	addOrUpdateCondition(&d.instance.Status.Conditions, rmn.ConditionAvailable, d.instance.Generation,
+		d.getConditionStatusForTypeAvailable(), string(d.instance.Status.Phase),
+		fmt.Sprintf("Waiting for storage maintenance mode %s activation on cluster %s", rmn.MModeRelocate, homeCluster))
+
+	return false
+}
+
+// ensureResyncMaintenanceActivations returns an error until the storage backend maintenance modes that the workload
+// requires to resync its volumes on the peers of the cluster it failed over or relocated to are activated on them
+func (d *DRPCInstance) ensureResyncMaintenanceActivations(srcCluster string) error {
+	vrg, ok := d.vrgs[srcCluster]
+	if !ok {
+		return nil
+	}
+
+	activationsRequired := mModeActivationsRequiredByVRG(vrg, rmn.MModeResync)
+	if len(activationsRequired) == 0 {
+		return nil
+	}
+
+	for _, drCluster := range d.drClusters {
+		if drCluster.Name == srcCluster {
+			continue
+		}
+
+		if !checkMaintenanceActivations(drCluster, activationsRequired, rmn.MModeResync, d.log) {
+			return fmt.Errorf("waiting for storage maintenance mode %s activation on cluster %s",
+				rmn.MModeResync, drCluster.Name)
+		}
+	}
+
+	return nil
+}
+
 // checkActivationForStorageIdentifier checks if provided storageIdentifier failover maintenance mode is
 // in an activated state as reported in the passed in ClusterMaintenanceMode list
 func checkActivationForStorageIdentifier(
@@ -893,6 +1090,10 @@ func (d *DRPCInstance) RunRelocate() (bool, error) {
 	}
 
 	if curHomeCluster != "" && curHomeCluster != preferredCluster {
+		if !d.checkRelocateMaintenanceActivations(curHomeCluster) {
+			return !done, nil
+		}
+
 		result, err := d.quiesceAndRunFinalSync(curHomeCluster)
 		if err != nil {
 			return !done, err
@@ -969,6 +1170,12 @@ func (d *DRPCInstance) ensureCleanupAndSecondaryReplicationSetup(srcCluster stri
 		return err
 	}
 
+	// Once peers are secondary, their storage may have to prepare to resync from the srcCluster
+	err = d.ensureResyncMaintenanceActivations(srcCluster)
+	if err != nil {
+		return err
+	}
+
 	// After we ensured peers are clean, The VolSync ReplicationSource (RS) will automatically get
 	// created, but for the ReplicationDestination, we need to explicitly tell the VRG to create it.
 	err = d.EnsureSecondaryReplicationSetup(srcCluster)
@@ -1607,7 +1814,7 @@ func equalClusterIDSlices(a, b []string) bool {
 //nolint:gocognit,cyclop
 func updatePeerClass(log logr.Logger, to []rmn.PeerClass, from rmn.PeerClass, scName string, cgAnnotationExists bool) {
 	for toIdx := range to {
-		if (to[toIdx].StorageClassName != scName) ||
+		if !peerClassHasStorageClassName(&to[toIdx], scName) ||
 			(!equalClusterIDSlices(to[toIdx].ClusterIDs, from.ClusterIDs)) {
 			continue
 		}
@@ -1647,7 +1854,7 @@ func updatePeerClass(log logr.Logger, to []rmn.PeerClass, from rmn.PeerClass, sc
 // Also see peerClassMatchesPeer
 func hasPeerClass(vrgPeerClasses []rmn.PeerClass, scName string, clusterIDs []string) bool {
 	for peerClassVRGIdx := range vrgPeerClasses {
-		if (vrgPeerClasses[peerClassVRGIdx].StorageClassName == scName) &&
+		if peerClassHasStorageClassName(&vrgPeerClasses[peerClassVRGIdx], scName) &&
 			(equalClusterIDSlices(vrgPeerClasses[peerClassVRGIdx].ClusterIDs, clusterIDs)) {
 			return true
 		}
@@ -1672,8 +1879,8 @@ func updatePeers(
 		}
 
 		for policyPeerClassIdx := range policyPeerClasses {
-			if policyPeerClasses[policyPeerClassIdx].StorageClassName !=
-				*vrgFromView.Status.ProtectedPVCs[pvcIdx].StorageClassName {
+			if !peerClassHasStorageClassName(&policyPeerClasses[policyPeerClassIdx],
+				*vrgFromView.Status.ProtectedPVCs[pvcIdx].StorageClassName) {
 				continue
 			}
 
@@ -1712,9 +1919,9 @@ func updatePeers(
 }
 
 // updateVRGAsyncSpec see updateVRGDRTypeSpec
-func (d *DRPCInstance) updateVRGAsyncSpec(vrgFromView, vrg *rmn.VolumeReplicationGroup) {
+func (d *DRPCInstance) updateVRGAsyncSpec(vrgFromView, vrg *rmn.VolumeReplicationGroup, homeCluster string) {
 	// vrg will be updated with final contents of asyncSpec
-	asyncSpec := d.newVRGSpecAsync()
+	asyncSpec := d.newVRGSpecAsync(homeCluster)
 	if len(asyncSpec.PeerClasses) == 0 {
 		// Retain peerClasses from VRG
 		if vrg.Spec.Async != nil && len(vrg.Spec.Async.PeerClasses) != 0 {
@@ -1740,7 +1947,7 @@ func (d *DRPCInstance) updateVRGAsyncSpec(vrgFromView, vrg *rmn.VolumeReplicatio
 		d.log,
 		vrgFromView,
 		vrg.Spec.Async.PeerClasses,
-		d.drPolicy.Status.Async.PeerClasses,
+		d.asyncPeerClasses(),
 		cgAnnotationExists,
 	)
 
@@ -1790,12 +1997,14 @@ func (d *DRPCInstance) updateVRGSyncSpec(vrgFromView, vrg *rmn.VolumeReplication
 // Volsync and VolumeSnapshots), then it is not updated with a peerClass that NOW supports native VolumeReplication,
 // as that would void existing protection. To change replication schemes a workload needs to be DR disabled and then
 // reenabled to catch up to the latest available peer information for an SC.
-func (d *DRPCInstance) updateVRGDRTypeSpec(vrgFromCluster, generatedVRG *rmn.VolumeReplicationGroup) {
+func (d *DRPCInstance) updateVRGDRTypeSpec(vrgFromCluster, generatedVRG *rmn.VolumeReplicationGroup,
+	homeCluster string,
+) {
 	switch d.drType {
 	case DRTypeSync:
 		d.updateVRGSyncSpec(vrgFromCluster, generatedVRG)
 	case DRTypeAsync:
-		d.updateVRGAsyncSpec(vrgFromCluster, generatedVRG)
+		d.updateVRGAsyncSpec(vrgFromCluster, generatedVRG, homeCluster)
 	}
 }
 
@@ -1822,8 +2031,11 @@ func (d *DRPCInstance) updateVRGOptionalFields(vrg, vrgFromView *rmn.VolumeRepli
 
 	vrg.Spec.ProtectedNamespaces = d.instance.Spec.ProtectedNamespaces
 	vrg.Spec.S3Profiles = AvailableS3Profiles(d.drClusters)
+	vrg.Spec.S3WriteQuorum = d.instance.Spec.S3WriteQuorum
 	vrg.Spec.KubeObjectProtection = d.instance.Spec.KubeObjectProtection
 	vrg.Spec.VolSync.Disabled = d.volSyncDisabled
+	vrg.Spec.ReplicationMethodOverrides = d.instance.Spec.ReplicationMethodOverrides
+	vrg.Spec.SplitBrain = d.instance.Spec.SplitBrain
 	d.setVRGAction(vrg)
 
 	// If vrgFromView nil, then vrg is newly generated, Sync/Async spec is updated unconditionally
@@ -1832,10 +2044,14 @@ func (d *DRPCInstance) updateVRGOptionalFields(vrg, vrgFromView *rmn.VolumeRepli
 		case DRTypeSync:
 			vrg.Spec.Sync = d.newVRGSpecSync()
 		case DRTypeAsync:
-			vrg.Spec.Async = d.newVRGSpecAsync()
+			vrg.Spec.Async = d.newVRGSpecAsync(homeCluster)
 		}
 	} else {
-		d.updateVRGDRTypeSpec(vrgFromView, vrg)
+		d.updateVRGDRTypeSpec(vrgFromView, vrg, homeCluster)
+	}
+
+	if vrg.Spec.Async != nil {
+		vrg.Spec.Async.StorageClassMapping = vrgStorageClassMapping(d.drPolicy.Spec.StorageClassMapping, homeCluster)
 	}
 
 	// Workaround for cephfs issue: FIXME:
@@ -1844,6 +2060,7 @@ func (d *DRPCInstance) updateVRGOptionalFields(vrg, vrgFromView *rmn.VolumeRepli
 	// Populate ReplicationSource and ReplicationDestination specs with MoverSecurityContext and MoverServiceAccount
 	if d.instance.Spec.VolSyncSpec != nil && d.drType == DRTypeAsync {
 		d.updateMoverConfig(vrg)
+		d.updateUnmountedPVCMode(vrg)
 	}
 }
 
@@ -1856,6 +2073,11 @@ func (d *DRPCInstance) updateMoverConfig(vrg *rmn.VolumeReplicationGroup) {
 	vrg.Spec.VolSync.MoverConfig = append([]rmn.MoverConfig(nil), d.instance.Spec.VolSyncSpec.MoverConfig...)
 }
 
+// updateUnmountedPVCMode propagates how unmounted PVCs are prepared for replication to the VRG
+func (d *DRPCInstance) updateUnmountedPVCMode(vrg *rmn.VolumeReplicationGroup) {
+	vrg.Spec.VolSync.UnmountedPVCMode = d.instance.Spec.VolSyncSpec.UnmountedPVCMode
+}
+
 func (d *DRPCInstance) ensurePlacement(homeCluster string) error {
 	clusterDecision := d.reconciler.getClusterDecision(d.userPlacement)
 	if clusterDecision.ClusterName == "" ||
@@ -1912,14 +2134,96 @@ func (d *DRPCInstance) newVRG(
 	return vrg
 }
 
-func (d *DRPCInstance) newVRGSpecAsync() *rmn.VRGAsyncSpec {
+// newVRGSpecAsync returns the async spec for the VRG on the passed in cluster, which replicates at the interval of
+// the scheduling tier of the instance, if any, or else at the shortest scheduling interval across its peers in the
+// policy
+func (d *DRPCInstance) newVRGSpecAsync(cluster string) *rmn.VRGAsyncSpec {
+	schedulingInterval := rmnutil.DRPolicyClusterSchedulingInterval(d.drPolicy, cluster)
+	if tier := rmnutil.DRPolicySchedulingTier(d.drPolicy, d.instance.Spec.SchedulingTier); tier != nil {
+		schedulingInterval = tier.SchedulingInterval
+	}
+
 	return &rmn.VRGAsyncSpec{
 		ReplicationClassSelector:         d.drPolicy.Spec.ReplicationClassSelector,
 		VolumeSnapshotClassSelector:      d.drPolicy.Spec.VolumeSnapshotClassSelector,
 		VolumeGroupSnapshotClassSelector: d.drPolicy.Spec.VolumeGroupSnapshotClassSelector,
-		SchedulingInterval:               d.drPolicy.Spec.SchedulingInterval,
-		PeerClasses:                      d.drPolicy.Status.Async.PeerClasses,
+		SchedulingInterval:               schedulingInterval,
+		PeerClasses:                      d.asyncPeerClasses(),
+	}
+}
+
+// asyncPeerClasses returns the async peer classes of the policy for the scheduling tier of the instance, if any
+func (d *DRPCInstance) asyncPeerClasses() []rmn.PeerClass {
+	if d.instance.Spec.SchedulingTier == "" {
+		return d.drPolicy.Status.Async.PeerClasses
+	}
+
+	for idx := range d.drPolicy.Status.Async.Tiers {
+		if d.drPolicy.Status.Async.Tiers[idx].Name == d.instance.Spec.SchedulingTier {
+			return d.drPolicy.Status.Async.Tiers[idx].PeerClasses
+		}
+	}
+
+	return nil
+}
+
+// schedulingIntervalChangeValidate rejects a change of the scheduling interval of the existing VRGs of the instance,
+// such as by the selection of another scheduling tier, if they protect any PVC using VolumeReplication, as the
+// VolumeReplicationClass that determines the schedule of a VolumeReplication is immutable. The schedule of PVCs
+// protected using VolSync changes in place.
+func (d *DRPCInstance) schedulingIntervalChangeValidate() error {
+	volRep := false
+
+	for _, vrg := range d.vrgs {
+		volRep = volRep || vrg != nil && slices.ContainsFunc(vrg.Status.ProtectedPVCs, func(pvc rmn.ProtectedPVC) bool {
+			return !pvc.ProtectedByVolSync
+		})
+	}
+
+	if !volRep {
+		return nil
 	}
+
+	for cluster, vrg := range d.vrgs {
+		if vrg == nil || vrg.Spec.Async == nil {
+			continue
+		}
+
+		if schedulingInterval := d.newVRGSpecAsync(cluster).SchedulingInterval; schedulingInterval !=
+			vrg.Spec.Async.SchedulingInterval {
+			return fmt.Errorf("scheduling interval of VRG on cluster %s cannot change from %s to %s, as it protects "+
+				"PVCs using VolumeReplication", cluster, vrg.Spec.Async.SchedulingInterval, schedulingInterval)
+		}
+	}
+
+	return nil
+}
+
+// vrgStorageClassMapping returns the names of StorageClasses on the peer clusters mapped to the names of the
+// StorageClasses on the passed in cluster, as per the DRPolicy StorageClassMapping list
+func vrgStorageClassMapping(mappings []rmn.StorageClassMapping, cluster string) map[string]string {
+	var scMapping map[string]string
+
+	for _, mapping := range mappings {
+		var peerSCName, scName string
+
+		switch cluster {
+		case mapping.TargetCluster:
+			peerSCName, scName = mapping.SourceStorageClassName, mapping.TargetStorageClassName
+		case mapping.SourceCluster:
+			peerSCName, scName = mapping.TargetStorageClassName, mapping.SourceStorageClassName
+		default:
+			continue
+		}
+
+		if scMapping == nil {
+			scMapping = map[string]string{}
+		}
+
+		scMapping[peerSCName] = scName
+	}
+
+	return scMapping
 }
 
 func (d *DRPCInstance) newVRGSpecSync() *rmn.VRGSyncSpec {
@@ -2344,7 +2648,9 @@ func (d *DRPCInstance) updateVRGState(clusterName string, state rmn.ReplicationS
 			clusterName, err)
 	}
 
-	if vrg.Spec.ReplicationState == state {
+	// The split-brain spec is updated along with the state, as its acknowledgement is given after the VRG is
+	// demoted
+	if vrg.Spec.ReplicationState == state && reflect.DeepEqual(vrg.Spec.SplitBrain, d.instance.Spec.SplitBrain) {
 		d.log.Info(fmt.Sprintf("VRG.Spec.ReplicationState %s already set to %s on cluster %s",
 			vrg.Name, state, clusterName))
 
@@ -2352,6 +2658,7 @@ func (d *DRPCInstance) updateVRGState(clusterName string, state rmn.ReplicationS
 	}
 
 	vrg.Spec.ReplicationState = state
+	vrg.Spec.SplitBrain = d.instance.Spec.SplitBrain
 	if state == rmn.Secondary {
 		// Turn off the final sync flags
 		vrg.Spec.PrepareForFinalSync = false
@@ -2606,6 +2913,10 @@ func (d *DRPCInstance) shouldUpdateStatus() bool {
 		return true
 	}
 
+	if !reflect.DeepEqual(vrg.Status.GroupSyncProgress, d.instance.Status.GroupSyncProgress) {
+		return true
+	}
+
 	if vrg.Status.KubeObjectProtection.CaptureToRecoverFrom != nil {
 		vrgKubeObjectProtectionTime := vrg.Status.KubeObjectProtection.CaptureToRecoverFrom.EndTime
 		if !vrgKubeObjectProtectionTime.Equal(d.instance.Status.LastKubeObjectProtectionTime) {
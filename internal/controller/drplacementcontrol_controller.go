@@ -64,6 +64,11 @@ const (
 
 	IsSubmarinerEnabledAnnotation    = "drplacementcontrol.ramendr.openshift.io/is-submariner-enabled"
 	IsSubmarinerEnabledAnnotationVal = "true"
+
+	// IgnoreUnhealthyCapabilitiesAnnotation lets a DRPC fail over to a cluster that reports unhealthy capabilities
+	// that block failover, e.g. a StorageClass without free capacity
+	IgnoreUnhealthyCapabilitiesAnnotation    = "drplacementcontrol.ramendr.openshift.io/ignore-unhealthy-capabilities"
+	IgnoreUnhealthyCapabilitiesAnnotationVal = "true"
 )
 
 var ErrInitialWaitTimeForDRPCPlacementRule = errors.New("waiting for DRPC Placement to produces placement decision")
@@ -227,6 +232,18 @@ func (r *DRPlacementControlReconciler) Reconcile(ctx context.Context, req ctrl.R
 		return ctrl.Result{Requeue: true}, nil
 	}
 
+	updated, err = r.ensureFailoverCluster(ctx, drpc, drPolicy, logger)
+	if err != nil {
+		r.recordFailure(ctx, drpc, placementObj, "Error", err.Error(), logger)
+
+		return ctrl.Result{}, err
+	}
+
+	if updated {
+		// Reload before proceeding
+		return ctrl.Result{Requeue: true}, nil
+	}
+
 	// Rebuild DRPC state if needed
 	requeue, err := r.ensureDRPCStatusConsistency(ctx, drpc, drPolicy, placementObj, logger)
 	if err != nil {
@@ -339,6 +356,24 @@ func (r *DRPlacementControlReconciler) setLastSyncBytesMetric(syncDataBytesMetri
 	syncDataBytesMetrics.LastSyncDataBytes.Set(float64(*b))
 }
 
+func (r *DRPlacementControlReconciler) setSyncFailedAttemptBytesMetric(
+	syncFailedAttemptBytesMetrics *SyncFailedAttemptBytesMetrics, progress *rmn.SyncProgress, log logr.Logger,
+) {
+	if syncFailedAttemptBytesMetrics == nil {
+		return
+	}
+
+	log.Info(fmt.Sprintf("setting metric: (%s)", SyncFailedAttemptBytes))
+
+	if progress == nil || progress.BytesTransferred == nil {
+		syncFailedAttemptBytesMetrics.SyncFailedAttemptBytes.Set(0)
+
+		return
+	}
+
+	syncFailedAttemptBytesMetrics.SyncFailedAttemptBytes.Set(float64(*progress.BytesTransferred))
+}
+
 // setWorkloadProtectionMetric sets the workload protection info metric, where 0 indicates not protected and
 // 1 indicates protected
 func (r *DRPlacementControlReconciler) setWorkloadProtectionMetric(workloadProtectionMetrics *WorkloadProtectionMetrics,
@@ -455,6 +490,13 @@ func (r *DRPlacementControlReconciler) createDRPCInstance(
 		log.Info("volsync is set to disabled")
 	}
 
+	// VolSync does not replicate to more than one destination
+	if len(rmnutil.DRPolicyClusterNames(drPolicy)) > 2 { //nolint:mnd
+		d.volSyncDisabled = true
+
+		log.Info("volsync is set to disabled for a policy of more than two clusters")
+	}
+
 	if !d.volSyncDisabled && drpcInAdminNamespace(drpc, ramenConfig) {
 		d.volSyncDisabled = !ramenConfig.MultiNamespace.VolsyncSupported
 	}
@@ -477,10 +519,14 @@ func (r *DRPlacementControlReconciler) createSyncMetricsInstance(
 	syncDataBytesLabels := SyncDataBytesMetricLabels(drPolicy, drpc)
 	syncDataMetrics := NewSyncDataBytesMetric(syncDataBytesLabels)
 
+	syncFailedAttemptBytesLabels := SyncFailedAttemptBytesMetricLabels(drPolicy, drpc)
+	syncFailedAttemptMetrics := NewSyncFailedAttemptBytesMetric(syncFailedAttemptBytesLabels)
+
 	return &SyncMetrics{
-		SyncTimeMetrics:      syncTimeMetrics,
-		SyncDurationMetrics:  syncDurationMetrics,
-		SyncDataBytesMetrics: syncDataMetrics,
+		SyncTimeMetrics:            syncTimeMetrics,
+		SyncDurationMetrics:        syncDurationMetrics,
+		SyncDataBytesMetrics:       syncDataMetrics,
+		SyncFailedAttemptBytesMetrics: syncFailedAttemptMetrics,
 	}
 }
 
@@ -578,6 +624,11 @@ func (r *DRPlacementControlReconciler) getAndEnsureValidDRPolicy(ctx context.Con
 		return nil, fmt.Errorf("DRPolicy not valid %w", err)
 	}
 
+	if drpc.Spec.SchedulingTier != "" && rmnutil.DRPolicySchedulingTier(drPolicy, drpc.Spec.SchedulingTier) == nil {
+		return nil, fmt.Errorf("drPolicy '%s' referred by the DRPC has no scheduling tier %s",
+			drpc.Spec.DRPolicyRef.Name, drpc.Spec.SchedulingTier)
+	}
+
 	return drPolicy, nil
 }
 
@@ -726,6 +777,23 @@ func (r *DRPlacementControlReconciler) finalizeDRPC(ctx context.Context, drpc *r
 		return fmt.Errorf("failed to clean up volsync secret-related resources (%w)", err)
 	}
 
+	if err := volsync.CleanupSecretPropagationManifestWork(&mwu, rmnutil.DRPolicyClusterNames(drPolicy)); err != nil {
+		return fmt.Errorf("failed to clean up volsync secret ManifestWorks (%w)", err)
+	}
+
+	// cleanup for clusters retiring from an incomplete DRPolicy migration
+	if drpc.Status.DRPolicyMigration != nil {
+		retired, err := r.retireClusters(mwu, drpc, vrgNamespace, drpc.Status.DRPolicyMigration.RetiringClusters, log)
+		if err != nil {
+			return err
+		}
+
+		if !retired {
+			return fmt.Errorf("waiting for VRG deletion on retiring clusters %v",
+				drpc.Status.DRPolicyMigration.RetiringClusters)
+		}
+	}
+
 	// cleanup for VRG artifacts
 	if err = r.cleanupVRGs(ctx, drPolicy, log, mwu, drpc, placementObj, vrgNamespace); err != nil {
 		return err
@@ -752,6 +820,9 @@ func (r *DRPlacementControlReconciler) finalizeDRPC(ctx context.Context, drpc *r
 	syncDataBytesMetricLabels := SyncDataBytesMetricLabels(drPolicy, drpc)
 	DeleteSyncDataBytesMetric(syncDataBytesMetricLabels)
 
+	syncFailedAttemptBytesMetricLabels := SyncFailedAttemptBytesMetricLabels(drPolicy, drpc)
+	DeleteSyncFailedAttemptBytesMetric(syncFailedAttemptBytesMetricLabels)
+
 	workloadProtectionLabels := WorkloadProtectionStatusLabels(drpc)
 	DeleteWorkloadProtectionStatusMetric(workloadProtectionLabels)
 
@@ -1342,6 +1413,10 @@ func (r *DRPlacementControlReconciler) updateDRPCStatus(
 
 	r.updateResourceCondition(ctx, drpc, userPlacement, log, vrgs)
 
+	if vrgs != nil {
+		updateDRPCSplitBrainCondition(drpc, vrgs)
+	}
+
 	// set metrics if DRPC is not being deleted and if finalizer exists
 	if !isBeingDeleted(drpc, userPlacement) && controllerutil.ContainsFinalizer(drpc, DRPCFinalizer) {
 		if err := r.setDRPCMetrics(ctx, drpc, log); err != nil {
@@ -1410,12 +1485,13 @@ func (r *DRPlacementControlReconciler) updateResourceCondition(
 
 	// Update DRPC with VRG details
 	drpc.Status.ResourceConditions.ResourceMeta = rmn.VRGResourceMeta{
-		Kind:            vrg.Kind,
-		Name:            vrg.Name,
-		Namespace:       vrg.Namespace,
-		Generation:      vrg.Generation,
-		ResourceVersion: vrg.ResourceVersion,
-		ProtectedPVCs:   extractProtectedPVCNames(vrg),
+		Kind:               vrg.Kind,
+		Name:               vrg.Name,
+		Namespace:          vrg.Namespace,
+		Generation:         vrg.Generation,
+		ResourceVersion:    vrg.ResourceVersion,
+		ProtectedPVCs:      extractProtectedPVCNames(vrg),
+		ReplicationMethods: extractProtectedPVCReplicationMethods(vrg),
 	}
 
 	drpc.Status.ResourceConditions.Conditions = assignConditionsWithConflictCheck(
@@ -1431,6 +1507,8 @@ func (r *DRPlacementControlReconciler) updateResourceCondition(
 		drpc.Status.LastGroupSyncBytes = vrg.Status.LastGroupSyncBytes
 	}
 
+	drpc.Status.GroupSyncProgress = vrg.Status.GroupSyncProgress
+
 	if vrg.Status.KubeObjectProtection.CaptureToRecoverFrom != nil {
 		drpc.Status.LastKubeObjectProtectionTime = &vrg.Status.KubeObjectProtection.CaptureToRecoverFrom.EndTime
 	}
@@ -1496,6 +1574,26 @@ func extractProtectedPVCNames(vrg *rmn.VolumeReplicationGroup) []string {
 	return protectedPVCs
 }
 
+// extractProtectedPVCReplicationMethods maps each protected PVC of a VRG, by namespace/name, to the method used
+// to replicate it
+func extractProtectedPVCReplicationMethods(vrg *rmn.VolumeReplicationGroup) map[string]rmn.ReplicationMethod {
+	var replicationMethods map[string]rmn.ReplicationMethod
+
+	for _, protectedPVC := range vrg.Status.ProtectedPVCs {
+		if protectedPVC.ReplicationMethod == "" {
+			continue
+		}
+
+		if replicationMethods == nil {
+			replicationMethods = map[string]rmn.ReplicationMethod{}
+		}
+
+		replicationMethods[protectedPVC.Namespace+"/"+protectedPVC.Name] = protectedPVC.ReplicationMethod
+	}
+
+	return replicationMethods
+}
+
 // findConflictCondition selects the appropriate condition from VRGs based on the conflict type.
 func findConflictCondition(vrgs map[string]*rmn.VolumeReplicationGroup, conflictType string) *metav1.Condition {
 	var selectedCondition *metav1.Condition
@@ -1659,6 +1757,7 @@ func (r *DRPlacementControlReconciler) setDRPCMetrics(ctx context.Context,
 		r.setLastSyncTimeMetric(&syncMetrics.SyncTimeMetrics, drpc.Status.LastGroupSyncTime, log)
 		r.setLastSyncDurationMetric(&syncMetrics.SyncDurationMetrics, drpc.Status.LastGroupSyncDuration, log)
 		r.setLastSyncBytesMetric(&syncMetrics.SyncDataBytesMetrics, drpc.Status.LastGroupSyncBytes, log)
+		r.setSyncFailedAttemptBytesMetric(&syncMetrics.SyncFailedAttemptBytesMetrics, drpc.Status.GroupSyncProgress, log)
 	}
 
 	return nil
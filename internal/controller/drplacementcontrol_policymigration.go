@@ -0,0 +1,283 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	rmn "github.com/ramendr/ramen/api/v1alpha1"
+	rmnutil "github.com/ramendr/ramen/internal/controller/util"
+)
+
+// A DRPC is migrated from the DRPolicy it is protected with, as recorded in its status, to the DRPolicy that its
+// spec references, when the two differ. The primary VRG is retained, its spec and any peers in the new DRPolicy are
+// updated by the regular processing of the DRPC, and once it reports DataProtected again the protection resources
+// on clusters that are not in the new DRPolicy are removed.
+
+// ensureDRPolicyMigrationStarted records the DRPolicy that the instance is protected with, and starts a migration
+// if the instance references another DRPolicy
+func (d *DRPCInstance) ensureDRPolicyMigrationStarted() error {
+	drPolicyName := d.drPolicy.GetName()
+
+	if d.instance.Status.DRPolicyName == "" {
+		d.instance.Status.DRPolicyName = drPolicyName
+
+		return nil
+	}
+
+	if d.instance.Status.DRPolicyName == drPolicyName {
+		return nil
+	}
+
+	fromDRPolicy := &rmn.DRPolicy{}
+	if err := d.reconciler.APIReader.Get(d.ctx, types.NamespacedName{Name: d.instance.Status.DRPolicyName},
+		fromDRPolicy); err != nil {
+		return fmt.Errorf("failed to get DRPolicy %s to migrate from: %w", d.instance.Status.DRPolicyName, err)
+	}
+
+	if err := d.drPolicyMigrationValidate(fromDRPolicy); err != nil {
+		err = fmt.Errorf("unable to migrate from DRPolicy %s to %s: %w", fromDRPolicy.GetName(), drPolicyName, err)
+
+		addOrUpdateCondition(&d.instance.Status.Conditions, rmn.ConditionAvailable, d.instance.Generation,
+			d.getConditionStatusForTypeAvailable(), string(d.instance.Status.Phase), err.Error())
+
+		return err
+	}
+
+	retiringClusters := drPolicyMigrationRetiringClusters(d.instance.Status.DRPolicyMigration, fromDRPolicy,
+		d.drPolicy)
+
+	d.instance.Status.DRPolicyMigration = &rmn.DRPolicyMigration{
+		FromDRPolicy:     fromDRPolicy.GetName(),
+		RetiringClusters: retiringClusters,
+		StartTime:        metav1.Now(),
+	}
+	d.instance.Status.DRPolicyName = drPolicyName
+
+	d.setProgression(rmn.ProgressionMigratingDRPolicy)
+
+	rmnutil.ReportIfNotPresent(d.reconciler.eventRecorder, d.instance, corev1.EventTypeNormal,
+		rmnutil.EventReasonDRPolicyMigrating,
+		fmt.Sprintf("Migrating from DRPolicy %s to %s, retiring clusters %v", fromDRPolicy.GetName(), drPolicyName,
+			retiringClusters))
+
+	return nil
+}
+
+// drPolicyMigrationIdlePhases are the phases, by the action that completes in each, that a DRPolicy migration may
+// start in
+var drPolicyMigrationIdlePhases = map[rmn.DRAction]rmn.DRState{
+	"":                 rmn.Deployed,
+	rmn.ActionFailover: rmn.FailedOver,
+	rmn.ActionRelocate: rmn.Relocated,
+}
+
+// drPolicyMigrationValidate ensures that the instance may migrate from the passed in DRPolicy to its DRPolicy, which
+// requires that it is idle, having completed its action as Deployed, FailedOver or Relocated, that its primary
+// cluster is in its DRPolicy, and that both DRPolicies are of the same DR type
+func (d *DRPCInstance) drPolicyMigrationValidate(fromDRPolicy *rmn.DRPolicy) error {
+	if d.instance.Status.Progression != rmn.ProgressionCompleted &&
+		d.instance.Status.Progression != rmn.ProgressionMigratingDRPolicy {
+		return fmt.Errorf("progression %s is not %s", d.instance.Status.Progression, rmn.ProgressionCompleted)
+	}
+
+	if phase := drPolicyMigrationIdlePhases[d.instance.Spec.Action]; d.instance.Status.Phase != phase {
+		return fmt.Errorf("phase %s is not %s for action %q", d.instance.Status.Phase, phase,
+			d.instance.Spec.Action)
+	}
+
+	primaryCluster := d.instance.Status.PreferredDecision.ClusterName
+	if primaryCluster == "" {
+		return fmt.Errorf("primary cluster is unknown")
+	}
+
+	if !rmnutil.DrpolicyContainsDrcluster(d.drPolicy, primaryCluster) {
+		return fmt.Errorf("primary cluster %s is not in DRPolicy %s", primaryCluster, d.drPolicy.GetName())
+	}
+
+	fromMetro, _, err := dRPolicySupportsMetro(fromDRPolicy, nil)
+	if err != nil {
+		return err
+	}
+
+	if fromMetro != (d.drType == DRTypeSync) {
+		return fmt.Errorf("DRPolicies are not of the same DR type")
+	}
+
+	return nil
+}
+
+// drPolicyMigrationRetiringClusters returns the clusters of fromDRPolicy, and those retiring from an in progress
+// migration, that are not in toDRPolicy
+func drPolicyMigrationRetiringClusters(
+	migration *rmn.DRPolicyMigration,
+	fromDRPolicy, toDRPolicy *rmn.DRPolicy,
+) []string {
+	clusters := rmnutil.DRPolicyClusterNames(fromDRPolicy)
+	if migration != nil {
+		clusters = append(slices.Clone(migration.RetiringClusters), clusters...)
+	}
+
+	retiringClusters := []string{}
+
+	for _, cluster := range clusters {
+		if rmnutil.DrpolicyContainsDrcluster(toDRPolicy, cluster) || slices.Contains(retiringClusters, cluster) {
+			continue
+		}
+
+		retiringClusters = append(retiringClusters, cluster)
+	}
+
+	return retiringClusters
+}
+
+// ensureDRPolicyMigrationCompleted waits for the primary VRG, as updated for the DRPolicy of the instance, to report
+// DataProtected, and for its peers in the DRPolicy to be protected, and then removes protection from the retiring
+// clusters. Returns true once the migration is complete, or if no migration is in progress.
+func (d *DRPCInstance) ensureDRPolicyMigrationCompleted() (bool, error) {
+	const done = true
+
+	migration := d.instance.Status.DRPolicyMigration
+	if migration == nil {
+		return done, nil
+	}
+
+	d.setProgression(rmn.ProgressionMigratingDRPolicy)
+
+	primaryCluster := d.instance.Status.PreferredDecision.ClusterName
+
+	if !d.isVRGConditionMet(primaryCluster, VRGConditionTypeDataProtected) {
+		addOrUpdateCondition(&d.instance.Status.Conditions, rmn.ConditionAvailable, d.instance.Generation,
+			d.getConditionStatusForTypeAvailable(), string(d.instance.Status.Phase),
+			fmt.Sprintf("Waiting for VRG on cluster %s to report DataProtected to migrate from DRPolicy %s",
+				primaryCluster, migration.FromDRPolicy))
+
+		return !done, nil
+	}
+
+	if msg, protected := d.drPolicyMigrationPeersProtected(primaryCluster, migration.StartTime); !protected {
+		addOrUpdateCondition(&d.instance.Status.Conditions, rmn.ConditionAvailable, d.instance.Generation,
+			d.getConditionStatusForTypeAvailable(), string(d.instance.Status.Phase),
+			fmt.Sprintf("%s to migrate from DRPolicy %s", msg, migration.FromDRPolicy))
+
+		return !done, nil
+	}
+
+	retired, err := d.reconciler.retireClusters(d.mwu, d.instance, d.vrgNamespace, migration.RetiringClusters,
+		d.log)
+	if err != nil || !retired {
+		return !done, err
+	}
+
+	d.instance.Status.DRPolicyMigration = nil
+
+	d.setProgression(rmn.ProgressionCompleted)
+
+	rmnutil.ReportIfNotPresent(d.reconciler.eventRecorder, d.instance, corev1.EventTypeNormal,
+		rmnutil.EventReasonDRPolicyMigrationSuccess,
+		fmt.Sprintf("Migrated from DRPolicy %s to %s", migration.FromDRPolicy, d.drPolicy.GetName()))
+
+	return done, nil
+}
+
+// drPolicyMigrationPeersProtected returns true if the peers of the passed in primary cluster in the DRPolicy of the
+// instance are protected: the VRG on each peer, which VolSync requires as the destination of its replication, must be
+// secondary as of its generation, and the primary VRG must report that all its PVCs replicated since the passed in
+// start of the migration, which covers peers that the storage replicates to without a VRG. Returns what it waits for
+// otherwise.
+func (d *DRPCInstance) drPolicyMigrationPeersProtected(primaryCluster string, startTime metav1.Time) (string, bool) {
+	primaryVRG := d.vrgs[primaryCluster]
+	if primaryVRG == nil {
+		return fmt.Sprintf("Waiting for VRG on cluster %s", primaryCluster), false
+	}
+
+	volSync := slices.ContainsFunc(primaryVRG.Status.ProtectedPVCs, func(pvc rmn.ProtectedPVC) bool {
+		return pvc.ProtectedByVolSync
+	})
+
+	for _, cluster := range rmnutil.DRPolicyClusterNames(d.drPolicy) {
+		if cluster == primaryCluster {
+			continue
+		}
+
+		vrg := d.vrgs[cluster]
+		if vrg == nil {
+			if volSync {
+				return fmt.Sprintf("Waiting for VRG on peer cluster %s", cluster), false
+			}
+
+			continue
+		}
+
+		if !isVRGSecondary(vrg) || vrg.Status.State != rmn.SecondaryState ||
+			vrg.Status.ObservedGeneration != vrg.Generation {
+			return fmt.Sprintf("Waiting for VRG on peer cluster %s to be secondary", cluster), false
+		}
+	}
+
+	if len(primaryVRG.Status.ProtectedPVCs) != 0 &&
+		(primaryVRG.Status.LastGroupSyncTime == nil || primaryVRG.Status.LastGroupSyncTime.Before(&startTime)) {
+		return fmt.Sprintf("Waiting for VRG on cluster %s to report a sync of its PVCs to its peers", primaryCluster),
+			false
+	}
+
+	return "", true
+}
+
+// retireClusters removes the VRG, VolSync PSK secret, namespace and view resources of the DRPC from the passed in
+// clusters. Returns true once the VRGs on all of them are deleted.
+func (r *DRPlacementControlReconciler) retireClusters(
+	mwu rmnutil.MWUtil,
+	drpc *rmn.DRPlacementControl,
+	vrgNamespace string,
+	clusters []string,
+	log logr.Logger,
+) (bool, error) {
+	annotations := make(map[string]string)
+	annotations[DRPCNameAnnotation] = drpc.Name
+	annotations[DRPCNamespaceAnnotation] = drpc.Namespace
+
+	retired := true
+
+	for _, cluster := range clusters {
+		if err := mwu.DeleteManifestWork(mwu.BuildManifestWorkName(rmnutil.MWTypeVRG), cluster); err != nil {
+			return false, fmt.Errorf("failed to delete VRG manifestwork for retiring cluster %q: %w", cluster, err)
+		}
+
+		_, err := r.MCVGetter.GetVRGFromManagedCluster(drpc.Name, vrgNamespace, cluster, annotations)
+		if err == nil {
+			log.Info("Waiting for VRG deletion on retiring cluster", "cluster", cluster)
+
+			retired = false
+
+			continue
+		}
+
+		if !k8serrors.IsNotFound(err) {
+			return false, fmt.Errorf("failed to get VRG from retiring cluster %q: %w", cluster, err)
+		}
+
+		if err := mwu.DeleteManifestWork(mwu.BuildManifestWorkName(rmnutil.MWTypeVSSecret), cluster); err != nil {
+			return false, fmt.Errorf("failed to delete VolSync secret manifestwork for retiring cluster %q: %w",
+				cluster, err)
+		}
+
+		if err := mwu.DeleteNamespaceManifestWork(cluster, annotations); err != nil {
+			return false, err
+		}
+
+		if err := r.deleteAllManagedClusterViews(drpc, []string{cluster}); err != nil {
+			return false, fmt.Errorf("error in deleting MCV (%w)", err)
+		}
+	}
+
+	return retired, nil
+}
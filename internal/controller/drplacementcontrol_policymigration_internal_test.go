@@ -0,0 +1,149 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	ramendrv1alpha1 "github.com/ramendr/ramen/api/v1alpha1"
+)
+
+var _ = Describe("DRPolicyMigration", func() {
+	drPolicy := func(name string, clusters ...string) *ramendrv1alpha1.DRPolicy {
+		return &ramendrv1alpha1.DRPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec:       ramendrv1alpha1.DRPolicySpec{DRClusters: clusters, SchedulingInterval: "5m"},
+		}
+	}
+
+	Describe("drPolicyMigrationRetiringClusters", func() {
+		It("retires clusters that are not in the new DRPolicy", func() {
+			Expect(drPolicyMigrationRetiringClusters(nil, drPolicy("from", "c1", "c2"), drPolicy("to", "c1", "c3"))).
+				To(Equal([]string{"c2"}))
+		})
+
+		It("retires no clusters when the new DRPolicy has the same clusters", func() {
+			Expect(drPolicyMigrationRetiringClusters(nil, drPolicy("from", "c1", "c2"), drPolicy("to", "c2", "c1"))).
+				To(BeEmpty())
+		})
+
+		It("retains clusters retiring from an in progress migration unless they are in the new DRPolicy", func() {
+			migration := &ramendrv1alpha1.DRPolicyMigration{FromDRPolicy: "first", RetiringClusters: []string{"c4", "c3"}}
+
+			Expect(drPolicyMigrationRetiringClusters(migration, drPolicy("from", "c1", "c2", "c3"),
+				drPolicy("to", "c1", "c3"))).To(Equal([]string{"c4", "c2"}))
+		})
+	})
+
+	Describe("drPolicyMigrationValidate", func() {
+		instance := func(progression ramendrv1alpha1.ProgressionStatus, primary string) *DRPCInstance {
+			return &DRPCInstance{
+				instance: &ramendrv1alpha1.DRPlacementControl{Status: ramendrv1alpha1.DRPlacementControlStatus{
+					Phase:             ramendrv1alpha1.Deployed,
+					Progression:       progression,
+					PreferredDecision: ramendrv1alpha1.PlacementDecision{ClusterName: primary},
+				}},
+				drPolicy: drPolicy("to", "c1", "c3"),
+				drType:   DRTypeAsync,
+			}
+		}
+
+		It("allows a completed DRPC whose primary is in the new DRPolicy", func() {
+			Expect(instance(ramendrv1alpha1.ProgressionCompleted, "c1").
+				drPolicyMigrationValidate(drPolicy("from", "c1", "c2"))).To(Succeed())
+		})
+
+		It("rejects a DRPC that is not completed", func() {
+			Expect(instance(ramendrv1alpha1.ProgressionCheckingFailoverPrerequisites, "c1").
+				drPolicyMigrationValidate(drPolicy("from", "c1", "c2"))).NotTo(Succeed())
+		})
+
+		It("rejects a DRPC whose action is not complete", func() {
+			d := instance(ramendrv1alpha1.ProgressionCompleted, "c1")
+			d.instance.Spec.Action = ramendrv1alpha1.ActionFailover
+
+			Expect(d.drPolicyMigrationValidate(drPolicy("from", "c1", "c2"))).To(MatchError(
+				`phase Deployed is not FailedOver for action "Failover"`))
+		})
+
+		It("rejects a DRPC whose primary is not in the new DRPolicy", func() {
+			Expect(instance(ramendrv1alpha1.ProgressionCompleted, "c2").
+				drPolicyMigrationValidate(drPolicy("from", "c1", "c2"))).NotTo(Succeed())
+		})
+
+		It("rejects a DRPolicy of another DR type", func() {
+			from := drPolicy("from", "c1", "c2")
+			from.Spec.SchedulingInterval = ""
+
+			Expect(instance(ramendrv1alpha1.ProgressionCompleted, "c1").drPolicyMigrationValidate(from)).
+				NotTo(Succeed())
+		})
+	})
+
+	Describe("drPolicyMigrationPeersProtected", func() {
+		startTime := metav1.Now()
+		synced := metav1.NewTime(startTime.Add(time.Minute))
+
+		states := map[ramendrv1alpha1.ReplicationState]ramendrv1alpha1.State{
+			ramendrv1alpha1.Primary:   ramendrv1alpha1.PrimaryState,
+			ramendrv1alpha1.Secondary: ramendrv1alpha1.SecondaryState,
+		}
+
+		vrg := func(state ramendrv1alpha1.ReplicationState, lastGroupSyncTime *metav1.Time, volSync bool,
+		) *ramendrv1alpha1.VolumeReplicationGroup {
+			return &ramendrv1alpha1.VolumeReplicationGroup{
+				Spec: ramendrv1alpha1.VolumeReplicationGroupSpec{ReplicationState: state},
+				Status: ramendrv1alpha1.VolumeReplicationGroupStatus{
+					State:             states[state],
+					LastGroupSyncTime: lastGroupSyncTime,
+					ProtectedPVCs:     []ramendrv1alpha1.ProtectedPVC{{Name: "pvc", ProtectedByVolSync: volSync}},
+				},
+			}
+		}
+
+		instance := func(vrgs map[string]*ramendrv1alpha1.VolumeReplicationGroup) *DRPCInstance {
+			return &DRPCInstance{drPolicy: drPolicy("to", "c1", "c3"), vrgs: vrgs}
+		}
+
+		It("waits for the primary VRG to sync its PVCs since the migration started", func() {
+			msg, protected := instance(map[string]*ramendrv1alpha1.VolumeReplicationGroup{
+				"c1": vrg(ramendrv1alpha1.Primary, &metav1.Time{Time: startTime.Add(-time.Minute)}, false),
+			}).drPolicyMigrationPeersProtected("c1", startTime)
+			Expect(protected).To(BeFalse())
+			Expect(msg).To(Equal("Waiting for VRG on cluster c1 to report a sync of its PVCs to its peers"))
+
+			_, protected = instance(map[string]*ramendrv1alpha1.VolumeReplicationGroup{
+				"c1": vrg(ramendrv1alpha1.Primary, &synced, false),
+			}).drPolicyMigrationPeersProtected("c1", startTime)
+			Expect(protected).To(BeTrue())
+		})
+
+		It("waits for the VRG on a new peer that VolSync replicates to", func() {
+			msg, protected := instance(map[string]*ramendrv1alpha1.VolumeReplicationGroup{
+				"c1": vrg(ramendrv1alpha1.Primary, &synced, true),
+			}).drPolicyMigrationPeersProtected("c1", startTime)
+			Expect(protected).To(BeFalse())
+			Expect(msg).To(Equal("Waiting for VRG on peer cluster c3"))
+
+			secondary := vrg(ramendrv1alpha1.Secondary, nil, false)
+			secondary.Status.State = ramendrv1alpha1.UnknownState
+
+			_, protected = instance(map[string]*ramendrv1alpha1.VolumeReplicationGroup{
+				"c1": vrg(ramendrv1alpha1.Primary, &synced, true),
+				"c3": secondary,
+			}).drPolicyMigrationPeersProtected("c1", startTime)
+			Expect(protected).To(BeFalse())
+
+			_, protected = instance(map[string]*ramendrv1alpha1.VolumeReplicationGroup{
+				"c1": vrg(ramendrv1alpha1.Primary, &synced, true),
+				"c3": vrg(ramendrv1alpha1.Secondary, nil, false),
+			}).drPolicyMigrationPeersProtected("c1", startTime)
+			Expect(protected).To(BeTrue())
+		})
+	})
+})
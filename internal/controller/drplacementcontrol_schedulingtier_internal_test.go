@@ -0,0 +1,51 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	ramendrv1alpha1 "github.com/ramendr/ramen/api/v1alpha1"
+)
+
+var _ = Describe("SchedulingTier", func() {
+	instance := func(tier string, protectedByVolSync bool) *DRPCInstance {
+		return &DRPCInstance{
+			instance: &ramendrv1alpha1.DRPlacementControl{
+				Spec: ramendrv1alpha1.DRPlacementControlSpec{SchedulingTier: tier},
+			},
+			drPolicy: &ramendrv1alpha1.DRPolicy{Spec: ramendrv1alpha1.DRPolicySpec{
+				DRClusters:         []string{"c1", "c2"},
+				SchedulingInterval: "5m",
+				SchedulingTiers:    []ramendrv1alpha1.SchedulingTier{{Name: "gold", SchedulingInterval: "1m"}},
+			}},
+			drType: DRTypeAsync,
+			vrgs: map[string]*ramendrv1alpha1.VolumeReplicationGroup{
+				"c1": {
+					Spec: ramendrv1alpha1.VolumeReplicationGroupSpec{
+						Async: &ramendrv1alpha1.VRGAsyncSpec{SchedulingInterval: "5m"},
+					},
+					Status: ramendrv1alpha1.VolumeReplicationGroupStatus{
+						ProtectedPVCs: []ramendrv1alpha1.ProtectedPVC{{Name: "pvc", ProtectedByVolSync: protectedByVolSync}},
+					},
+				},
+			},
+		}
+	}
+
+	It("allows the scheduling interval to remain unchanged", func() {
+		Expect(instance("", false).schedulingIntervalChangeValidate()).To(Succeed())
+	})
+
+	It("allows a change of tier for PVCs protected using VolSync", func() {
+		Expect(instance("gold", true).schedulingIntervalChangeValidate()).To(Succeed())
+	})
+
+	It("rejects a change of tier for PVCs protected using VolumeReplication", func() {
+		Expect(instance("gold", false).schedulingIntervalChangeValidate()).To(MatchError(
+			"scheduling interval of VRG on cluster c1 cannot change from 5m to 1m, as it protects PVCs using " +
+				"VolumeReplication"))
+	})
+})
@@ -0,0 +1,61 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	rmn "github.com/ramendr/ramen/api/v1alpha1"
+	rmnutil "github.com/ramendr/ramen/internal/controller/util"
+)
+
+// updateDRPCSplitBrainCondition sets the DRPC SplitBrain condition from the SplitBrain conditions of the VRGs on
+// the passed in clusters, or removes it if none of them reports one. The condition is True while any VRG reports
+// PVCs whose diverged data is not yet discarded, with reason AcknowledgementRequired if any of them is pending
+// acknowledgement.
+func updateDRPCSplitBrainCondition(drpc *rmn.DRPlacementControl, vrgs map[string]*rmn.VolumeReplicationGroup) {
+	status, reason := metav1.ConditionFalse, rmn.ReasonSplitBrainResolved
+	messages := []string{}
+
+	for _, clusterName := range slices.Sorted(maps.Keys(vrgs)) {
+		vrg := vrgs[clusterName]
+
+		condition := rmnutil.FindCondition(vrg.Status.Conditions, VRGConditionTypeSplitBrain)
+		if condition == nil {
+			continue
+		}
+
+		messages = append(messages, fmt.Sprintf("cluster %s: %s", clusterName, condition.Message))
+
+		if condition.Status != metav1.ConditionTrue {
+			continue
+		}
+
+		status = metav1.ConditionTrue
+
+		if reason != rmn.ReasonSplitBrainAcknowledgementRequired {
+			reason = condition.Reason
+		}
+	}
+
+	if len(messages) == 0 {
+		meta.RemoveStatusCondition(&drpc.Status.Conditions, rmn.ConditionSplitBrain)
+
+		return
+	}
+
+	message := strings.Join(messages, "; ")
+	if reason == rmn.ReasonSplitBrainAcknowledgementRequired {
+		message += ". Set spec.splitBrain.acknowledgedTime to the current time to acknowledge"
+	}
+
+	addOrUpdateCondition(&drpc.Status.Conditions, rmn.ConditionSplitBrain, drpc.Generation, status, reason,
+		message)
+}
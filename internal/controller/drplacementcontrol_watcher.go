@@ -282,22 +282,25 @@ func DRPolicyPredicateFunc() predicate.Funcs {
 // TODO: Needs some logs for easier troubleshooting
 func DRClusterUpdateOfInterest(oldDRCluster, newDRCluster *rmn.DRCluster) bool {
 	for _, mModeNew := range newDRCluster.Status.MaintenanceModes {
-		// Check if new conditions have failover activated, if not this maintenance mode is NOT of interest
-		conditionNew := getFailoverActivatedCondition(mModeNew)
-		if conditionNew == nil ||
-			conditionNew.Status == metav1.ConditionFalse ||
-			conditionNew.Status == metav1.ConditionUnknown {
-			continue
-		}
+		for _, mode := range []rmn.MMode{rmn.MModeFailover, rmn.MModeRelocate, rmn.MModeResync} {
+			// Check if new conditions have the mode activated, if not this maintenance mode is NOT of interest
+			conditionNew := getMModeActivatedCondition(mModeNew, mode)
+			if conditionNew == nil ||
+				conditionNew.Status == metav1.ConditionFalse ||
+				conditionNew.Status == metav1.ConditionUnknown {
+				continue
+			}
 
-		// Check if failover maintenance mode was already activated as part of an older update to DRCluster, if NOT
-		// this change is of interest
-		if activated := checkFailoverActivation(oldDRCluster, mModeNew.StorageProvisioner, mModeNew.TargetID); !activated {
-			return true
+			// Check if the maintenance mode was already activated as part of an older update to DRCluster, if NOT
+			// this change is of interest
+			if activated := checkMModeActivation(oldDRCluster, mModeNew.StorageProvisioner, mModeNew.TargetID,
+				mode); !activated {
+				return true
+			}
 		}
 	}
 
-	// Exhausted all failover activation checks, the only interesting update is deleting a drcluster.
+	// Exhausted all activation checks, the only interesting update is deleting a drcluster.
 	return rmnutil.ResourceIsDeleted(newDRCluster)
 }
 
@@ -314,16 +317,15 @@ func RequiresDRPCReconciliation(oldDRPolicy, newDRPolicy *rmn.DRPolicy) bool {
 		!reflect.DeepEqual(oldDRPolicy.Status.Sync.PeerClasses, newDRPolicy.Status.Sync.PeerClasses)
 }
 
-// checkFailoverActivation checks if provided provisioner and storage instance is activated as per the
-// passed in DRCluster resource status. It currently only checks for:
-//   - Failover activation condition
-func checkFailoverActivation(drcluster *rmn.DRCluster, provisioner string, targetID string) bool {
+// checkMModeActivation checks if provided provisioner and storage instance is activated as per the
+// passed in DRCluster resource status. It checks for the activation condition of the passed in mode.
+func checkMModeActivation(drcluster *rmn.DRCluster, provisioner string, targetID string, mode rmn.MMode) bool {
 	for _, mMode := range drcluster.Status.MaintenanceModes {
 		if !(mMode.StorageProvisioner == provisioner && mMode.TargetID == targetID) {
 			continue
 		}
 
-		condition := getFailoverActivatedCondition(mMode)
+		condition := getMModeActivatedCondition(mMode, mode)
 		if condition == nil ||
 			condition.Status == metav1.ConditionFalse ||
 			condition.Status == metav1.ConditionUnknown {
@@ -336,11 +338,11 @@ func checkFailoverActivation(drcluster *rmn.DRCluster, provisioner string, targe
 	return false
 }
 
-// getFailoverActivatedCondition is a helper routine that returns the FailoverActivated condition
-// from a given ClusterMaintenanceMode if found, or nil otherwise
-func getFailoverActivatedCondition(mMode rmn.ClusterMaintenanceMode) *metav1.Condition {
+// getMModeActivatedCondition is a helper routine that returns the activated condition of the mode, e.g.
+// FailoverActivated, from a given ClusterMaintenanceMode if found, or nil otherwise
+func getMModeActivatedCondition(mMode rmn.ClusterMaintenanceMode, mode rmn.MMode) *metav1.Condition {
 	for _, condition := range mMode.Conditions {
-		if condition.Type != string(rmn.MModeConditionFailoverActivated) {
+		if condition.Type != string(mModeActivatedCondition(mode)) {
 			continue
 		}
 
@@ -362,6 +364,12 @@ func (r *DRPlacementControlReconciler) FilterDRCluster(drcluster *rmn.DRCluster)
 		drpcCollections, err = DRPCsUsingDRCluster(r.Client, log, drcluster)
 	} else {
 		drpcCollections, err = DRPCsFailingOverToCluster(r.Client, log, drcluster.GetName())
+		if err == nil {
+			var drpcsRequiringMModes []DRPCAndPolicy
+
+			drpcsRequiringMModes, err = DRPCsRequiringMModesOnCluster(r.Client, log, drcluster)
+			drpcCollections = append(drpcCollections, drpcsRequiringMModes...)
+		}
 	}
 
 	if err != nil {
@@ -453,6 +461,73 @@ func DRPCsUsingDRPolicy(
 	return found, nil
 }
 
+// DRPCsRequiringMModesOnCluster lists DRPC resources of regional DRPolicies that may require relocate or resync
+// maintenance modes on the passed in drcluster, i.e. those relocating from it, or cleaning it up once they failed
+// over or relocated to a peer cluster
+func DRPCsRequiringMModesOnCluster(
+	k8sclient client.Client,
+	log logr.Logger,
+	drcluster *rmn.DRCluster,
+) ([]DRPCAndPolicy, error) {
+	drpcCollections, err := DRPCsUsingDRCluster(k8sclient, log, drcluster)
+	if err != nil {
+		return nil, err
+	}
+
+	found := []DRPCAndPolicy{}
+
+	for _, drpcCollection := range drpcCollections {
+		if mode, _ := drpcMModeRequired(drpcCollection.drpc, drcluster.GetName()); mode == "" {
+			continue
+		}
+
+		metro, _, err := dRPolicySupportsMetro(drpcCollection.drPolicy, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check if DRPolicy supports Metro: %w", err)
+		}
+
+		if metro {
+			continue
+		}
+
+		found = append(found, drpcCollection)
+	}
+
+	return found, nil
+}
+
+// drpcMModeRequired returns the maintenance mode, other than failover, that the DRPC may require of the storage on
+// the passed in cluster, if any, and the cluster that the DRPC fails over or relocates to:
+//   - Relocate, on the cluster it relocates from, until it is relocated
+//   - Resync, on the peers of the cluster it failed over or relocated to, while they are cleaned up
+func drpcMModeRequired(drpc *rmn.DRPlacementControl, cluster string) (rmn.MMode, string) {
+	targetCluster := drpc.Spec.PreferredCluster
+	if drpc.Spec.Action == rmn.ActionFailover {
+		targetCluster = drpc.Spec.FailoverCluster
+	}
+
+	if rmnutil.ResourceIsDeleted(drpc) || targetCluster == "" || targetCluster == cluster {
+		return "", targetCluster
+	}
+
+	if (drpc.Spec.Action == rmn.ActionFailover || drpc.Spec.Action == rmn.ActionRelocate) &&
+		drpc.Status.Progression == rmn.ProgressionCleaningUp {
+		return rmn.MModeResync, targetCluster
+	}
+
+	if drpc.Spec.Action != rmn.ActionRelocate {
+		return "", targetCluster
+	}
+
+	if condition := meta.FindStatusCondition(drpc.Status.Conditions, rmn.ConditionAvailable); condition != nil &&
+		condition.Status == metav1.ConditionTrue &&
+		condition.ObservedGeneration == drpc.Generation {
+		return "", targetCluster
+	}
+
+	return rmn.MModeRelocate, targetCluster
+}
+
 // DRPCsFailingOverToCluster lists DRPC resources that are failing over to the passed in drcluster
 //
 //nolint:gocognit
@@ -6,6 +6,7 @@ package controllers
 import (
 	"encoding/json"
 	"fmt"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
@@ -217,17 +218,22 @@ func (d *DRPCInstance) EnsureVolSyncReplicationSetup(srcCluster string) error {
 		return fmt.Errorf("%w", err)
 	}
 
-	// Propagate the secret to all clusters
-	// Note that VRG spec will not contain the psk secret name, we're going to name based on the VRG name itself
-	pskSecretNameCluster := volsync.GetVolSyncPSKSecretNameFromVRGName(d.instance.GetName()) // VRG name == DRPC name
-
 	clustersToPropagateSecret := []string{}
 	for _, drCluster := range d.drClusters {
 		clustersToPropagateSecret = append(clustersToPropagateSecret, drCluster.Name)
 	}
 
-	err = volsync.PropagateSecretToClusters(d.ctx, d.reconciler.Client, pskSecretHub,
-		d.instance, clustersToPropagateSecret, pskSecretNameCluster, d.vrgNamespace, d.log)
+	if err := d.rotateVolSyncReplicationSecret(pskSecretHub, clustersToPropagateSecret); err != nil {
+		d.log.Error(err, "Unable to rotate psk secret on hub for VolSync")
+
+		return fmt.Errorf("%w", err)
+	}
+
+	// Propagate the secret to all clusters
+	// Note that VRG spec will not contain the psk secret name, we're going to name based on the VRG name itself
+	pskSecretNameCluster := volsync.GetVolSyncPSKSecretNameFromVRGName(d.instance.GetName()) // VRG name == DRPC name
+
+	err = d.propagateVolSyncReplicationSecret(pskSecretHub, clustersToPropagateSecret, pskSecretNameCluster)
 	if err != nil {
 		d.log.Error(err, "Error propagating secret to clusters", "clustersToPropagateSecret", clustersToPropagateSecret)
 
@@ -237,6 +243,83 @@ func (d *DRPCInstance) EnsureVolSyncReplicationSetup(srcCluster string) error {
 	return nil
 }
 
+// rotateVolSyncReplicationSecret rotates the pre-shared key in the hub secret as configured in the RamenConfig, moving
+// an in-progress rotation to its next phase only once the secret is applied on all clusters, and reports each update
+// of the key on the DRPC for auditing
+func (d *DRPCInstance) rotateVolSyncReplicationSecret(pskSecretHub *corev1.Secret, clusters []string) error {
+	applied, err := d.volSyncReplicationSecretApplied(pskSecretHub, clusters)
+	if err != nil {
+		return err
+	}
+
+	updated, err := volsync.RotateVolSyncReplicationSecret(d.ctx, d.reconciler.Client, pskSecretHub,
+		volSyncPSKRotation(d.ramenConfig), applied, time.Now(), d.log)
+	if err != nil || !updated {
+		return err
+	}
+
+	phase := pskSecretHub.GetAnnotations()[volsync.PSKRotationPhaseAnnotation]
+	if phase == "" {
+		phase = "Completed"
+	}
+
+	rmnutil.ReportIfNotPresent(d.reconciler.eventRecorder, d.instance, corev1.EventTypeNormal,
+		rmnutil.EventReasonVolSyncPSKUpdated,
+		fmt.Sprintf("VolSync pre-shared key secret %s updated, generation %s, rotation phase %s",
+			pskSecretHub.GetName(), pskSecretHub.GetAnnotations()[volsync.PSKGenerationAnnotation], phase))
+
+	return nil
+}
+
+// volSyncReplicationSecretApplied returns true if the hub secret of an in-progress key rotation is applied on all
+// clusters, as reported by the status of the ManifestWorks or policies propagating it
+func (d *DRPCInstance) volSyncReplicationSecretApplied(pskSecretHub *corev1.Secret, clusters []string,
+) (bool, error) {
+	if pskSecretHub.GetAnnotations()[volsync.PSKRotationPhaseAnnotation] == "" {
+		return false, nil
+	}
+
+	if volSyncSecretPropagationOrDefault(d.ramenConfig) == rmn.VolSyncSecretPropagationManifestWork {
+		return volsync.SecretPropagatedToClustersUsingManifestWork(&d.mwu, pskSecretHub, clusters)
+	}
+
+	updatedAt, err := time.Parse(time.RFC3339, pskSecretHub.GetAnnotations()[volsync.PSKUpdatedAtAnnotation])
+	if err != nil {
+		return false, fmt.Errorf("invalid %s annotation on secret %s (%w)", volsync.PSKUpdatedAtAnnotation,
+			pskSecretHub.GetName(), err)
+	}
+
+	return volsync.SecretPropagatedToClusters(d.ctx, d.reconciler.Client, d.instance, clusters, updatedAt, d.log)
+}
+
+// propagateVolSyncReplicationSecret propagates the hub secret to the clusters using the mechanism configured in the
+// RamenConfig, and cleans up resources used by the other mechanism. Those exist only after the mechanism changed, and
+// their cleanup leaves the propagated secrets in place.
+func (d *DRPCInstance) propagateVolSyncReplicationSecret(pskSecretHub *corev1.Secret, clusters []string,
+	pskSecretNameCluster string,
+) error {
+	if volSyncSecretPropagationOrDefault(d.ramenConfig) == rmn.VolSyncSecretPropagationManifestWork {
+		if err := volsync.CleanupSecretPropagation(d.ctx, d.reconciler.Client, d.instance, d.log); err != nil {
+			return err
+		}
+
+		annotations := map[string]string{
+			DRPCNameAnnotation:      d.instance.Name,
+			DRPCNamespaceAnnotation: d.instance.Namespace,
+		}
+
+		return volsync.PropagateSecretToClustersUsingManifestWork(&d.mwu, pskSecretHub, clusters,
+			pskSecretNameCluster, d.vrgNamespace, annotations)
+	}
+
+	if err := volsync.CleanupSecretPropagationManifestWork(&d.mwu, clusters); err != nil {
+		return err
+	}
+
+	return volsync.PropagateSecretToClusters(d.ctx, d.reconciler.Client, pskSecretHub,
+		d.instance, clusters, pskSecretNameCluster, d.vrgNamespace, d.log)
+}
+
 func (d *DRPCInstance) IsVolSyncReplicationRequired(homeCluster string) (bool, error) {
 	if d.volSyncDisabled {
 		d.log.Info("VolSync is disabled")
@@ -366,6 +449,7 @@ func (d *DRPCInstance) resetRDSpec(srcVRG, dstVRG *rmn.VolumeReplicationGroup,
 		protectedPVC.LastSyncBytes = nil
 		protectedPVC.LastSyncTime = nil
 		protectedPVC.LastSyncDuration = nil
+		protectedPVC.SyncProgress = nil
 		protectedPVC.Conditions = nil
 
 		rdSpec := rmn.VolSyncReplicationDestinationSpec{
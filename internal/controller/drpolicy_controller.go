@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
@@ -145,14 +146,30 @@ func (r *DRPolicyReconciler) reconcile(
 	ramenConfig *ramen.RamenConfig,
 	drClusterIDsToNames map[string]string,
 ) (ctrl.Result, error) {
-	if err := u.validatedSetTrue("Succeeded", "drpolicy validated"); err != nil {
-		return ctrl.Result{}, fmt.Errorf("unable to set drpolicy validation: %w", err)
+	// peer classes that failed validation keep the policy invalid until they are updated and validated below
+	if validatePeerClassesReplication(u.object) == nil {
+		if err := u.validatedSetTrue("Succeeded", "drpolicy validated"); err != nil {
+			return ctrl.Result{}, fmt.Errorf("unable to set drpolicy validation: %w", err)
+		}
+	}
+
+	if err := u.placementConstraintsSatisfiedSet(validatePlacementConstraints(u.object, drclusters)); err != nil {
+		return ctrl.Result{}, fmt.Errorf("unable to set drpolicy placement constraints satisfied: %w", err)
 	}
 
 	if err := updatePeerClasses(u, r.MCVGetter); err != nil {
 		return ctrl.Result{}, fmt.Errorf("drpolicy peerClass update: %w", err)
 	}
 
+	if err := validatePeerClassesReplication(u.object); err != nil {
+		return ctrl.Result{}, fmt.Errorf("drpolicy peerClass validate: %w",
+			u.validatedSetFalse(ReasonValidationFailed, err))
+	}
+
+	if err := u.validatedSetTrue("Succeeded", "drpolicy validated"); err != nil {
+		return ctrl.Result{}, fmt.Errorf("unable to set drpolicy validation: %w", err)
+	}
+
 	if err := propagateS3Secret(u.object, drclusters, secretsUtil, ramenConfig, u.log); err != nil {
 		return ctrl.Result{}, fmt.Errorf("drpolicy deploy: %w", err)
 	}
@@ -231,9 +248,61 @@ func validateDRPolicy(drpolicy *ramen.DRPolicy,
 		return reason, err
 	}
 
+	if err := validateStorageClassMapping(drpolicy); err != nil {
+		return ReasonValidationFailed, err
+	}
+
+	if err := validatePeers(drpolicy); err != nil {
+		return ReasonValidationFailed, err
+	}
+
 	return "", nil
 }
 
+// validatePeers ensures that each peer in the policy declares a distinct pair of distinct clusters of the policy,
+// that replicate as per the type of the policy, as a policy does not mix synchronous and asynchronous replication
+func validatePeers(drpolicy *ramen.DRPolicy) error {
+	const pairSize = 2
+
+	replicationType := ramen.DRPolicyReplicationAsync
+	if drpolicy.Spec.SchedulingInterval == "" {
+		replicationType = ramen.DRPolicyReplicationSync
+	}
+
+	for idx, peer := range drpolicy.Spec.Peers {
+		if len(peer.Clusters) != pairSize || peer.Clusters[0] == peer.Clusters[1] ||
+			!slices.Contains(drpolicy.Spec.DRClusters, peer.Clusters[0]) ||
+			!slices.Contains(drpolicy.Spec.DRClusters, peer.Clusters[1]) {
+			return fmt.Errorf("peer %d does not pair two distinct clusters of the policy", idx)
+		}
+
+		if util.FindDRPolicyPeer(drpolicy.Spec.Peers, peer.Clusters[0], peer.Clusters[1]) != &drpolicy.Spec.Peers[idx] {
+			return fmt.Errorf("peer %d pairs clusters %v that are already paired by another peer", idx, peer.Clusters)
+		}
+
+		if peer.ReplicationType != replicationType {
+			return fmt.Errorf("peer %d replication type %s differs from the policy replication type %s",
+				idx, peer.ReplicationType, replicationType)
+		}
+	}
+
+	return nil
+}
+
+// validateStorageClassMapping ensures that each StorageClassMapping in the policy pairs StorageClasses across two
+// distinct clusters of the policy
+func validateStorageClassMapping(drpolicy *ramen.DRPolicy) error {
+	for idx, mapping := range drpolicy.Spec.StorageClassMapping {
+		if !slices.Contains(drpolicy.Spec.DRClusters, mapping.SourceCluster) ||
+			!slices.Contains(drpolicy.Spec.DRClusters, mapping.TargetCluster) ||
+			mapping.SourceCluster == mapping.TargetCluster {
+			return fmt.Errorf("storageClassMapping %d does not pair two distinct clusters of the policy", idx)
+		}
+	}
+
+	return nil
+}
+
 func (r *DRPolicyReconciler) setDRPolicyMetrics(drPolicy *ramen.DRPolicy) error {
 	r.Log.Info(fmt.Sprintf("Setting metric: (%v)", DRPolicySyncIntervalSeconds))
 
@@ -280,6 +349,29 @@ func ensureDRClustersAvailable(drpolicy *ramen.DRPolicy, drclusters *ramen.DRClu
 	return "", nil
 }
 
+// validatePeerClassesReplication ensures that the async peer classes of a policy of more than two clusters replicate
+// by VolumeReplication, as VolSync, which replicates peer classes that lack replication classes, does not replicate
+// to more than one destination
+func validatePeerClassesReplication(drpolicy *ramen.DRPolicy) error {
+	if len(drpolicy.Spec.DRClusters) <= 2 { //nolint:mnd
+		return nil
+	}
+
+	peerClasses := slices.Clone(drpolicy.Status.Async.PeerClasses)
+	for _, tier := range drpolicy.Status.Async.Tiers {
+		peerClasses = append(peerClasses, tier.PeerClasses...)
+	}
+
+	for _, peerClass := range peerClasses {
+		if peerClass.ReplicationID == "" && peerClass.GroupReplicationID == "" {
+			return fmt.Errorf("storageClass %s of clusters %v has no replication classes, and VolSync does not "+
+				"replicate policies of more than two clusters", peerClass.StorageClassName, peerClass.ClusterIDs)
+		}
+	}
+
+	return nil
+}
+
 func validatePolicyConflicts(ctx context.Context,
 	apiReader client.Reader,
 	drpolicy *ramen.DRPolicy,
@@ -436,6 +528,18 @@ func (u *drpolicyUpdater) validatedSetFalse(reason string, err error) error {
 	return err
 }
 
+// placementConstraintsSatisfiedSet reports whether the placement constraints of the policy are satisfied, as per the
+// passed in error from their validation
+func (u *drpolicyUpdater) placementConstraintsSatisfiedSet(err error) error {
+	if err != nil {
+		return u.statusConditionSet(ramen.DRPolicyPlacementConstraintsSatisfied, metav1.ConditionFalse,
+			"Unsatisfied", err.Error())
+	}
+
+	return u.statusConditionSet(ramen.DRPolicyPlacementConstraintsSatisfied, metav1.ConditionTrue, "Satisfied",
+		"every cluster has a failover target")
+}
+
 func (u *drpolicyUpdater) statusConditionSet(conditionType string,
 	status metav1.ConditionStatus,
 	reason, message string,
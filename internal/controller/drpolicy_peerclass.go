@@ -21,12 +21,13 @@ import (
 // classLists contains [storage|snapshot|replication]classes from ManagedClusters with the required ramen storageID or,
 // replicationID labels
 type classLists struct {
-	clusterID  string
-	sClasses   []*storagev1.StorageClass
-	vsClasses  []*snapv1.VolumeSnapshotClass
-	vrClasses  []*volrep.VolumeReplicationClass
-	vgrClasses []*volrep.VolumeGroupReplicationClass
-	vgsClasses []*groupsnapv1beta1.VolumeGroupSnapshotClass
+	clusterID   string
+	clusterName string
+	sClasses    []*storagev1.StorageClass
+	vsClasses   []*snapv1.VolumeSnapshotClass
+	vrClasses   []*volrep.VolumeReplicationClass
+	vgrClasses  []*volrep.VolumeGroupReplicationClass
+	vgsClasses  []*groupsnapv1beta1.VolumeGroupSnapshotClass
 }
 
 // peerInfo contains a single peer relationship between a PAIR of clusters for a common storageClassName across
@@ -50,9 +51,14 @@ type peerInfo struct {
 	// a common replicationID or due to required VolumeSnapshotClasses on each cluster
 	storageIDs []string
 
-	// storageClassName is the name of a StorageClass that is common across the peers
+	// storageClassName is the name of a StorageClass that is common across the peers, or the name of the
+	// StorageClass on the first cluster in clusterIDs if peerStorageClassName is not empty
 	storageClassName string
 
+	// peerStorageClassName is the name of the StorageClass on the second cluster in clusterIDs, if it differs from
+	// storageClassName due to a StorageClassMapping in the DRPolicy
+	peerStorageClassName string
+
 	// clusterIDs is a list of 2 IDs that denote the IDs for the clusters in this peer relationship
 	clusterIDs []string
 
@@ -79,9 +85,17 @@ func peerClassMatchesPeer(pc ramen.PeerClass, peer peerInfo) bool {
 		match = pc.StorageClassName == peer.storageClassName
 	}
 
+	match = match && pc.PeerStorageClassName == peer.peerStorageClassName
+
 	return match && equalClusterIDSlices(pc.ClusterIDs, peer.clusterIDs)
 }
 
+// peerClassHasStorageClassName returns true if the passed in StorageClass name is the name of the StorageClass of the
+// PeerClass on any of its clusters
+func peerClassHasStorageClassName(pc *ramen.PeerClass, scName string) bool {
+	return pc.StorageClassName == scName || (pc.PeerStorageClassName != "" && pc.PeerStorageClassName == scName)
+}
+
 // findStatusPeerInPeers finds PeerClass in passed in peers, and returns true and the peer if founds
 func findStatusPeerInPeers(pc ramen.PeerClass, peers []peerInfo) (bool, peerInfo) {
 	for _, peer := range peers {
@@ -110,13 +124,14 @@ func findPeerInStatusPeer(peer peerInfo, pcs []ramen.PeerClass) bool {
 
 func peerClassFromPeer(peer peerInfo) ramen.PeerClass {
 	return ramen.PeerClass{
-		ClusterIDs:         peer.clusterIDs,
-		StorageClassName:   peer.storageClassName,
-		StorageID:          peer.storageIDs,
-		ReplicationID:      peer.replicationID,
-		GroupReplicationID: peer.groupReplicationID,
-		Grouping:           peer.grouping,
-		Offloaded:          peer.offloaded,
+		ClusterIDs:           peer.clusterIDs,
+		StorageClassName:     peer.storageClassName,
+		PeerStorageClassName: peer.peerStorageClassName,
+		StorageID:            peer.storageIDs,
+		ReplicationID:        peer.replicationID,
+		GroupReplicationID:   peer.groupReplicationID,
+		Grouping:             peer.grouping,
+		Offloaded:            peer.offloaded,
 	}
 }
 
@@ -148,14 +163,86 @@ func pruneAndUpdateStatusPeers(statusPeers []ramen.PeerClass, peers []peerInfo)
 	return outStatusPeers
 }
 
-// updatePeerClassStatus updates the DRPolicy.Status.[Async|Sync] peer lists based on passed in peerInfo values
-func updatePeerClassStatus(u *drpolicyUpdater, syncPeers, asyncPeers []peerInfo) error {
+// updatePeerClassStatus updates the DRPolicy.Status.[Async|Sync] peer lists based on passed in peerInfo values, and
+// the async peer lists of each scheduling tier based on the passed in tierPeers, that are indexed as per the
+// DRPolicy scheduling tiers
+func updatePeerClassStatus(u *drpolicyUpdater, syncPeers, asyncPeers []peerInfo, tierPeers [][]peerInfo) error {
 	u.object.Status.Async.PeerClasses = pruneAndUpdateStatusPeers(u.object.Status.Async.PeerClasses, asyncPeers)
 	u.object.Status.Sync.PeerClasses = pruneAndUpdateStatusPeers(u.object.Status.Sync.PeerClasses, syncPeers)
+	u.object.Status.Async.Tiers = pruneAndUpdateStatusTiers(u.object.Status.Async.Tiers,
+		u.object.Spec.SchedulingTiers, tierPeers)
 
 	return u.statusUpdate()
 }
 
+// pruneAndUpdateStatusTiers returns the async peer classes of each of the passed in tiers, updated from their
+// existing statusTiers with the passed in tierPeers, that are indexed as per tiers. Tiers no longer in the passed in
+// tiers are pruned.
+func pruneAndUpdateStatusTiers(
+	statusTiers []ramen.AsyncTier,
+	tiers []ramen.SchedulingTier,
+	tierPeers [][]peerInfo,
+) []ramen.AsyncTier {
+	outStatusTiers := []ramen.AsyncTier{}
+
+	for idx := range tiers {
+		statusPeers := []ramen.PeerClass{}
+
+		for _, statusTier := range statusTiers {
+			if statusTier.Name == tiers[idx].Name {
+				statusPeers = statusTier.PeerClasses
+
+				break
+			}
+		}
+
+		outStatusTiers = append(outStatusTiers, ramen.AsyncTier{
+			Name:        tiers[idx].Name,
+			PeerClasses: pruneAndUpdateStatusPeers(statusPeers, tierPeers[idx]),
+		})
+	}
+
+	return outStatusTiers
+}
+
+// tierPolicyPeers returns the passed in peers with the scheduling interval of their async pairs replaced by the
+// passed in tier scheduling interval, as a scheduling tier applies to all async pairs of clusters in a DRPolicy
+func tierPolicyPeers(peers []ramen.DRPolicyPeer, schedulingInterval string) []ramen.DRPolicyPeer {
+	tierPeers := make([]ramen.DRPolicyPeer, len(peers))
+
+	for idx := range peers {
+		peers[idx].DeepCopyInto(&tierPeers[idx])
+
+		if tierPeers[idx].ReplicationType == ramen.DRPolicyReplicationAsync {
+			tierPeers[idx].SchedulingInterval = schedulingInterval
+		}
+	}
+
+	return tierPeers
+}
+
+// findAllTierPeers finds all async PAIRs of peers in the passed in classLists for each of the passed in tiers, at
+// the scheduling interval of the tier. It also returns the StorageClasses that could not be paired at the interval
+// of a tier, unless they are in the passed in unmatched StorageClasses for the same reason.
+func findAllTierPeers(cls []classLists, tiers []ramen.SchedulingTier, peers []ramen.DRPolicyPeer,
+	mappings []ramen.StorageClassMapping, unmatched []ramen.UnmatchedStorageClass,
+) ([][]peerInfo, []ramen.UnmatchedStorageClass) {
+	tierPeers := make([][]peerInfo, len(tiers))
+	tiersUnmatched := []ramen.UnmatchedStorageClass{}
+
+	for idx := range tiers {
+		var tierUnmatched []ramen.UnmatchedStorageClass
+
+		_, tierPeers[idx], tierUnmatched = findAllPeers(cls, tiers[idx].SchedulingInterval,
+			tierPolicyPeers(peers, tiers[idx].SchedulingInterval), mappings)
+
+		tiersUnmatched = append(tiersUnmatched,
+			tierUnmatchedStorageClasses(tiers[idx].Name, tierUnmatched, unmatched)...)
+	}
+
+	return tierPeers, tiersUnmatched
+}
+
 // provisionerMatchesSC inspects StorageClass named scName in the passed in classLists and returns true if its
 // provisioner value matches the driver
 func provisionerMatchesSC(scName string, cl classLists, driver string) bool {
@@ -211,17 +298,17 @@ func hasVGSClassMatchingSID(scName string, cl classLists, sID string) bool {
 }
 
 // isAsyncVSClassPeer inspects provided pair of classLists for a matching VolumeSnapshotClass, that is linked to the
-// StorageClass whose storageID is respectively sIDA or sIDB
-func isAsyncVSClassPeer(scName string, clA, clB classLists, sIDA, sIDB string) bool {
+// StorageClass named scNameA or scNameB whose storageID is respectively sIDA or sIDB
+func isAsyncVSClassPeer(scNameA, scNameB string, clA, clB classLists, sIDA, sIDB string) bool {
 	// No provisioner match as we can do cross provisioner VSC based protection
-	return hasVSClassMatchingSID(scName, clA, sIDA) && hasVSClassMatchingSID(scName, clB, sIDB)
+	return hasVSClassMatchingSID(scNameA, clA, sIDA) && hasVSClassMatchingSID(scNameB, clB, sIDB)
 }
 
 // isAsyncVGSClassPeer inspects provided pair of classLists for a matching VolumeGroupSnapshotClass,
-// that is linked to the StorageClass whose storageID is respectively sIDA or sIDB
-func isAsyncVGSClassPeer(scName string, clA, clB classLists, sIDA, sIDB string) bool {
+// that is linked to the StorageClass named scNameA or scNameB whose storageID is respectively sIDA or sIDB
+func isAsyncVGSClassPeer(scNameA, scNameB string, clA, clB classLists, sIDA, sIDB string) bool {
 	// No provisioner match as we can do cross provisioner VGSC based protection
-	return hasVGSClassMatchingSID(scName, clA, sIDA) && hasVGSClassMatchingSID(scName, clB, sIDB)
+	return hasVGSClassMatchingSID(scNameA, clA, sIDA) && hasVGSClassMatchingSID(scNameB, clB, sIDB)
 }
 
 // getVRID inspects VolumeReplicationClass in the passed in classLists at the specified index, and returns,
@@ -269,16 +356,17 @@ func getVGRID(scName string, cl classLists, vgrcIdx int, inSID string, schedule
 }
 
 // getAsyncVRClassPeer inspects if there is a common replicationID among the vrClasses in the passed in classLists,
-// that relate to the corresponding storageIDs and schedule, and returns the replicationID or "" if there was no match
-func getAsyncVRClassPeer(scName string, clA, clB classLists, sIDA, sIDB string, schedule string) string {
+// that relate to the corresponding StorageClass names, storageIDs and schedule, and returns the replicationID or "" if
+// there was no match
+func getAsyncVRClassPeer(scNameA, scNameB string, clA, clB classLists, sIDA, sIDB string, schedule string) string {
 	for vrcAidx := range clA.vrClasses {
-		ridA := getVRID(scName, clA, vrcAidx, sIDA, schedule)
+		ridA := getVRID(scNameA, clA, vrcAidx, sIDA, schedule)
 		if ridA == "" {
 			continue
 		}
 
 		for vrcBidx := range clB.vrClasses {
-			ridB := getVRID(scName, clB, vrcBidx, sIDB, schedule)
+			ridB := getVRID(scNameB, clB, vrcBidx, sIDB, schedule)
 			if ridB == "" {
 				continue
 			}
@@ -295,18 +383,18 @@ func getAsyncVRClassPeer(scName string, clA, clB classLists, sIDA, sIDB string,
 }
 
 // getAsyncVGRClassPeer inspects if there is a common GroupReplicationID among the vgrClasses in the passed
-// in classLists, that relate to the corresponding storageIDs and schedule, and returns the GroupReplicationID or ""
-// if there is no match.
-func getAsyncVGRClassPeer(scName string, clA, clB classLists, sIDA, sIDB string, schedule string) string {
+// in classLists, that relate to the corresponding StorageClass names, storageIDs and schedule, and returns the
+// GroupReplicationID or "" if there is no match.
+func getAsyncVGRClassPeer(scNameA, scNameB string, clA, clB classLists, sIDA, sIDB string, schedule string) string {
 	for vgrcAidx := range clA.vgrClasses {
-		grIDA := getVGRID(scName, clA, vgrcAidx, sIDA, schedule)
+		grIDA := getVGRID(scNameA, clA, vgrcAidx, sIDA, schedule)
 
 		if grIDA == "" {
 			continue
 		}
 
 		for vgrcBidx := range clB.vgrClasses {
-			grIDB := getVGRID(scName, clB, vgrcBidx, sIDB, schedule)
+			grIDB := getVGRID(scNameB, clB, vgrcBidx, sIDB, schedule)
 			if grIDB == "" {
 				continue
 			}
@@ -322,9 +410,50 @@ func getAsyncVGRClassPeer(scName string, clA, clB classLists, sIDA, sIDB string,
 	return ""
 }
 
+// peerStorageClassName returns the name of the StorageClass on peerCluster that pairs with the StorageClass scName on
+// cluster, as per the passed in mappings. It returns scName if no mapping exists for it, unless a StorageClass named
+// scName on peerCluster is mapped to another StorageClass on cluster, in which case it returns an empty string as
+// scName has no peer on peerCluster.
+func peerStorageClassName(mappings []ramen.StorageClassMapping, cluster, scName, peerCluster string) string {
+	mappedOnPeer := false
+
+	for _, mapping := range mappings {
+		switch {
+		case mapping.SourceCluster == cluster && mapping.TargetCluster == peerCluster:
+			if mapping.SourceStorageClassName == scName {
+				return mapping.TargetStorageClassName
+			}
+
+			mappedOnPeer = mappedOnPeer || mapping.TargetStorageClassName == scName
+		case mapping.SourceCluster == peerCluster && mapping.TargetCluster == cluster:
+			if mapping.TargetStorageClassName == scName {
+				return mapping.SourceStorageClassName
+			}
+
+			mappedOnPeer = mappedOnPeer || mapping.SourceStorageClassName == scName
+		}
+	}
+
+	if mappedOnPeer {
+		return ""
+	}
+
+	return scName
+}
+
+// mappedPeerStorageClassName returns peerSCName if it differs from scName, else an empty string
+func mappedPeerStorageClassName(scName, peerSCName string) string {
+	if scName == peerSCName {
+		return ""
+	}
+
+	return peerSCName
+}
+
 // getAsyncPeers determines if scName in the first classList has asynchronous peers in the remaining classLists.
 // The clusterID and sID are the corresponding IDs for the first cluster in the classList, and the schedule is
-// the desired asynchronous schedule that requires to be matched.
+// the desired asynchronous schedule that requires to be matched. StorageClasses are paired across clusters by name,
+// or as per the passed in mappings.
 // Grouping logic:
 //   - Offloaded storage: Uses VGRC only, grouping = true when VGRC with ReplicationID exists
 //   - Non-offloaded storage: Uses VRC and VGRC, grouping = true when both exist with ReplicationID,
@@ -332,16 +461,20 @@ func getAsyncVGRClassPeer(scName string, clA, clB classLists, sIDA, sIDB string,
 //   - Snapshots: Uses VGSC and VSC, grouping = true when VGSC exists on both clusters for the same storageClass
 //
 // nolint:gocognit,cyclop,ineffassign,funlen
-func getAsyncPeers(scName, clusterID, sID string, offloaded bool, cls []classLists, schedule string) []peerInfo {
+func getAsyncPeers(scName, clusterID, sID string, offloaded bool, cls []classLists, schedule string,
+	mappings []ramen.StorageClassMapping,
+) []peerInfo {
 	peers := []peerInfo{}
 
 	for _, cl := range cls[1:] {
+		peerSCName := peerStorageClassName(mappings, cls[0].clusterName, scName, cl.clusterName)
+
 		for scIdx := range cl.sClasses {
 			var grouping bool
 
 			var rID, grID string
 
-			if cl.sClasses[scIdx].GetName() != scName {
+			if peerSCName == "" || cl.sClasses[scIdx].GetName() != peerSCName {
 				continue
 			}
 
@@ -355,7 +488,7 @@ func getAsyncPeers(scName, clusterID, sID string, offloaded bool, cls []classLis
 				continue
 			}
 
-			grID = getAsyncVGRClassPeer(scName, cls[0], cl, sID, sIDcl, schedule)
+			grID = getAsyncVGRClassPeer(scName, peerSCName, cls[0], cl, sID, sIDcl, schedule)
 			if offloaded && grID == "" {
 				continue
 			}
@@ -364,28 +497,29 @@ func getAsyncPeers(scName, clusterID, sID string, offloaded bool, cls []classLis
 			case true:
 				grouping = true
 			case false:
-				rID = getAsyncVRClassPeer(scName, cls[0], cl, sID, sIDcl, schedule)
+				rID = getAsyncVRClassPeer(scName, peerSCName, cls[0], cl, sID, sIDcl, schedule)
 				grouping = rID != "" && grID != ""
 
 				if rID == "" {
 					grID = ""
 
-					if isAsyncVGSClassPeer(scName, cls[0], cl, sID, sIDcl) {
+					if isAsyncVGSClassPeer(scName, peerSCName, cls[0], cl, sID, sIDcl) {
 						grouping = true
-					} else if !isAsyncVSClassPeer(scName, cls[0], cl, sID, sIDcl) {
+					} else if !isAsyncVSClassPeer(scName, peerSCName, cls[0], cl, sID, sIDcl) {
 						continue
 					}
 				}
 			}
 
 			peers = append(peers, peerInfo{
-				storageClassName:   scName,
-				storageIDs:         []string{sID, sIDcl},
-				clusterIDs:         []string{clusterID, cl.clusterID},
-				replicationID:      rID,
-				groupReplicationID: grID,
-				grouping:           grouping,
-				offloaded:          offloaded,
+				storageClassName:     scName,
+				peerStorageClassName: mappedPeerStorageClassName(scName, peerSCName),
+				storageIDs:           []string{sID, sIDcl},
+				clusterIDs:           []string{clusterID, cl.clusterID},
+				replicationID:        rID,
+				groupReplicationID:   grID,
+				grouping:             grouping,
+				offloaded:            offloaded,
 			})
 
 			break
@@ -396,13 +530,18 @@ func getAsyncPeers(scName, clusterID, sID string, offloaded bool, cls []classLis
 }
 
 // getSyncPeers determines if scName passed has asynchronous peers in the passed in classLists.
-// The clusterID and sID are the corresponding IDs for the passed in scName to find a match
-func getSyncPeers(scName string, clusterID string, sID string, cls []classLists) []peerInfo {
+// The clusterID and sID are the corresponding IDs for the passed in scName to find a match, and clusterName is
+// used to pair StorageClasses across clusters as per the passed in mappings
+func getSyncPeers(scName string, clusterID, clusterName string, sID string, cls []classLists,
+	mappings []ramen.StorageClassMapping,
+) []peerInfo {
 	peers := []peerInfo{}
 
 	for _, cl := range cls {
+		peerSCName := peerStorageClassName(mappings, clusterName, scName, cl.clusterName)
+
 		for idx := range cl.sClasses {
-			if cl.sClasses[idx].GetName() != scName {
+			if peerSCName == "" || cl.sClasses[idx].GetName() != peerSCName {
 				continue
 			}
 
@@ -413,9 +552,10 @@ func getSyncPeers(scName string, clusterID string, sID string, cls []classLists)
 			// TODO: Check provisioner match?
 
 			peers = append(peers, peerInfo{
-				storageClassName: scName,
-				storageIDs:       []string{sID},
-				clusterIDs:       []string{clusterID, cl.clusterID},
+				storageClassName:     scName,
+				peerStorageClassName: mappedPeerStorageClassName(scName, peerSCName),
+				storageIDs:           []string{sID},
+				clusterIDs:           []string{clusterID, cl.clusterID},
 			})
 
 			break
@@ -426,8 +566,12 @@ func getSyncPeers(scName string, clusterID string, sID string, cls []classLists)
 }
 
 // findPeers finds all sync and async peers for the scName and cluster at the index startClsIdx of classLists,
-// across other remaining elements post the startClsIdx in the classLists
-func findPeers(cls []classLists, scName string, startClsIdx int, schedule string) ([]peerInfo, []peerInfo) {
+// across other remaining elements post the startClsIdx in the classLists. Pairs of clusters declared in peers are
+// only paired as per their declared replication type and schedule, others are paired as per the passed in schedule.
+// It also returns the pairs of clusters that the StorageClass could not be paired across, with the reason why.
+func findPeers(cls []classLists, scName string, startClsIdx int, schedule string, peers []ramen.DRPolicyPeer,
+	mappings []ramen.StorageClassMapping,
+) ([]peerInfo, []peerInfo, []ramen.UnmatchedStorageClass) {
 	scIdx := 0
 	for scIdx = range cls[startClsIdx].sClasses {
 		if cls[startClsIdx].sClasses[scIdx].Name == scName {
@@ -436,12 +580,25 @@ func findPeers(cls []classLists, scName string, startClsIdx int, schedule string
 	}
 
 	if !util.HasLabel(cls[startClsIdx].sClasses[scIdx], StorageIDLabel) {
-		return nil, nil
+		return nil, nil, unmatchedStorageClasses(cls, scIdx, startClsIdx, schedule, peers, mappings, nil)
 	}
 
 	sID := cls[startClsIdx].sClasses[scIdx].Labels[StorageIDLabel]
+
+	syncCls := []classLists{}
+
+	for _, cl := range cls[startClsIdx+1:] {
+		peer := util.FindDRPolicyPeer(peers, cls[startClsIdx].clusterName, cl.clusterName)
+		if peer != nil && peer.ReplicationType != ramen.DRPolicyReplicationSync {
+			continue
+		}
+
+		syncCls = append(syncCls, cl)
+	}
+
 	// TODO: Check if Sync is non-nil?
-	syncPeers := getSyncPeers(scName, cls[startClsIdx].clusterID, sID, cls[startClsIdx+1:])
+	syncPeers := getSyncPeers(scName, cls[startClsIdx].clusterID, cls[startClsIdx].clusterName, sID, syncCls,
+		mappings)
 
 	offloaded := false
 	if util.HasLabel(cls[startClsIdx].sClasses[scIdx], StorageOffloadedLabel) {
@@ -449,11 +606,45 @@ func findPeers(cls []classLists, scName string, startClsIdx int, schedule string
 	}
 
 	asyncPeers := []peerInfo{}
-	if schedule != "" {
-		asyncPeers = getAsyncPeers(scName, cls[startClsIdx].clusterID, sID, offloaded, cls[startClsIdx:], schedule)
+
+	for _, cl := range cls[startClsIdx+1:] {
+		pairSchedule := schedule
+
+		peer := util.FindDRPolicyPeer(peers, cls[startClsIdx].clusterName, cl.clusterName)
+		if peer != nil {
+			pairSchedule = peer.SchedulingInterval
+		}
+
+		if pairSchedule == "" {
+			continue
+		}
+
+		pairPeers := getAsyncPeers(scName, cls[startClsIdx].clusterID, sID, offloaded,
+			[]classLists{cls[startClsIdx], cl}, pairSchedule, mappings)
+
+		asyncPeers = append(asyncPeers, pairPeers...)
+	}
+
+	return syncPeers, asyncPeers, unmatchedStorageClasses(cls, scIdx, startClsIdx, schedule, peers, mappings,
+		append(slices.Clone(syncPeers), asyncPeers...))
+}
+
+// unmatchedStorageClasses returns the pairs of clusters, of the cluster at the index startClsIdx of classLists and
+// the remaining clusters post it, that its StorageClass at scIdx is not found in the passed in matched peers for,
+// along with the reason it could not be paired
+func unmatchedStorageClasses(cls []classLists, scIdx, startClsIdx int, schedule string, peers []ramen.DRPolicyPeer,
+	mappings []ramen.StorageClassMapping, matched []peerInfo,
+) []ramen.UnmatchedStorageClass {
+	unmatched := []ramen.UnmatchedStorageClass{}
+
+	for _, cl := range cls[startClsIdx+1:] {
+		if sc := unmatchedStorageClass(cls[startClsIdx].sClasses[scIdx], cls[startClsIdx], cl, schedule, peers,
+			mappings, matched); sc != nil {
+			unmatched = append(unmatched, *sc)
+		}
 	}
 
-	return syncPeers, asyncPeers
+	return unmatched
 }
 
 // unionStorageClasses returns a union of all StorageClass names found in all clusters in the passed in classLists
@@ -474,13 +665,19 @@ func unionStorageClasses(cls []classLists) []string {
 }
 
 // findAllPeers finds all PAIRs of peers in the passed in classLists. It does an exhaustive search for each scName in
-// the prior index of classLists (starting at index 0) with all clusters from that index forward
-func findAllPeers(cls []classLists, schedule string) ([]peerInfo, []peerInfo) {
+// the prior index of classLists (starting at index 0) with all clusters from that index forward. StorageClasses are
+// paired across clusters by name, or as per the passed in mappings, and pairs of clusters are replicated as per the
+// passed in peers, or the schedule if they are not declared in peers. It also returns, for each pair of clusters,
+// the StorageClasses that could not be paired, along with the reason why.
+func findAllPeers(cls []classLists, schedule string, peers []ramen.DRPolicyPeer,
+	mappings []ramen.StorageClassMapping,
+) ([]peerInfo, []peerInfo, []ramen.UnmatchedStorageClass) {
 	syncPeers := []peerInfo{}
 	asyncPeers := []peerInfo{}
+	unmatched := []ramen.UnmatchedStorageClass{}
 
 	if len(cls) <= 1 {
-		return syncPeers, asyncPeers
+		return syncPeers, asyncPeers, unmatched
 	}
 
 	sClassNames := unionStorageClasses(cls)
@@ -491,7 +688,8 @@ func findAllPeers(cls []classLists, schedule string) ([]peerInfo, []peerInfo) {
 				continue
 			}
 
-			sPeers, aPeers := findPeers(cls, cls[clsIdx].sClasses[scIdx].Name, clsIdx, schedule)
+			sPeers, aPeers, unmatchedSCs := findPeers(cls, cls[clsIdx].sClasses[scIdx].Name, clsIdx, schedule,
+				peers, mappings)
 			if len(sPeers) != 0 {
 				syncPeers = append(syncPeers, sPeers...)
 			}
@@ -499,10 +697,16 @@ func findAllPeers(cls []classLists, schedule string) ([]peerInfo, []peerInfo) {
 			if len(aPeers) != 0 {
 				asyncPeers = append(asyncPeers, aPeers...)
 			}
+
+			unmatched = append(unmatched, unmatchedSCs...)
+		}
+
+		for _, cl := range cls[clsIdx+1:] {
+			unmatched = append(unmatched, unmatchedPeerStorageClasses(cls[clsIdx], cl, mappings)...)
 		}
 	}
 
-	return syncPeers, asyncPeers
+	return syncPeers, asyncPeers, unmatched
 }
 
 // pruneClassViews prunes existing views in mcvs, for classes that are not found in survivorClassNames
@@ -792,12 +996,13 @@ func getClusterClasses(
 	}
 
 	return classLists{
-		clusterID:  clID,
-		sClasses:   sClasses,
-		vrClasses:  vrClasses,
-		vsClasses:  vsClasses,
-		vgrClasses: vgrClasses,
-		vgsClasses: vgsClasses,
+		clusterID:   clID,
+		clusterName: cluster,
+		sClasses:    sClasses,
+		vrClasses:   vrClasses,
+		vsClasses:   vsClasses,
+		vgrClasses:  vgrClasses,
+		vgsClasses:  vgsClasses,
 	}, nil
 }
 
@@ -844,7 +1049,14 @@ func updatePeerClasses(u *drpolicyUpdater, m util.ManagedClusterViewGetter) erro
 		cls = append(cls, clusterClasses)
 	}
 
-	syncPeers, asyncPeers := findAllPeers(cls, u.object.Spec.SchedulingInterval)
+	syncPeers, asyncPeers, unmatched := findAllPeers(cls, u.object.Spec.SchedulingInterval, u.object.Spec.Peers,
+		u.object.Spec.StorageClassMapping)
+
+	tierPeers, tierUnmatched := findAllTierPeers(cls, u.object.Spec.SchedulingTiers, u.object.Spec.Peers,
+		u.object.Spec.StorageClassMapping, unmatched)
+
+	unmatched = append(unmatched, tierUnmatched...)
+	u.object.Status.UnmatchedStorageClasses = unmatched
 
-	return updatePeerClassStatus(u, syncPeers, asyncPeers)
+	return updatePeerClassStatus(u, syncPeers, asyncPeers, tierPeers)
 }
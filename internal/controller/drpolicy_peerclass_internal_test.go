@@ -11,6 +11,9 @@ import (
 	groupsnapv1beta1 "github.com/red-hat-storage/external-snapshotter/client/v8/apis/volumegroupsnapshot/v1beta1"
 	storagev1 "k8s.io/api/storage/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	ramen "github.com/ramendr/ramen/api/v1alpha1"
+	"github.com/ramendr/ramen/internal/controller/util"
 )
 
 // nolint:dupl
@@ -22,7 +25,7 @@ var _ = Describe("updatePeerClassesInternal", func() {
 			syncPeers []peerInfo,
 			asyncPeers []peerInfo,
 		) {
-			sPeers, aPeers := findAllPeers(cls, schedule)
+			sPeers, aPeers, _ := findAllPeers(cls, schedule, nil, nil)
 			Expect(sPeers).Should(HaveExactElements(syncPeers))
 			Expect(aPeers).Should(HaveExactElements(asyncPeers))
 		},
@@ -2498,4 +2501,484 @@ var _ = Describe("updatePeerClassesInternal", func() {
 			},
 		),
 	)
+
+	Describe("StorageClassMapping", func() {
+		mappings := []ramen.StorageClassMapping{
+			{
+				SourceCluster:          "cluster-1",
+				SourceStorageClassName: "fast-ceph",
+				TargetCluster:          "cluster-2",
+				TargetStorageClassName: "premium-ssd",
+			},
+		}
+
+		mappedClassLists := func(scNameOnPeer string) []classLists {
+			return []classLists{
+				{
+					clusterID:   "cl-1",
+					clusterName: "cluster-1",
+					sClasses: []*storagev1.StorageClass{
+						{
+							ObjectMeta: metav1.ObjectMeta{
+								Name:   "fast-ceph",
+								Labels: map[string]string{StorageIDLabel: "cl-1-sID"},
+							},
+							Provisioner: "rbd.csi.ceph.com",
+						},
+					},
+					vsClasses: []*snapv1.VolumeSnapshotClass{
+						{
+							ObjectMeta: metav1.ObjectMeta{
+								Name:   "vsc-ceph",
+								Labels: map[string]string{StorageIDLabel: "cl-1-sID"},
+							},
+							Driver: "rbd.csi.ceph.com",
+						},
+					},
+				},
+				{
+					clusterID:   "cl-2",
+					clusterName: "cluster-2",
+					sClasses: []*storagev1.StorageClass{
+						{
+							ObjectMeta: metav1.ObjectMeta{
+								Name:   scNameOnPeer,
+								Labels: map[string]string{StorageIDLabel: "cl-2-sID"},
+							},
+							Provisioner: "ssd.csi.example.com",
+						},
+					},
+					vsClasses: []*snapv1.VolumeSnapshotClass{
+						{
+							ObjectMeta: metav1.ObjectMeta{
+								Name:   "vsc-ssd",
+								Labels: map[string]string{StorageIDLabel: "cl-2-sID"},
+							},
+							Driver: "ssd.csi.example.com",
+						},
+					},
+				},
+			}
+		}
+
+		DescribeTable("peerStorageClassName",
+			func(cluster, scName, peerCluster, expected string) {
+				Expect(peerStorageClassName(mappings, cluster, scName, peerCluster)).To(Equal(expected))
+			},
+			Entry("maps from the source to the target cluster", "cluster-1", "fast-ceph", "cluster-2", "premium-ssd"),
+			Entry("maps from the target to the source cluster", "cluster-2", "premium-ssd", "cluster-1", "fast-ceph"),
+			Entry("keeps unmapped names", "cluster-1", "standard", "cluster-2", "standard"),
+			Entry("does not pair with a mapped name", "cluster-1", "premium-ssd", "cluster-2", ""),
+			Entry("ignores mappings of other clusters", "cluster-1", "fast-ceph", "cluster-3", "fast-ceph"),
+		)
+
+		It("pairs differently named StorageClasses across drivers", func() {
+			sPeers, aPeers, _ := findAllPeers(mappedClassLists("premium-ssd"), "1m", nil, mappings)
+			Expect(sPeers).To(BeEmpty())
+			Expect(aPeers).To(HaveExactElements(peerInfo{
+				storageIDs:           []string{"cl-1-sID", "cl-2-sID"},
+				storageClassName:     "fast-ceph",
+				peerStorageClassName: "premium-ssd",
+				clusterIDs:           []string{"cl-1", "cl-2"},
+			}))
+
+			peerClass := peerClassFromPeer(aPeers[0])
+			Expect(peerClassHasStorageClassName(&peerClass, "fast-ceph")).To(BeTrue())
+			Expect(peerClassHasStorageClassName(&peerClass, "premium-ssd")).To(BeTrue())
+		})
+
+		It("does not pair differently named StorageClasses without a mapping", func() {
+			_, aPeers, _ := findAllPeers(mappedClassLists("premium-ssd"), "1m", nil, nil)
+			Expect(aPeers).To(BeEmpty())
+		})
+
+		It("maps peer StorageClass names to the StorageClass names of a VRG cluster", func() {
+			Expect(vrgStorageClassMapping(mappings, "cluster-2")).To(Equal(map[string]string{"fast-ceph": "premium-ssd"}))
+			Expect(vrgStorageClassMapping(mappings, "cluster-1")).To(Equal(map[string]string{"premium-ssd": "fast-ceph"}))
+			Expect(vrgStorageClassMapping(mappings, "cluster-3")).To(BeNil())
+		})
+	})
+
+	Describe("Peers", func() {
+		classList := func(clusterID, clusterName, sID string) classLists {
+			return classLists{
+				clusterID:   clusterID,
+				clusterName: clusterName,
+				sClasses: []*storagev1.StorageClass{
+					{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:   "sc1",
+							Labels: map[string]string{StorageIDLabel: sID},
+						},
+						Provisioner: "sample.csi.com",
+					},
+				},
+				vsClasses: []*snapv1.VolumeSnapshotClass{
+					{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:   "vsc1",
+							Labels: map[string]string{StorageIDLabel: sID},
+						},
+						Driver: "sample.csi.com",
+					},
+				},
+			}
+		}
+
+		cls := []classLists{
+			classList("cl-1", "cluster-1", "metro-sID"),
+			classList("cl-2", "cluster-2", "metro-sID"),
+			classList("cl-3", "cluster-3", "remote-sID"),
+		}
+
+		drpolicy := func(peers ...ramen.DRPolicyPeer) *ramen.DRPolicy {
+			return &ramen.DRPolicy{
+				Spec: ramen.DRPolicySpec{
+					SchedulingInterval: "1m",
+					DRClusters:         []string{"cluster-1", "cluster-2", "cluster-3"},
+					Peers:              peers,
+				},
+			}
+		}
+
+		metroPeer := ramen.DRPolicyPeer{
+			Clusters:        []string{"cluster-1", "cluster-2"},
+			ReplicationType: ramen.DRPolicyReplicationSync,
+		}
+
+		remotePeer := ramen.DRPolicyPeer{
+			Clusters:           []string{"cluster-3", "cluster-1"},
+			ReplicationType:    ramen.DRPolicyReplicationAsync,
+			SchedulingInterval: "5m",
+		}
+
+		It("pairs undeclared clusters as per the policy schedule", func() {
+			sPeers, aPeers, _ := findAllPeers(cls, "1m", nil, nil)
+			Expect(sPeers).To(HaveExactElements(peerInfo{
+				storageIDs:       []string{"metro-sID"},
+				storageClassName: "sc1",
+				clusterIDs:       []string{"cl-1", "cl-2"},
+			}))
+			Expect(aPeers).To(HaveExactElements(
+				peerInfo{
+					storageIDs:       []string{"metro-sID", "remote-sID"},
+					storageClassName: "sc1",
+					clusterIDs:       []string{"cl-1", "cl-3"},
+				},
+				peerInfo{
+					storageIDs:       []string{"metro-sID", "remote-sID"},
+					storageClassName: "sc1",
+					clusterIDs:       []string{"cl-2", "cl-3"},
+				},
+			))
+		})
+
+		It("pairs declared clusters as per their replication type and schedule", func() {
+			sPeers, aPeers, _ := findAllPeers(cls, "1m", drpolicy(metroPeer, remotePeer).Spec.Peers, nil)
+			Expect(sPeers).To(HaveExactElements(peerInfo{
+				storageIDs:       []string{"metro-sID"},
+				storageClassName: "sc1",
+				clusterIDs:       []string{"cl-1", "cl-2"},
+			}))
+			Expect(aPeers).To(HaveExactElements(
+				peerInfo{
+					storageIDs:       []string{"metro-sID", "remote-sID"},
+					storageClassName: "sc1",
+					clusterIDs:       []string{"cl-1", "cl-3"},
+				},
+				peerInfo{
+					storageIDs:       []string{"metro-sID", "remote-sID"},
+					storageClassName: "sc1",
+					clusterIDs:       []string{"cl-2", "cl-3"},
+				},
+			))
+		})
+
+		It("does not pair clusters declared as Async synchronously", func() {
+			asyncPeer := ramen.DRPolicyPeer{
+				Clusters:           []string{"cluster-1", "cluster-2"},
+				ReplicationType:    ramen.DRPolicyReplicationAsync,
+				SchedulingInterval: "1h",
+			}
+
+			sPeers, _, _ := findAllPeers(cls, "", drpolicy(asyncPeer).Spec.Peers, nil)
+			Expect(sPeers).To(BeEmpty())
+		})
+
+		It("reports the replication schedules of a cluster across its peers", func() {
+			policy := drpolicy(metroPeer, remotePeer)
+			Expect(util.DRPolicyPeerSchedulingInterval(policy, "cluster-1", "cluster-3")).To(Equal("5m"))
+			Expect(util.DRPolicyPeerSchedulingInterval(policy, "cluster-2", "cluster-3")).To(Equal("1m"))
+			Expect(util.DRPolicyClusterSchedulingIntervals(policy, "cluster-1")).To(Equal([]string{"5m"}))
+			Expect(util.DRPolicyClusterSchedulingIntervals(policy, "cluster-3")).To(Equal([]string{"5m", "1m"}))
+			Expect(util.DRPolicyClusterSchedulingIntervals(policy, "cluster-4")).To(BeEmpty())
+			Expect(util.DRPolicyClusterSchedulingInterval(policy, "cluster-3")).To(Equal("1m"))
+			Expect(util.DRPolicyClusterSchedulingInterval(policy, "cluster-1")).To(Equal("5m"))
+		})
+
+		DescribeTable("validatePeers",
+			func(valid bool, peers ...ramen.DRPolicyPeer) {
+				err := validatePeers(drpolicy(peers...))
+				if valid {
+					Expect(err).ToNot(HaveOccurred())
+				} else {
+					Expect(err).To(HaveOccurred())
+				}
+			},
+			Entry("accepts distinct pairs", true, remotePeer, ramen.DRPolicyPeer{
+				Clusters:           []string{"cluster-2", "cluster-3"},
+				ReplicationType:    ramen.DRPolicyReplicationAsync,
+				SchedulingInterval: "1h",
+			}),
+			Entry("rejects a cluster outside the policy", false, ramen.DRPolicyPeer{
+				Clusters:           []string{"cluster-1", "cluster-4"},
+				ReplicationType:    ramen.DRPolicyReplicationAsync,
+				SchedulingInterval: "1h",
+			}),
+			Entry("rejects a pair of the same cluster", false, ramen.DRPolicyPeer{
+				Clusters:           []string{"cluster-1", "cluster-1"},
+				ReplicationType:    ramen.DRPolicyReplicationAsync,
+				SchedulingInterval: "1h",
+			}),
+			Entry("rejects a Sync pair in an asynchronous policy", false, metroPeer, remotePeer),
+			Entry("rejects a pair declared twice", false, remotePeer, ramen.DRPolicyPeer{
+				Clusters:           []string{"cluster-1", "cluster-3"},
+				ReplicationType:    ramen.DRPolicyReplicationAsync,
+				SchedulingInterval: "1h",
+			}),
+		)
+
+		It("rejects async peer classes without replication classes only in policies of more than two clusters", func() {
+			policy := drpolicy()
+			policy.Status.Async.PeerClasses = []ramen.PeerClass{{StorageClassName: "sc1", ReplicationID: "rID"}}
+			policy.Status.Async.Tiers = []ramen.AsyncTier{{
+				Name:        "gold",
+				PeerClasses: []ramen.PeerClass{{StorageClassName: "sc2", GroupReplicationID: "grID"}},
+			}}
+			Expect(validatePeerClassesReplication(policy)).To(Succeed())
+
+			policy.Status.Async.Tiers[0].PeerClasses = append(policy.Status.Async.Tiers[0].PeerClasses,
+				ramen.PeerClass{StorageClassName: "cephfs", ClusterIDs: []string{"cl-1", "cl-3"}})
+			Expect(validatePeerClassesReplication(policy)).To(MatchError(ContainSubstring("storageClass cephfs")))
+
+			policy.Spec.DRClusters = policy.Spec.DRClusters[:2]
+			Expect(validatePeerClassesReplication(policy)).To(Succeed())
+		})
+	})
+
+	Describe("SchedulingTiers", func() {
+		vrClass := func(sID, schedule string) *volrep.VolumeReplicationClass {
+			return &volrep.VolumeReplicationClass{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "vrc-" + schedule,
+					Labels: map[string]string{
+						StorageIDLabel:     sID,
+						ReplicationIDLabel: "rID-" + schedule,
+					},
+				},
+				Spec: volrep.VolumeReplicationClassSpec{
+					Provisioner: "sample.csi.com",
+					Parameters:  map[string]string{ReplicationClassScheduleKey: schedule},
+				},
+			}
+		}
+
+		classList := func(clusterID, clusterName, sID string) classLists {
+			return classLists{
+				clusterID:   clusterID,
+				clusterName: clusterName,
+				sClasses: []*storagev1.StorageClass{
+					{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:   "sc1",
+							Labels: map[string]string{StorageIDLabel: sID},
+						},
+						Provisioner: "sample.csi.com",
+					},
+				},
+				vrClasses: []*volrep.VolumeReplicationClass{vrClass(sID, "1m"), vrClass(sID, "1h")},
+			}
+		}
+
+		cls := []classLists{
+			classList("cl-1", "cluster-1", "cl-1-sID"),
+			classList("cl-2", "cluster-2", "cl-2-sID"),
+		}
+
+		tiers := []ramen.SchedulingTier{
+			{Name: "gold", SchedulingInterval: "1m"},
+			{Name: "bronze", SchedulingInterval: "1h"},
+			{Name: "silver", SchedulingInterval: "15m"},
+		}
+
+		tierPeer := func(rID string) peerInfo {
+			return peerInfo{
+				replicationID:    rID,
+				storageIDs:       []string{"cl-1-sID", "cl-2-sID"},
+				storageClassName: "sc1",
+				clusterIDs:       []string{"cl-1", "cl-2"},
+			}
+		}
+
+		It("finds the async peers of each tier at its scheduling interval", func() {
+			tierPeers, _ := findAllTierPeers(cls, tiers, nil, nil, nil)
+			Expect(tierPeers).To(HaveLen(3))
+			Expect(tierPeers[0]).To(HaveExactElements(tierPeer("rID-1m")))
+			Expect(tierPeers[1]).To(HaveExactElements(tierPeer("rID-1h")))
+			Expect(tierPeers[2]).To(BeEmpty())
+		})
+
+		It("applies the tier scheduling interval to async pairs declared in peers", func() {
+			peers := []ramen.DRPolicyPeer{{
+				Clusters:           []string{"cluster-1", "cluster-2"},
+				ReplicationType:    ramen.DRPolicyReplicationAsync,
+				SchedulingInterval: "1m",
+			}}
+
+			tierPeers, _ := findAllTierPeers(cls, tiers[1:2], peers, nil, nil)
+			Expect(tierPeers[0]).To(HaveExactElements(tierPeer("rID-1h")))
+			Expect(peers[0].SchedulingInterval).To(Equal("1m"))
+		})
+
+		It("prunes the status of tiers that are no longer in the policy", func() {
+			statusTiers := pruneAndUpdateStatusTiers(
+				[]ramen.AsyncTier{{Name: "platinum"}, {Name: "gold"}},
+				tiers[:1],
+				[][]peerInfo{{tierPeer("rID-1m")}},
+			)
+			Expect(statusTiers).To(HaveExactElements(ramen.AsyncTier{
+				Name:        "gold",
+				PeerClasses: []ramen.PeerClass{peerClassFromPeer(tierPeer("rID-1m"))},
+			}))
+		})
+
+		It("adds the tier scheduling intervals to the schedules of clusters with async peers", func() {
+			drpolicy := &ramen.DRPolicy{
+				Spec: ramen.DRPolicySpec{
+					SchedulingInterval: "5m",
+					DRClusters:         []string{"cluster-1", "cluster-2"},
+					SchedulingTiers:    tiers,
+				},
+			}
+			Expect(drClusterConfigSchedulingIntervals(drpolicy, "cluster-1")).To(
+				Equal([]string{"5m", "1m", "1h", "15m"}))
+
+			drpolicy.Spec.SchedulingInterval = ""
+			Expect(drClusterConfigSchedulingIntervals(drpolicy, "cluster-1")).To(BeEmpty())
+		})
+	})
+
+	Describe("UnmatchedStorageClasses", func() {
+		storageClass := func(name, sID string) *storagev1.StorageClass {
+			return &storagev1.StorageClass{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:   name,
+					Labels: map[string]string{StorageIDLabel: sID},
+				},
+				Provisioner: "sample.csi.com",
+			}
+		}
+
+		vrClass := func(sID, rID, schedule string) *volrep.VolumeReplicationClass {
+			return &volrep.VolumeReplicationClass{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:   "vrc-" + sID,
+					Labels: map[string]string{StorageIDLabel: sID, ReplicationIDLabel: rID},
+				},
+				Spec: volrep.VolumeReplicationClassSpec{
+					Provisioner: "sample.csi.com",
+					Parameters:  map[string]string{ReplicationClassScheduleKey: schedule},
+				},
+			}
+		}
+
+		classList := func(clusterID, clusterName, sID string, vrClasses ...*volrep.VolumeReplicationClass) classLists {
+			return classLists{
+				clusterID:   clusterID,
+				clusterName: clusterName,
+				sClasses:    []*storagev1.StorageClass{storageClass("sc1", sID)},
+				vrClasses:   vrClasses,
+			}
+		}
+
+		unmatched := func(cls []classLists, peers []ramen.DRPolicyPeer) []ramen.UnmatchedStorageClass {
+			_, _, unmatched := findAllPeers(cls, "1m", peers, nil)
+
+			return unmatched
+		}
+
+		unmatchedSC := func(scName, reason string) ramen.UnmatchedStorageClass {
+			return ramen.UnmatchedStorageClass{
+				StorageClassName: scName,
+				Clusters:         []string{"cluster-1", "cluster-2"},
+				Reason:           reason,
+			}
+		}
+
+		It("reports no StorageClasses when all are paired", func() {
+			Expect(unmatched([]classLists{
+				classList("cl-1", "cluster-1", "sID-1", vrClass("sID-1", "rID", "1m")),
+				classList("cl-2", "cluster-2", "sID-2", vrClass("sID-2", "rID", "1m")),
+			}, nil)).To(BeEmpty())
+		})
+
+		It("reports StorageClasses missing on either cluster", func() {
+			cl1 := classList("cl-1", "cluster-1", "sID")
+			cl2 := classList("cl-2", "cluster-2", "sID")
+			cl2.sClasses = append(cl2.sClasses, storageClass("sc2", "sID"))
+
+			Expect(unmatched([]classLists{cl1, cl2}, nil)).To(HaveExactElements(
+				unmatchedSC("sc2", "StorageClass sc2 not found on cluster cluster-1"),
+			))
+		})
+
+		It("reports StorageClasses sharing a storageID across clusters declared as Async peers", func() {
+			peers := []ramen.DRPolicyPeer{{
+				Clusters:           []string{"cluster-1", "cluster-2"},
+				ReplicationType:    ramen.DRPolicyReplicationAsync,
+				SchedulingInterval: "5m",
+			}}
+
+			Expect(unmatched([]classLists{
+				classList("cl-1", "cluster-1", "sID"),
+				classList("cl-2", "cluster-2", "sID"),
+			}, peers)).To(HaveExactElements(unmatchedSC("sc1",
+				"StorageClasses share storageID sID, but clusters cluster-1 and cluster-2 are declared Async peers")))
+		})
+
+		It("reports the missing replication and snapshot classes", func() {
+			Expect(unmatched([]classLists{
+				classList("cl-1", "cluster-1", "sID-1", vrClass("sID-1", "rID", "1m")),
+				classList("cl-2", "cluster-2", "sID-2", vrClass("sID-2", "rID", "5m")),
+			}, nil)).To(HaveExactElements(unmatchedSC("sc1",
+				"VolumeReplicationClass vrc-sID-2 on cluster cluster-2 has schedule \"5m\" instead of 1m; "+
+					"no VolumeGroupSnapshotClass labeled with storageID sID-1 on cluster cluster-1; "+
+					"no VolumeSnapshotClass labeled with storageID sID-1 on cluster cluster-1")))
+		})
+
+		It("reports replication classes without a common replicationID", func() {
+			reasons := unmatched([]classLists{
+				classList("cl-1", "cluster-1", "sID-1", vrClass("sID-1", "rID-1", "1m")),
+				classList("cl-2", "cluster-2", "sID-2", vrClass("sID-2", "rID-2", "1m")),
+			}, nil)
+			Expect(reasons).To(HaveLen(1))
+			Expect(reasons[0].Reason).To(HavePrefix("VolumeReplicationClass " + ReplicationIDLabel +
+				" values [rID-1] on cluster cluster-1 and [rID-2] on cluster cluster-2 have no common value"))
+		})
+
+		It("reports StorageClasses that do not pair at the interval of a tier once, for the tier", func() {
+			cl1 := classList("cl-1", "cluster-1", "sID-1", vrClass("sID-1", "rID", "1m"))
+			cl2 := classList("cl-2", "cluster-2", "sID-2", vrClass("sID-2", "rID", "1m"))
+			cl2.sClasses = append(cl2.sClasses, storageClass("sc2", "sID-2"))
+			cls := []classLists{cl1, cl2}
+
+			_, tierUnmatched := findAllTierPeers(cls, []ramen.SchedulingTier{{Name: "gold", SchedulingInterval: "5m"}},
+				nil, nil, unmatched(cls, nil))
+			Expect(tierUnmatched).To(HaveLen(1))
+			Expect(tierUnmatched[0].StorageClassName).To(Equal("sc1"))
+			Expect(tierUnmatched[0].Tier).To(Equal("gold"))
+			Expect(tierUnmatched[0].Reason).To(HavePrefix(
+				"VolumeReplicationClass vrc-sID-1 on cluster cluster-1 has schedule \"1m\" instead of 5m"))
+		})
+	})
 })
@@ -0,0 +1,369 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	storagev1 "k8s.io/api/storage/v1"
+
+	ramen "github.com/ramendr/ramen/api/v1alpha1"
+	"github.com/ramendr/ramen/internal/controller/util"
+)
+
+// replicationClassInfo contains the attributes of a VolumeReplicationClass or VolumeGroupReplicationClass that are
+// used to pair StorageClasses across clusters
+type replicationClassInfo struct {
+	name          string
+	storageID     string
+	schedule      string
+	provisioner   string
+	replicationID string
+}
+
+// snapshotClassInfo contains the attributes of a VolumeSnapshotClass or VolumeGroupSnapshotClass that are used to
+// pair StorageClasses across clusters
+type snapshotClassInfo struct {
+	name      string
+	storageID string
+	driver    string
+}
+
+func vrClassInfos(cl classLists) []replicationClassInfo {
+	infos := []replicationClassInfo{}
+
+	for _, vrc := range cl.vrClasses {
+		infos = append(infos, replicationClassInfo{
+			name:          vrc.GetName(),
+			storageID:     vrc.GetLabels()[StorageIDLabel],
+			schedule:      vrc.Spec.Parameters[ReplicationClassScheduleKey],
+			provisioner:   vrc.Spec.Provisioner,
+			replicationID: vrc.GetLabels()[ReplicationIDLabel],
+		})
+	}
+
+	return infos
+}
+
+func vgrClassInfos(cl classLists) []replicationClassInfo {
+	infos := []replicationClassInfo{}
+
+	for _, vgrc := range cl.vgrClasses {
+		infos = append(infos, replicationClassInfo{
+			name:          vgrc.GetName(),
+			storageID:     vgrc.GetLabels()[StorageIDLabel],
+			schedule:      vgrc.Spec.Parameters[ReplicationClassScheduleKey],
+			provisioner:   vgrc.Spec.Provisioner,
+			replicationID: vgrc.GetLabels()[GroupReplicationIDLabel],
+		})
+	}
+
+	return infos
+}
+
+func vsClassInfos(cl classLists) []snapshotClassInfo {
+	infos := []snapshotClassInfo{}
+
+	for _, vsc := range cl.vsClasses {
+		infos = append(infos, snapshotClassInfo{
+			name:      vsc.GetName(),
+			storageID: vsc.GetLabels()[StorageIDLabel],
+			driver:    vsc.Driver,
+		})
+	}
+
+	return infos
+}
+
+func vgsClassInfos(cl classLists) []snapshotClassInfo {
+	infos := []snapshotClassInfo{}
+
+	for _, vgsc := range cl.vgsClasses {
+		infos = append(infos, snapshotClassInfo{
+			name:      vgsc.GetName(),
+			storageID: vgsc.GetLabels()[StorageIDLabel],
+			driver:    vgsc.Driver,
+		})
+	}
+
+	return infos
+}
+
+// findStorageClass returns the StorageClass named scName in the passed in classLists, or nil if not found
+func findStorageClass(cl classLists, scName string) *storagev1.StorageClass {
+	for idx := range cl.sClasses {
+		if cl.sClasses[idx].GetName() == scName {
+			return cl.sClasses[idx]
+		}
+	}
+
+	return nil
+}
+
+// replicationIDsOnCluster returns the replicationIDs of the replication classes, of the passed in kind, that match the
+// passed in StorageClass on the cluster at the passed in schedule. If there are none, it returns the reason each of
+// the classes with the storageID of the StorageClass does not match.
+func replicationIDsOnCluster(kind, idLabel string, sc *storagev1.StorageClass, cl classLists,
+	infos []replicationClassInfo, schedule string,
+) ([]string, string) {
+	sID := sc.GetLabels()[StorageIDLabel]
+	rIDs := []string{}
+	reasons := []string{}
+
+	for _, info := range infos {
+		if info.storageID != sID {
+			continue
+		}
+
+		switch {
+		case info.schedule != schedule:
+			reasons = append(reasons, fmt.Sprintf("%s %s on cluster %s has schedule %q instead of %s", kind,
+				info.name, cl.clusterName, info.schedule, schedule))
+		case info.provisioner != sc.Provisioner:
+			reasons = append(reasons, fmt.Sprintf("%s %s on cluster %s has provisioner %s instead of %s of "+
+				"StorageClass %s", kind, info.name, cl.clusterName, info.provisioner, sc.Provisioner, sc.GetName()))
+		case info.replicationID == "":
+			reasons = append(reasons, fmt.Sprintf("%s %s on cluster %s has no %s label", kind, info.name,
+				cl.clusterName, idLabel))
+		default:
+			rIDs = append(rIDs, info.replicationID)
+		}
+	}
+
+	if len(reasons) == 0 {
+		return rIDs, fmt.Sprintf("no %s labeled with storageID %s on cluster %s", kind, sID, cl.clusterName)
+	}
+
+	return rIDs, strings.Join(reasons, ", ")
+}
+
+// replicationClassesReason returns the reason the replication classes, of the passed in kind, on the pair of clusters
+// fail to pair the passed in StorageClasses at the passed in schedule, or an empty string if they pair
+func replicationClassesReason(kind, idLabel string, scA, scB *storagev1.StorageClass, clA, clB classLists,
+	infosA, infosB []replicationClassInfo, schedule string,
+) string {
+	rIDsA, reasonA := replicationIDsOnCluster(kind, idLabel, scA, clA, infosA, schedule)
+	if len(rIDsA) == 0 {
+		return reasonA
+	}
+
+	rIDsB, reasonB := replicationIDsOnCluster(kind, idLabel, scB, clB, infosB, schedule)
+	if len(rIDsB) == 0 {
+		return reasonB
+	}
+
+	for _, rID := range rIDsA {
+		if slices.Contains(rIDsB, rID) {
+			return ""
+		}
+	}
+
+	return fmt.Sprintf("%s %s values %v on cluster %s and %v on cluster %s have no common value", kind, idLabel,
+		rIDsA, clA.clusterName, rIDsB, clB.clusterName)
+}
+
+// snapshotClassReason returns the reason no snapshot class, of the passed in kind, on the cluster matches the passed in
+// StorageClass, or an empty string if one matches
+func snapshotClassReason(kind string, sc *storagev1.StorageClass, cl classLists, infos []snapshotClassInfo) string {
+	sID := sc.GetLabels()[StorageIDLabel]
+	reasons := []string{}
+
+	for _, info := range infos {
+		if info.storageID != sID {
+			continue
+		}
+
+		if info.driver == sc.Provisioner {
+			return ""
+		}
+
+		reasons = append(reasons, fmt.Sprintf("%s %s on cluster %s has driver %s instead of %s of StorageClass %s",
+			kind, info.name, cl.clusterName, info.driver, sc.Provisioner, sc.GetName()))
+	}
+
+	if len(reasons) == 0 {
+		return fmt.Sprintf("no %s labeled with storageID %s on cluster %s", kind, sID, cl.clusterName)
+	}
+
+	return strings.Join(reasons, ", ")
+}
+
+// snapshotClassesReason returns the reason the snapshot classes, of the passed in kind, on the pair of clusters fail
+// to pair the passed in StorageClasses, or an empty string if they pair
+func snapshotClassesReason(kind string, scA, scB *storagev1.StorageClass, clA, clB classLists,
+	infosA, infosB []snapshotClassInfo,
+) string {
+	if reason := snapshotClassReason(kind, scA, clA, infosA); reason != "" {
+		return reason
+	}
+
+	return snapshotClassReason(kind, scB, clB, infosB)
+}
+
+// asyncUnmatchedReason returns the reason the passed in StorageClasses, with distinct storageIDs, are not paired
+// asynchronously across the pair of clusters at the passed in schedule
+func asyncUnmatchedReason(scA, scB *storagev1.StorageClass, clA, clB classLists, schedule string) string {
+	offloaded := util.HasLabel(scA, StorageOffloadedLabel)
+	if offloaded != util.HasLabel(scB, StorageOffloadedLabel) {
+		return fmt.Sprintf("StorageClass %s is labeled %s on only one of the clusters %s and %s", scA.GetName(),
+			StorageOffloadedLabel, clA.clusterName, clB.clusterName)
+	}
+
+	vgrcReason := replicationClassesReason("VolumeGroupReplicationClass", GroupReplicationIDLabel, scA, scB,
+		clA, clB, vgrClassInfos(clA), vgrClassInfos(clB), schedule)
+	if offloaded {
+		return vgrcReason
+	}
+
+	reasons := []string{
+		replicationClassesReason("VolumeReplicationClass", ReplicationIDLabel, scA, scB, clA, clB,
+			vrClassInfos(clA), vrClassInfos(clB), schedule),
+		snapshotClassesReason("VolumeGroupSnapshotClass", scA, scB, clA, clB, vgsClassInfos(clA), vgsClassInfos(clB)),
+		snapshotClassesReason("VolumeSnapshotClass", scA, scB, clA, clB, vsClassInfos(clA), vsClassInfos(clB)),
+	}
+
+	if slices.Contains(reasons, "") {
+		return ""
+	}
+
+	return strings.Join(reasons, "; ")
+}
+
+// unmatchedReason returns the reason the StorageClass scA on clA is not paired with the StorageClass scB on clB, as
+// per the passed in peer declared for the pair of clusters, or the schedule if the pair is not declared
+func unmatchedReason(scA, scB *storagev1.StorageClass, clA, clB classLists, peer *ramen.DRPolicyPeer,
+	schedule string,
+) string {
+	for _, sc := range []struct {
+		sc *storagev1.StorageClass
+		cl classLists
+	}{{scA, clA}, {scB, clB}} {
+		if !util.HasLabel(sc.sc, StorageIDLabel) {
+			return fmt.Sprintf("StorageClass %s on cluster %s has no %s label", sc.sc.GetName(), sc.cl.clusterName,
+				StorageIDLabel)
+		}
+	}
+
+	sIDA := scA.GetLabels()[StorageIDLabel]
+	sIDB := scB.GetLabels()[StorageIDLabel]
+
+	if sIDA == sIDB {
+		if peer != nil && peer.ReplicationType != ramen.DRPolicyReplicationSync {
+			return fmt.Sprintf("StorageClasses share storageID %s, but clusters %s and %s are declared %s peers",
+				sIDA, clA.clusterName, clB.clusterName, peer.ReplicationType)
+		}
+
+		return ""
+	}
+
+	if peer != nil {
+		if peer.ReplicationType == ramen.DRPolicyReplicationSync {
+			return fmt.Sprintf("StorageClasses have distinct storageIDs %s and %s, but clusters %s and %s are "+
+				"declared %s peers", sIDA, sIDB, clA.clusterName, clB.clusterName, peer.ReplicationType)
+		}
+
+		schedule = peer.SchedulingInterval
+	}
+
+	if schedule == "" {
+		return fmt.Sprintf("StorageClasses have distinct storageIDs %s and %s, but no schedulingInterval is set "+
+			"for asynchronous replication", sIDA, sIDB)
+	}
+
+	return asyncUnmatchedReason(scA, scB, clA, clB, schedule)
+}
+
+// peersHavePair returns true if the passed in peers have a peer for the StorageClass scName across the passed in
+// pair of clusterIDs
+func peersHavePair(peers []peerInfo, scName string, clusterIDs []string) bool {
+	for _, peer := range peers {
+		if peer.storageClassName == scName && equalClusterIDSlices(peer.clusterIDs, clusterIDs) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// unmatchedStorageClass returns the StorageClass sc on clA along with the reason it could not be paired with a
+// StorageClass on clB, as per the peer declared for the pair of clusters in the passed in peers, or the schedule if
+// the pair is not declared, or nil if it is found in the passed in matched peers
+func unmatchedStorageClass(sc *storagev1.StorageClass, clA, clB classLists, schedule string,
+	peers []ramen.DRPolicyPeer, mappings []ramen.StorageClassMapping, matched []peerInfo,
+) *ramen.UnmatchedStorageClass {
+	if peersHavePair(matched, sc.GetName(), []string{clA.clusterID, clB.clusterID}) {
+		return nil
+	}
+
+	var reason string
+
+	peerSCName := peerStorageClassName(mappings, clA.clusterName, sc.GetName(), clB.clusterName)
+	peerSC := findStorageClass(clB, peerSCName)
+
+	switch {
+	case peerSCName == "":
+		reason = fmt.Sprintf("StorageClass %s on cluster %s is mapped to another StorageClass on cluster %s",
+			sc.GetName(), clB.clusterName, clA.clusterName)
+	case peerSC == nil:
+		reason = fmt.Sprintf("StorageClass %s not found on cluster %s", peerSCName, clB.clusterName)
+	default:
+		reason = unmatchedReason(sc, peerSC, clA, clB, util.FindDRPolicyPeer(peers, clA.clusterName, clB.clusterName),
+			schedule)
+	}
+
+	if reason == "" {
+		return nil
+	}
+
+	return &ramen.UnmatchedStorageClass{
+		StorageClassName: sc.GetName(),
+		Clusters:         []string{clA.clusterName, clB.clusterName},
+		Reason:           reason,
+	}
+}
+
+// unmatchedPeerStorageClasses returns the StorageClasses on clB that are not found on clA, which are not paired from
+// clA as they do not exist on it
+func unmatchedPeerStorageClasses(clA, clB classLists, mappings []ramen.StorageClassMapping,
+) []ramen.UnmatchedStorageClass {
+	unmatched := []ramen.UnmatchedStorageClass{}
+
+	for _, sc := range clB.sClasses {
+		peerSCName := peerStorageClassName(mappings, clB.clusterName, sc.GetName(), clA.clusterName)
+		if peerSCName == "" || findStorageClass(clA, peerSCName) != nil {
+			continue
+		}
+
+		unmatched = append(unmatched, ramen.UnmatchedStorageClass{
+			StorageClassName: sc.GetName(),
+			Clusters:         []string{clA.clusterName, clB.clusterName},
+			Reason:           fmt.Sprintf("StorageClass %s not found on cluster %s", sc.GetName(), clA.clusterName),
+		})
+	}
+
+	return unmatched
+}
+
+// tierUnmatchedStorageClasses returns the passed in tierUnmatched StorageClasses, of the passed in tier, that are not
+// reported for the same reason in the passed in unmatched StorageClasses of the DRPolicy SchedulingInterval
+func tierUnmatchedStorageClasses(tier string, tierUnmatched, unmatched []ramen.UnmatchedStorageClass,
+) []ramen.UnmatchedStorageClass {
+	outUnmatched := []ramen.UnmatchedStorageClass{}
+
+	for _, tierSC := range tierUnmatched {
+		if slices.ContainsFunc(unmatched, func(sc ramen.UnmatchedStorageClass) bool {
+			return sc.StorageClassName == tierSC.StorageClassName && slices.Equal(sc.Clusters, tierSC.Clusters) &&
+				sc.Reason == tierSC.Reason
+		}) {
+			continue
+		}
+
+		tierSC.Tier = tier
+		outUnmatched = append(outUnmatched, tierSC)
+	}
+
+	return outUnmatched
+}
@@ -0,0 +1,230 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	ramen "github.com/ramendr/ramen/api/v1alpha1"
+	"github.com/ramendr/ramen/internal/controller/util"
+)
+
+// drClusterTopologyValue returns the value of the passed in topology attribute of the DRCluster, or an empty string
+// if it is not set
+func drClusterTopologyValue(drCluster *ramen.DRCluster, key ramen.TopologyKey) string {
+	if key == ramen.TopologyKeyRegion {
+		return string(drCluster.Spec.Region)
+	}
+
+	if drCluster.Spec.Topology == nil {
+		return ""
+	}
+
+	switch key {
+	case ramen.TopologyKeyZone:
+		return drCluster.Spec.Topology.Zone
+	case ramen.TopologyKeyProvider:
+		return drCluster.Spec.Topology.Provider
+	}
+
+	return ""
+}
+
+// drClusterTopologyLabels returns the topology labels of the DRCluster
+func drClusterTopologyLabels(drCluster *ramen.DRCluster) labels.Set {
+	if drCluster.Spec.Topology == nil {
+		return labels.Set{}
+	}
+
+	return labels.Set(drCluster.Spec.Topology.Labels)
+}
+
+// checkPlacementConstraints returns an error describing the first of the passed in constraints that the target
+// cluster violates as a failover target of the primary cluster, or nil if it satisfies all of them
+func checkPlacementConstraints(constraints *ramen.PlacementConstraints, primary, target *ramen.DRCluster) error {
+	if constraints == nil {
+		return nil
+	}
+
+	provider := drClusterTopologyValue(target, ramen.TopologyKeyProvider)
+	if provider != "" && slices.Contains(constraints.AvoidProviders, provider) {
+		return fmt.Errorf("cluster %s is of provider %s, which is to be avoided", target.Name, provider)
+	}
+
+	if constraints.TargetSelector != nil {
+		selector, err := metav1.LabelSelectorAsSelector(constraints.TargetSelector)
+		if err != nil {
+			return fmt.Errorf("invalid targetSelector: %w", err)
+		}
+
+		if !selector.Matches(drClusterTopologyLabels(target)) {
+			return fmt.Errorf("cluster %s topology labels do not match targetSelector %s", target.Name,
+				selector.String())
+		}
+	}
+
+	for _, key := range constraints.DistinctTopologyKeys {
+		value := drClusterTopologyValue(target, key)
+		if value == "" || value == drClusterTopologyValue(primary, key) {
+			return fmt.Errorf("cluster %s is not in a different %s than cluster %s", target.Name, key, primary.Name)
+		}
+	}
+
+	return nil
+}
+
+// findDRCluster returns the DRCluster named name in the passed in list, or nil if not found
+func findDRCluster(drClusters []ramen.DRCluster, name string) *ramen.DRCluster {
+	for idx := range drClusters {
+		if drClusters[idx].Name == name {
+			return &drClusters[idx]
+		}
+	}
+
+	return nil
+}
+
+// validatePlacementConstraints returns an error unless the placement constraints of the policy leave every cluster in
+// the policy with at least one other cluster in the policy to fail over to
+func validatePlacementConstraints(drpolicy *ramen.DRPolicy, drclusters *ramen.DRClusterList) error {
+	constraints := drpolicy.Spec.PlacementConstraints
+	if constraints == nil {
+		return nil
+	}
+
+	if constraints.TargetSelector != nil {
+		if _, err := metav1.LabelSelectorAsSelector(constraints.TargetSelector); err != nil {
+			return fmt.Errorf("invalid placementConstraints targetSelector: %w", err)
+		}
+	}
+
+	for _, primaryName := range drpolicy.Spec.DRClusters {
+		primary := findDRCluster(drclusters.Items, primaryName)
+		if primary == nil {
+			continue
+		}
+
+		violations := []string{}
+
+		for _, targetName := range drpolicy.Spec.DRClusters {
+			target := findDRCluster(drclusters.Items, targetName)
+			if targetName == primaryName || target == nil {
+				continue
+			}
+
+			err := checkPlacementConstraints(constraints, primary, target)
+			if err == nil {
+				violations = nil
+
+				break
+			}
+
+			violations = append(violations, err.Error())
+		}
+
+		if len(violations) != 0 {
+			return fmt.Errorf("placementConstraints leave cluster %s with no failover target: %s", primaryName,
+				strings.Join(violations, ", "))
+		}
+	}
+
+	return nil
+}
+
+// selectFailoverCluster returns the first cluster in the policy, other than the primary cluster, that is not being
+// deleted or fenced, reports no capabilities that block failover to it and satisfies the placement constraints of
+// the policy as a failover target of the primary cluster. The free capacity of StorageClasses is checked by the
+// failover, once the StorageClasses of its PVCs are known.
+func selectFailoverCluster(drpolicy *ramen.DRPolicy, drClusters []ramen.DRCluster, primaryName string) (
+	string, error,
+) {
+	primary := findDRCluster(drClusters, primaryName)
+	if primary == nil {
+		return "", fmt.Errorf("failed to find primary cluster %s in DRPolicy %s", primaryName, drpolicy.Name)
+	}
+
+	reasons := []string{}
+
+	for _, targetName := range drpolicy.Spec.DRClusters {
+		target := findDRCluster(drClusters, targetName)
+		if targetName == primaryName || target == nil {
+			continue
+		}
+
+		switch {
+		case !target.GetDeletionTimestamp().IsZero():
+			reasons = append(reasons, fmt.Sprintf("cluster %s is being deleted", targetName))
+		case target.Spec.ClusterFence == ramen.ClusterFenceStateFenced ||
+			target.Spec.ClusterFence == ramen.ClusterFenceStateManuallyFenced:
+			reasons = append(reasons, fmt.Sprintf("cluster %s is fenced", targetName))
+		case failoverBlockingCapabilities(target, nil) != "":
+			reasons = append(reasons, fmt.Sprintf("cluster %s reports unhealthy capabilities: %s", targetName,
+				failoverBlockingCapabilities(target, nil)))
+		default:
+			if err := checkPlacementConstraints(drpolicy.Spec.PlacementConstraints, primary, target); err != nil {
+				reasons = append(reasons, err.Error())
+
+				continue
+			}
+
+			return targetName, nil
+		}
+	}
+
+	return "", fmt.Errorf("no valid failover cluster for primary cluster %s: %s", primaryName,
+		strings.Join(reasons, ", "))
+}
+
+// ensureFailoverCluster selects, and updates the DRPC with, the cluster to failover to when the DRPC requests a
+// failover without specifying a failover cluster. Returns true if the DRPC was updated.
+func (r *DRPlacementControlReconciler) ensureFailoverCluster(ctx context.Context, drpc *ramen.DRPlacementControl,
+	drPolicy *ramen.DRPolicy, log logr.Logger,
+) (bool, error) {
+	const updated = true
+
+	if drpc.Spec.Action != ramen.ActionFailover || drpc.Spec.FailoverCluster != "" {
+		return !updated, nil
+	}
+
+	primaryName := drpc.Status.PreferredDecision.ClusterName
+	if primaryName == "" {
+		primaryName = drpc.Spec.PreferredCluster
+	}
+
+	if primaryName == "" {
+		return !updated, fmt.Errorf("unable to select a failover cluster, as the primary cluster is unknown")
+	}
+
+	drClusters, err := GetDRClusters(ctx, r.Client, drPolicy)
+	if err != nil {
+		return !updated, err
+	}
+
+	failoverCluster, err := selectFailoverCluster(drPolicy, drClusters, primaryName)
+	if err != nil {
+		return !updated, err
+	}
+
+	drpc.Spec.FailoverCluster = failoverCluster
+
+	if err := r.Update(ctx, drpc); err != nil {
+		return !updated, fmt.Errorf("failed to update drpc %s failover cluster (%w)", drpc.GetName(), err)
+	}
+
+	log.Info("Selected failover cluster", "primary", primaryName, "failoverCluster", failoverCluster)
+
+	util.ReportIfNotPresent(r.eventRecorder, drpc, corev1.EventTypeNormal,
+		util.EventReasonFailoverClusterSelected,
+		fmt.Sprintf("Selected cluster %s to failover to from cluster %s", failoverCluster, primaryName))
+
+	return updated, nil
+}
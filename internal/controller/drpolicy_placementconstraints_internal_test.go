@@ -0,0 +1,112 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	ramen "github.com/ramendr/ramen/api/v1alpha1"
+)
+
+var _ = Describe("PlacementConstraints", func() {
+	drCluster := func(name, region, zone, provider string, topologyLabels map[string]string) ramen.DRCluster {
+		return ramen.DRCluster{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec: ramen.DRClusterSpec{
+				Region: ramen.Region(region),
+				Topology: &ramen.DRClusterTopology{
+					Zone:     zone,
+					Provider: provider,
+					Labels:   topologyLabels,
+				},
+			},
+		}
+	}
+
+	drClusters := func() []ramen.DRCluster {
+		return []ramen.DRCluster{
+			drCluster("east-1", "east", "east-a", "aws", map[string]string{"tier": "prod"}),
+			drCluster("east-2", "east", "east-b", "aws", map[string]string{"tier": "prod"}),
+			drCluster("west-1", "west", "west-a", "onprem", map[string]string{"tier": "dr"}),
+		}
+	}
+
+	drPolicy := func(constraints *ramen.PlacementConstraints) *ramen.DRPolicy {
+		return &ramen.DRPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: "policy"},
+			Spec: ramen.DRPolicySpec{
+				DRClusters:           []string{"east-1", "east-2", "west-1"},
+				PlacementConstraints: constraints,
+			},
+		}
+	}
+
+	DescribeTable("checkPlacementConstraints",
+		func(constraints *ramen.PlacementConstraints, primary, target int, valid bool) {
+			clusters := drClusters()
+
+			err := checkPlacementConstraints(constraints, &clusters[primary], &clusters[target])
+			if valid {
+				Expect(err).ToNot(HaveOccurred())
+			} else {
+				Expect(err).To(HaveOccurred())
+			}
+		},
+		Entry("accepts any target without constraints", nil, 0, 1, true),
+		Entry("accepts a target in a different region",
+			&ramen.PlacementConstraints{DistinctTopologyKeys: []ramen.TopologyKey{ramen.TopologyKeyRegion}}, 0, 2, true),
+		Entry("rejects a target in the same region",
+			&ramen.PlacementConstraints{DistinctTopologyKeys: []ramen.TopologyKey{ramen.TopologyKeyRegion}}, 0, 1, false),
+		Entry("accepts a target in a different zone of the same region",
+			&ramen.PlacementConstraints{DistinctTopologyKeys: []ramen.TopologyKey{ramen.TopologyKeyZone}}, 0, 1, true),
+		Entry("rejects a target of an avoided provider",
+			&ramen.PlacementConstraints{AvoidProviders: []string{"onprem"}}, 0, 2, false),
+		Entry("accepts a target matching the target selector",
+			&ramen.PlacementConstraints{TargetSelector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{"tier": "dr"},
+			}}, 0, 2, true),
+		Entry("rejects a target not matching the target selector",
+			&ramen.PlacementConstraints{TargetSelector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{"tier": "dr"},
+			}}, 0, 1, false),
+	)
+
+	It("reports a policy that leaves a cluster with no failover target", func() {
+		clusters := &ramen.DRClusterList{Items: drClusters()}
+
+		Expect(validatePlacementConstraints(drPolicy(&ramen.PlacementConstraints{
+			DistinctTopologyKeys: []ramen.TopologyKey{ramen.TopologyKeyRegion},
+		}), clusters)).To(Succeed())
+
+		Expect(validatePlacementConstraints(drPolicy(&ramen.PlacementConstraints{
+			DistinctTopologyKeys: []ramen.TopologyKey{ramen.TopologyKeyProvider},
+			AvoidProviders:       []string{"onprem"},
+		}), clusters)).ToNot(Succeed())
+	})
+
+	Describe("selectFailoverCluster", func() {
+		It("selects the first cluster that satisfies the constraints", func() {
+			Expect(selectFailoverCluster(drPolicy(nil), drClusters(), "east-1")).To(Equal("east-2"))
+			Expect(selectFailoverCluster(drPolicy(&ramen.PlacementConstraints{
+				DistinctTopologyKeys: []ramen.TopologyKey{ramen.TopologyKeyRegion},
+			}), drClusters(), "east-1")).To(Equal("west-1"))
+		})
+
+		It("skips fenced clusters", func() {
+			clusters := drClusters()
+			clusters[1].Spec.ClusterFence = ramen.ClusterFenceStateFenced
+
+			Expect(selectFailoverCluster(drPolicy(nil), clusters, "east-1")).To(Equal("west-1"))
+		})
+
+		It("fails when no cluster satisfies the constraints", func() {
+			_, err := selectFailoverCluster(drPolicy(&ramen.PlacementConstraints{
+				AvoidProviders: []string{"aws", "onprem"},
+			}), drClusters(), "east-1")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
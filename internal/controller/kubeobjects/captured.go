@@ -0,0 +1,138 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package kubeobjects
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// CapturedObjects lists the names of captured objects keyed by API version and kind, e.g.
+// "apps/v1/Deployment": ["namespace/name"], the same way as a Velero backup's resource list. Cluster scoped objects
+// are listed by name only.
+type CapturedObjects map[string][]string
+
+func CapturedObjectsKind(groupVersionKind schema.GroupVersionKind) string {
+	return groupVersionKind.GroupVersion().String() + "/" + groupVersionKind.Kind
+}
+
+func CapturedObjectName(namespaceName, name string) string {
+	if namespaceName == "" {
+		return name
+	}
+
+	return namespaceName + "/" + name
+}
+
+// CapturedObjectsGroupVersionKind returns the group, version and kind of a kind listed, e.g. "apps/v1/Deployment"
+func CapturedObjectsGroupVersionKind(kind string) (schema.GroupVersionKind, error) {
+	index := strings.LastIndex(kind, "/")
+	if index < 0 {
+		return schema.GroupVersionKind{}, fmt.Errorf("captured objects kind %q has no version", kind)
+	}
+
+	groupVersion, err := schema.ParseGroupVersion(kind[:index])
+	if err != nil {
+		return schema.GroupVersionKind{}, fmt.Errorf("captured objects kind %q: %w", kind, err)
+	}
+
+	return groupVersion.WithKind(kind[index+1:]), nil
+}
+
+// CapturedObjectNamespaceAndName returns the namespace, empty if cluster scoped, and name of an object listed
+func CapturedObjectNamespaceAndName(name string) (string, string) {
+	namespaceName, name, found := strings.Cut(name, "/")
+	if !found {
+		return "", namespaceName
+	}
+
+	return namespaceName, name
+}
+
+// Insert adds an object to the list, unless listed already, keeping the names of each kind sorted
+func (c CapturedObjects) Insert(kind, name string) {
+	names := c[kind]
+
+	index, found := slices.BinarySearch(names, name)
+	if found {
+		return
+	}
+
+	c[kind] = slices.Insert(names, index, name)
+}
+
+// Merge adds the objects of another list
+func (c CapturedObjects) Merge(other CapturedObjects) {
+	for kind, names := range other {
+		for _, name := range names {
+			c.Insert(kind, name)
+		}
+	}
+}
+
+// Difference returns the objects listed that the other list does not
+func (c CapturedObjects) Difference(other CapturedObjects) CapturedObjects {
+	difference := CapturedObjects{}
+
+	for kind, names := range c {
+		otherNames := other[kind]
+
+		for _, name := range names {
+			if !slices.Contains(otherNames, name) {
+				difference.Insert(kind, name)
+			}
+		}
+	}
+
+	return difference
+}
+
+func (c CapturedObjects) Count() int {
+	count := 0
+
+	for _, names := range c {
+		count += len(names)
+	}
+
+	return count
+}
+
+// Counts returns the number of objects of each kind
+func (c CapturedObjects) Counts() map[string]int {
+	counts := make(map[string]int, len(c))
+
+	for kind, names := range c {
+		counts[kind] = len(names)
+	}
+
+	return counts
+}
+
+// Strings returns up to limit objects, as kind and name, e.g. "v1/Secret namespace/name", in order
+func (c CapturedObjects) Strings(limit int) []string {
+	kinds := make([]string, 0, len(c))
+	for kind := range c {
+		kinds = append(kinds, kind)
+	}
+
+	sort.Strings(kinds)
+
+	objects := []string{}
+
+	for _, kind := range kinds {
+		for _, name := range c[kind] {
+			if len(objects) == limit {
+				return objects
+			}
+
+			objects = append(objects, kind+" "+name)
+		}
+	}
+
+	return objects
+}
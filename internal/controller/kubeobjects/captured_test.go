@@ -0,0 +1,73 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package kubeobjects_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/ramendr/ramen/internal/controller/kubeobjects"
+)
+
+var _ = Describe("CapturedObjects", func() {
+	deploymentKind := kubeobjects.CapturedObjectsKind(schema.GroupVersionKind{Group: "apps", Version: "v1",
+		Kind: "Deployment"})
+	secretKind := kubeobjects.CapturedObjectsKind(schema.GroupVersionKind{Version: "v1", Kind: "Secret"})
+	namespaceKind := kubeobjects.CapturedObjectsKind(schema.GroupVersionKind{Version: "v1", Kind: "Namespace"})
+
+	It("lists objects by kind as Velero does", func() {
+		capturedObjects := kubeobjects.CapturedObjects{}
+		capturedObjects.Insert(deploymentKind, kubeobjects.CapturedObjectName("app", "web"))
+		capturedObjects.Insert(deploymentKind, kubeobjects.CapturedObjectName("app", "db"))
+		capturedObjects.Insert(deploymentKind, kubeobjects.CapturedObjectName("app", "web"))
+		capturedObjects.Insert(namespaceKind, kubeobjects.CapturedObjectName("", "app"))
+
+		Expect(capturedObjects).To(Equal(kubeobjects.CapturedObjects{
+			"apps/v1/Deployment": {"app/db", "app/web"},
+			"v1/Namespace":       {"app"},
+		}))
+		Expect(capturedObjects.Count()).To(Equal(3))
+		Expect(capturedObjects.Counts()).To(Equal(map[string]int{"apps/v1/Deployment": 2, "v1/Namespace": 1}))
+	})
+
+	It("differs from the objects of another list", func() {
+		previous := kubeobjects.CapturedObjects{
+			deploymentKind: {"app/web"},
+			secretKind:     {"app/credentials", "app/token"},
+		}
+		current := kubeobjects.CapturedObjects{
+			deploymentKind: {"app/db", "app/web"},
+			secretKind:     {"app/token"},
+		}
+
+		Expect(current.Difference(previous)).To(Equal(kubeobjects.CapturedObjects{deploymentKind: {"app/db"}}))
+		Expect(previous.Difference(current)).To(Equal(kubeobjects.CapturedObjects{secretKind: {"app/credentials"}}))
+		Expect(previous.Strings(2)).To(Equal([]string{"apps/v1/Deployment app/web", "v1/Secret app/credentials"}))
+
+		previous.Merge(current)
+		Expect(previous).To(Equal(kubeobjects.CapturedObjects{
+			deploymentKind: {"app/db", "app/web"},
+			secretKind:     {"app/credentials", "app/token"},
+		}))
+	})
+
+	It("parses the kinds and names listed", func() {
+		groupVersionKind, err := kubeobjects.CapturedObjectsGroupVersionKind(deploymentKind)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(groupVersionKind).To(Equal(schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"}))
+
+		groupVersionKind, err = kubeobjects.CapturedObjectsGroupVersionKind(secretKind)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(groupVersionKind).To(Equal(schema.GroupVersionKind{Version: "v1", Kind: "Secret"}))
+
+		_, err = kubeobjects.CapturedObjectsGroupVersionKind("Secret")
+		Expect(err).To(HaveOccurred())
+
+		namespaceName, name := kubeobjects.CapturedObjectNamespaceAndName("app/web")
+		Expect([]string{namespaceName, name}).To(Equal([]string{"app", "web"}))
+		namespaceName, name = kubeobjects.CapturedObjectNamespaceAndName("app")
+		Expect([]string{namespaceName, name}).To(Equal([]string{"", "app"}))
+	})
+})
@@ -0,0 +1,263 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package kubeobjects
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ClusterResourcesSpec selects the cluster scoped objects that the selected namespaced objects depend on, to be
+// captured and recovered with them
+type ClusterResourcesSpec struct {
+	// Resources of the cluster scoped objects, e.g. storageclasses.storage.k8s.io
+	Resources []string `json:"resources"`
+	//+optional
+	LabelSelector *metav1.LabelSelector `json:"labelSelector,omitempty"`
+	// Referenced selects the objects that the selected namespaced objects refer to by name
+	//+optional
+	Referenced bool `json:"referenced,omitempty"`
+	//+optional
+	ConflictPolicy ConflictPolicy `json:"conflictPolicy,omitempty"`
+}
+
+// ConflictPolicy is how a recovered cluster scoped object that exists already, and differs, is handled
+type ConflictPolicy string
+
+const (
+	ConflictPolicySkip            = ConflictPolicy("Skip")
+	ConflictPolicyOverwrite       = ConflictPolicy("Overwrite")
+	ConflictPolicyFailIfDifferent = ConflictPolicy("FailIfDifferent")
+)
+
+// ClusterObjectResult is the outcome of capturing or recovering a cluster scoped object
+type ClusterObjectResult string
+
+const (
+	ClusterObjectCaptured    = ClusterObjectResult("Captured")
+	ClusterObjectCreated     = ClusterObjectResult("Created")
+	ClusterObjectUnchanged   = ClusterObjectResult("Unchanged")
+	ClusterObjectSkipped     = ClusterObjectResult("Skipped")
+	ClusterObjectOverwritten = ClusterObjectResult("Overwritten")
+	ClusterObjectConflict    = ClusterObjectResult("Conflict")
+)
+
+// ClusterObject reports the outcome of capturing or recovering a cluster scoped object
+type ClusterObject struct {
+	// Resource is the group qualified resource of the object, e.g. storageclasses.storage.k8s.io
+	Resource string              `json:"resource"`
+	Name     string              `json:"name"`
+	Result   ClusterObjectResult `json:"result"`
+	//+optional
+	Message string `json:"message,omitempty"`
+}
+
+// Owned returns whether the object is owned by the owner that recovered it, i.e. whether the recovery created it.
+// Objects that existed already are not, even if overwritten, nor are those captured.
+func (o ClusterObject) Owned() bool {
+	return o.Result == ClusterObjectCreated
+}
+
+// ClusterObjectsReporter is implemented by requests that report the cluster scoped objects they captured or
+// recovered
+type ClusterObjectsReporter interface {
+	ClusterObjects() []ClusterObject
+}
+
+// ClusterObjectsReleasingRequestsManager is implemented by requests managers that track the owners of the cluster
+// scoped objects they recover
+type ClusterObjectsReleasingRequestsManager interface {
+	// ClusterObjectsRelease removes the owner identified by the labels from the owners of the objects it owns, and
+	// deletes those left without owners that no other objects refer to
+	ClusterObjectsRelease(
+		c context.Context, w client.Client, l logr.Logger, labels map[string]string, objects []ClusterObject,
+	) error
+}
+
+// ClusterObjectOwnersAnnotation lists the owners of a cluster scoped object, comma separated, that recovered it
+const ClusterObjectOwnersAnnotation = "kubeobjects.ramendr.openshift.io/protected-by"
+
+func ClusterObjectOwners(object metav1.Object) []string {
+	owners := object.GetAnnotations()[ClusterObjectOwnersAnnotation]
+	if owners == "" {
+		return nil
+	}
+
+	return strings.Split(owners, ",")
+}
+
+// ClusterObjectOwnersSet sets the owners of the object, or removes the annotation if none
+func ClusterObjectOwnersSet(object metav1.Object, owners []string) {
+	annotations := object.GetAnnotations()
+
+	if len(owners) == 0 {
+		delete(annotations, ClusterObjectOwnersAnnotation)
+		object.SetAnnotations(annotations)
+
+		return
+	}
+
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+
+	owners = slices.Clone(owners)
+	slices.Sort(owners)
+	annotations[ClusterObjectOwnersAnnotation] = strings.Join(slices.Compact(owners), ",")
+	object.SetAnnotations(annotations)
+}
+
+// ClusterObjectOwnerAdd adds the owner to the object's owners and returns whether it was not one already
+func ClusterObjectOwnerAdd(object metav1.Object, owner string) bool {
+	owners := ClusterObjectOwners(object)
+	if slices.Contains(owners, owner) {
+		return false
+	}
+
+	ClusterObjectOwnersSet(object, append(owners, owner))
+
+	return true
+}
+
+// ClusterObjectOwnerRemove removes the owner from the object's owners and returns whether it was one
+func ClusterObjectOwnerRemove(object metav1.Object, owner string) bool {
+	owners := ClusterObjectOwners(object)
+	if !slices.Contains(owners, owner) {
+		return false
+	}
+
+	ClusterObjectOwnersSet(object, slices.DeleteFunc(owners, func(o string) bool { return o == owner }))
+
+	return true
+}
+
+const (
+	// CustomResourceDefinitions are never deleted as their deletion deletes all of their custom resources
+	CustomResourceDefinitions = "customresourcedefinitions.apiextensions.k8s.io"
+	storageClasses            = "storageclasses.storage.k8s.io"
+	priorityClasses           = "priorityclasses.scheduling.k8s.io"
+	runtimeClasses            = "runtimeclasses.node.k8s.io"
+	clusterRoles              = "clusterroles.rbac.authorization.k8s.io"
+	ingressClasses            = "ingressclasses.networking.k8s.io"
+)
+
+// clusterObjectReferrers lists, by the resource of cluster scoped objects, the resources of the objects that refer
+// to them by name
+var clusterObjectReferrers = map[string][]string{
+	storageClasses:  {"persistentvolumeclaims", "persistentvolumes", "statefulsets.apps"},
+	priorityClasses: {"pods"},
+	runtimeClasses:  {"pods"},
+	clusterRoles:    {"rolebindings.rbac.authorization.k8s.io", "clusterrolebindings.rbac.authorization.k8s.io"},
+	ingressClasses:  {"ingresses.networking.k8s.io"},
+}
+
+// ClusterObjectReferrers returns the resources of the objects that may refer to a cluster scoped object. The custom
+// resource that a custom resource definition defines refers to it.
+func ClusterObjectReferrers(groupResource, name string) []string {
+	if groupResource == CustomResourceDefinitions {
+		return []string{name}
+	}
+
+	return clusterObjectReferrers[groupResource]
+}
+
+// podSpecFields are the fields of the pod specs of workloads, by kind
+var podSpecFields = map[string][]string{
+	"Pod":         {"spec"},
+	"Deployment":  {"spec", "template", "spec"},
+	"StatefulSet": {"spec", "template", "spec"},
+	"DaemonSet":   {"spec", "template", "spec"},
+	"ReplicaSet":  {"spec", "template", "spec"},
+	"Job":         {"spec", "template", "spec"},
+	"CronJob":     {"spec", "jobTemplate", "spec", "template", "spec"},
+}
+
+// ClusterObjectReferences returns the names of the cluster scoped objects, by resource, that an object of a resource
+// refers to. Custom resources refer to their definition.
+func ClusterObjectReferences(object *unstructured.Unstructured, groupResource string) map[string][]string {
+	references := map[string][]string{}
+	reference := func(groupResource string, fields ...string) {
+		if name, _, _ := unstructured.NestedString(object.Object, fields...); name != "" &&
+			!slices.Contains(references[groupResource], name) {
+			references[groupResource] = append(references[groupResource], name)
+		}
+	}
+
+	groupVersionKind := object.GroupVersionKind()
+
+	if CustomResourceGroup(groupVersionKind.Group) {
+		references[CustomResourceDefinitions] = []string{groupResource}
+	}
+
+	if fields, ok := podSpecFields[groupVersionKind.Kind]; ok {
+		reference(priorityClasses, append(slices.Clone(fields), "priorityClassName")...)
+		reference(runtimeClasses, append(slices.Clone(fields), "runtimeClassName")...)
+	}
+
+	switch groupVersionKind.Kind {
+	case "PersistentVolumeClaim", "PersistentVolume":
+		reference(storageClasses, "spec", "storageClassName")
+	case "StatefulSet":
+		templates, _, _ := unstructured.NestedSlice(object.Object, "spec", "volumeClaimTemplates")
+		for _, template := range templates {
+			if template, ok := template.(map[string]interface{}); ok {
+				name, _, _ := unstructured.NestedString(template, "spec", "storageClassName")
+				if name != "" && !slices.Contains(references[storageClasses], name) {
+					references[storageClasses] = append(references[storageClasses], name)
+				}
+			}
+		}
+	case "RoleBinding", "ClusterRoleBinding":
+		if kind, _, _ := unstructured.NestedString(object.Object, "roleRef", "kind"); kind == "ClusterRole" {
+			reference(clusterRoles, "roleRef", "name")
+		}
+	case "Ingress":
+		reference(ingressClasses, "spec", "ingressClassName")
+	}
+
+	return references
+}
+
+// clusterObjectFieldsIgnored are the top level fields of cluster scoped objects that are not compared
+var clusterObjectFieldsIgnored = []string{"apiVersion", "kind", "metadata", "status"}
+
+// CustomResourceGroup returns whether the group may be the group of custom resources, i.e. not a core group, nor a
+// kubernetes one
+func CustomResourceGroup(group string) bool {
+	return strings.Contains(group, ".") && group != "k8s.io" && !strings.HasSuffix(group, ".k8s.io")
+}
+
+// ClusterObjectDifferences returns the fields of a cluster scoped object that differ from those of another one: its
+// top level fields other than its metadata and status, and its labels
+func ClusterObjectDifferences(object, other *unstructured.Unstructured) []string {
+	fields := []string{}
+
+	for field := range object.Object {
+		if !slices.Contains(clusterObjectFieldsIgnored, field) &&
+			!equality.Semantic.DeepEqual(object.Object[field], other.Object[field]) {
+			fields = append(fields, field)
+		}
+	}
+
+	for field := range other.Object {
+		if _, ok := object.Object[field]; !ok && !slices.Contains(clusterObjectFieldsIgnored, field) {
+			fields = append(fields, field)
+		}
+	}
+
+	if !equality.Semantic.DeepEqual(object.GetLabels(), other.GetLabels()) {
+		fields = append(fields, "metadata.labels")
+	}
+
+	slices.Sort(fields)
+
+	return fields
+}
@@ -0,0 +1,81 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package kubeobjects_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/ramendr/ramen/internal/controller/kubeobjects"
+)
+
+var _ = Describe("ClusterObjectReferences", func() {
+	object := func(apiVersion, kind string, fields map[string]interface{}) *unstructured.Unstructured {
+		object := &unstructured.Unstructured{Object: fields}
+		object.SetAPIVersion(apiVersion)
+		object.SetKind(kind)
+
+		return object
+	}
+
+	It("returns the cluster scoped objects that workloads refer to", func() {
+		statefulSet := object("apps/v1", "StatefulSet", map[string]interface{}{
+			"spec": map[string]interface{}{
+				"template": map[string]interface{}{"spec": map[string]interface{}{"priorityClassName": "high"}},
+				"volumeClaimTemplates": []interface{}{
+					map[string]interface{}{"spec": map[string]interface{}{"storageClassName": "fast"}},
+				},
+			},
+		})
+		Expect(kubeobjects.ClusterObjectReferences(statefulSet, "statefulsets.apps")).To(Equal(map[string][]string{
+			"priorityclasses.scheduling.k8s.io": {"high"},
+			"storageclasses.storage.k8s.io":     {"fast"},
+		}))
+	})
+
+	It("returns the cluster roles that role bindings refer to", func() {
+		roleBinding := func(kind string) *unstructured.Unstructured {
+			return object("rbac.authorization.k8s.io/v1", "RoleBinding", map[string]interface{}{
+				"roleRef": map[string]interface{}{"kind": kind, "name": "view"},
+			})
+		}
+		Expect(kubeobjects.ClusterObjectReferences(roleBinding("ClusterRole"), "rolebindings.rbac.authorization.k8s.io")).
+			To(Equal(map[string][]string{"clusterroles.rbac.authorization.k8s.io": {"view"}}))
+		Expect(kubeobjects.ClusterObjectReferences(roleBinding("Role"), "rolebindings.rbac.authorization.k8s.io")).
+			To(BeEmpty())
+	})
+
+	It("returns the definitions of custom resources", func() {
+		database := object("example.com/v1", "Database", map[string]interface{}{})
+		Expect(kubeobjects.ClusterObjectReferences(database, "databases.example.com")).To(Equal(map[string][]string{
+			"customresourcedefinitions.apiextensions.k8s.io": {"databases.example.com"},
+		}))
+		Expect(kubeobjects.ClusterObjectReferrers("customresourcedefinitions.apiextensions.k8s.io",
+			"databases.example.com")).To(HaveExactElements("databases.example.com"))
+	})
+})
+
+var _ = Describe("ClusterObjectDifferences", func() {
+	It("compares the fields other than the metadata and status, and the labels", func() {
+		storageClass := func(provisioner string, labels map[string]string) *unstructured.Unstructured {
+			object := &unstructured.Unstructured{Object: map[string]interface{}{
+				"apiVersion":  "storage.k8s.io/v1",
+				"kind":        "StorageClass",
+				"provisioner": provisioner,
+			}}
+			object.SetName("fast")
+			object.SetLabels(labels)
+
+			return object
+		}
+
+		same := storageClass("csi.example.com", nil)
+		same.SetResourceVersion("1")
+		Expect(kubeobjects.ClusterObjectDifferences(storageClass("csi.example.com", nil), same)).To(BeEmpty())
+		Expect(kubeobjects.ClusterObjectDifferences(storageClass("csi.example.com", nil),
+			storageClass("csi.other.com", map[string]string{"a": "b"}))).
+			To(HaveExactElements("metadata.labels", "provisioner"))
+	})
+})
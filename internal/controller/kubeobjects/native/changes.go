@@ -0,0 +1,527 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package native
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+
+	"github.com/ramendr/ramen/internal/controller/kubeobjects"
+)
+
+// tombstone records the deletion of a captured object, or that it is no longer selected by the capture
+type tombstone struct {
+	DeletionTime metav1.Time `json:"deletionTime"`
+}
+
+type trackedCapture struct {
+	kubeobjects.ChangeCapture
+	objectStorer     ObjectStorer
+	keyPrefix        string
+	changesKeyPrefix string
+	excluded         []string
+	// objects are the objects captured when the tracker started, including the changes captured already
+	objects []capturedObject
+	// objectKeys are the keys of the objects captured, or whose changes are, so that only those are tombstoned
+	objectKeys map[string]struct{}
+	// tombstoneKeys are the keys of the capture's tombstones, so that they are deleted if their objects reappear
+	tombstoneKeys map[string]struct{}
+}
+
+// selects returns whether the capture includes the objects of the resource in the namespace
+func (c *trackedCapture) selects(r resource, namespaceName string) bool {
+	if !r.selected(c.Spec.IncludedResources, c.excluded, c.Spec.IncludeClusterResources) {
+		return false
+	}
+
+	return !r.namespaced || len(c.Spec.IncludedNamespaces) == 0 ||
+		slices.Contains(c.Spec.IncludedNamespaces, namespaceName)
+}
+
+func (c *trackedCapture) objectSelected(object *unstructured.Unstructured) (bool, error) {
+	return objectSelected(object, c.Spec.LabelSelector, c.Spec.OrLabelSelectors)
+}
+
+// objectChange uploads the object if it is selected, or else tombstones it if it was captured
+func (c *trackedCapture) objectChange(r resource, namespaceName, name string, object *unstructured.Unstructured,
+) error {
+	if !c.selects(r, namespaceName) {
+		return nil
+	}
+
+	if object != nil {
+		selected, err := c.objectSelected(object)
+		if err != nil {
+			return err
+		}
+
+		if selected {
+			return c.objectUpload(r, object)
+		}
+	}
+
+	return c.objectTombstone(r, namespaceName, name)
+}
+
+func (c *trackedCapture) objectUpload(r resource, object *unstructured.Unstructured) error {
+	object = object.DeepCopy()
+	object.SetManagedFields(nil)
+
+	key := objectKey(r, object.GetNamespace(), object.GetName())
+	if err := c.objectStorer.UploadObject(c.changesKeyPrefix+key, object.Object); err != nil {
+		return fmt.Errorf("object %s upload: %w", c.changesKeyPrefix+key, err)
+	}
+
+	c.objectKeys[key] = struct{}{}
+
+	tombstoneKey := tombstoneKey(c.changesKeyPrefix, key)
+	if _, ok := c.tombstoneKeys[tombstoneKey]; !ok {
+		return nil
+	}
+
+	if err := c.objectStorer.DeleteObject(tombstoneKey); err != nil {
+		return fmt.Errorf("tombstone %s delete: %w", tombstoneKey, err)
+	}
+
+	delete(c.tombstoneKeys, tombstoneKey)
+
+	return nil
+}
+
+func (c *trackedCapture) objectTombstone(r resource, namespaceName, name string) error {
+	objectKey := objectKey(r, namespaceName, name)
+	if _, ok := c.objectKeys[objectKey]; !ok {
+		return nil
+	}
+
+	key := tombstoneKey(c.changesKeyPrefix, objectKey)
+	if _, ok := c.tombstoneKeys[key]; ok {
+		return nil
+	}
+
+	if err := c.objectStorer.UploadObject(key, tombstone{DeletionTime: metav1.Now()}); err != nil {
+		return fmt.Errorf("tombstone %s upload: %w", key, err)
+	}
+
+	c.tombstoneKeys[key] = struct{}{}
+
+	return nil
+}
+
+type informerKey struct {
+	groupResource string
+	namespaceName string
+}
+
+// changeKey identifies an object whose change is to be captured
+type changeKey struct {
+	resource      resource
+	namespaceName string
+	name          string
+}
+
+// ChangeTracker watches the objects selected by captures and uploads their changes, so that they are recovered as
+// they were last. Deleted objects are recorded as tombstones, so that they are not recovered. Changes are stored
+// apart from the captures, so that the captures are not modified, and are uploaded by a worker, so that the store's
+// latency does not delay the informers.
+type ChangeTracker struct {
+	captures  []*trackedCapture
+	since     time.Time
+	factories []dynamicinformer.DynamicSharedInformerFactory
+	informers map[informerKey]cache.SharedIndexInformer
+	queue     workqueue.TypedInterface[changeKey]
+	cancel    context.CancelFunc
+	log       logr.Logger
+
+	// mutex guards the fields below
+	mutex       sync.Mutex
+	current     bool
+	changesTime metav1.Time
+	err         error
+}
+
+func (r RequestsManager) ChangeTrackerStart(
+	ctx context.Context,
+	log logr.Logger,
+	captures []kubeobjects.ChangeCapture,
+	since metav1.Time,
+) (kubeobjects.ChangeTracker, error) {
+	log = log.WithName("ChangeTracker")
+	t := &ChangeTracker{
+		since:     since.Time,
+		informers: map[informerKey]cache.SharedIndexInformer{},
+		queue:     workqueue.NewTyped[changeKey](),
+		log:       log,
+	}
+
+	for _, capture := range captures {
+		trackedCapture, err := r.trackedCapture(ctx, capture, log)
+		if err != nil {
+			return nil, err
+		}
+
+		t.captures = append(t.captures, trackedCapture)
+	}
+
+	resources, err := resourcesList(r.Discovery, log)
+	if err != nil {
+		return nil, err
+	}
+
+	synced, err := t.informersCreate(r.Dynamic, resources)
+	if err != nil {
+		return nil, err
+	}
+
+	// the tracker outlives the request that starts it
+	trackerCtx, cancel := context.WithCancel(context.Background())
+	t.cancel = cancel
+
+	go t.run(trackerCtx, synced)
+
+	log.Info("Kube objects changes capture start", "captures", len(t.captures), "informers", len(t.informers),
+		"since", since)
+
+	return t, nil
+}
+
+func (r RequestsManager) trackedCapture(
+	ctx context.Context, capture kubeobjects.ChangeCapture, log logr.Logger,
+) (*trackedCapture, error) {
+	objectStorer, err := r.ObjectStorerGet(ctx, capture.S3Url, capture.S3BucketName, log)
+	if err != nil {
+		return nil, fmt.Errorf("object store get: %w", err)
+	}
+
+	keyPrefix := captureKeyPrefix(capture.S3KeyPrefix, capture.CaptureName)
+	changesKeyPrefix := changesKeyPrefix(capture.S3KeyPrefix, capture.CaptureName)
+
+	keys, err := objectStorer.ListKeys(changesKeyPrefix + tombstonesPath)
+	if err != nil {
+		return nil, fmt.Errorf("tombstones list: %w", err)
+	}
+
+	tombstoneKeys := make(map[string]struct{}, len(keys))
+	for _, key := range keys {
+		tombstoneKeys[key] = struct{}{}
+	}
+
+	objects, err := capturedObjectsList(objectStorer, keyPrefix, changesKeyPrefix, log)
+	if err != nil {
+		return nil, err
+	}
+
+	objectKeys := make(map[string]struct{}, len(objects))
+	for _, object := range objects {
+		objectKeys[objectKey(object.resource, object.namespaceName, object.name)] = struct{}{}
+	}
+
+	return &trackedCapture{
+		ChangeCapture:    capture,
+		objectStorer:     objectStorer,
+		keyPrefix:        keyPrefix,
+		changesKeyPrefix: changesKeyPrefix,
+		excluded:         append(slices.Clone(capture.Spec.ExcludedResources), kubeobjects.ExcludedResourcesDefault...),
+		objects:          objects,
+		objectKeys:       objectKeys,
+		tombstoneKeys:    tombstoneKeys,
+	}, nil
+}
+
+// informersCreate creates informers for the objects of the resources selected by the captures. It returns
+// functions that report whether the informers have delivered their initial lists.
+func (t *ChangeTracker) informersCreate(
+	dynamicClient dynamic.Interface, resources []resource,
+) ([]cache.InformerSynced, error) {
+	factories := map[string]dynamicinformer.DynamicSharedInformerFactory{}
+	synced := []cache.InformerSynced{}
+
+	for _, r := range resources {
+		if !r.watchable {
+			continue
+		}
+
+		for _, namespaceName := range t.informerNamespaceNames(r) {
+			factory, ok := factories[namespaceName]
+			if !ok {
+				factory = dynamicinformer.NewFilteredDynamicSharedInformerFactory(dynamicClient, 0, namespaceName, nil)
+				factories[namespaceName] = factory
+				t.factories = append(t.factories, factory)
+			}
+
+			informer := factory.ForResource(r.groupVersionResource()).Informer()
+
+			registration, err := informer.AddEventHandler(t.eventHandler(r))
+			if err != nil {
+				return nil, fmt.Errorf("%s informer event handler add: %w", r.groupResource(), err)
+			}
+
+			t.informers[informerKey{r.groupResource(), namespaceName}] = informer
+			synced = append(synced, registration.HasSynced)
+		}
+	}
+
+	return synced, nil
+}
+
+// informerNamespaceNames returns the namespaces to watch the objects of the resource in: none if no capture
+// selects the resource, or the empty namespace name to watch all namespaces, or cluster scoped objects
+func (t *ChangeTracker) informerNamespaceNames(r resource) []string {
+	namespaceNames := []string{}
+
+	for _, c := range t.captures {
+		if !r.selected(c.Spec.IncludedResources, c.excluded, c.Spec.IncludeClusterResources) {
+			continue
+		}
+
+		if !r.namespaced || len(c.Spec.IncludedNamespaces) == 0 {
+			return []string{""}
+		}
+
+		for _, namespaceName := range c.Spec.IncludedNamespaces {
+			if !slices.Contains(namespaceNames, namespaceName) {
+				namespaceNames = append(namespaceNames, namespaceName)
+			}
+		}
+	}
+
+	return namespaceNames
+}
+
+func (t *ChangeTracker) informer(groupResource, namespaceName string) cache.SharedIndexInformer {
+	if informer, ok := t.informers[informerKey{groupResource, ""}]; ok {
+		return informer
+	}
+
+	return t.informers[informerKey{groupResource, namespaceName}]
+}
+
+func (t *ChangeTracker) eventHandler(r resource) cache.ResourceEventHandler {
+	return cache.ResourceEventHandlerDetailedFuncs{
+		AddFunc: func(obj interface{}, isInInitialList bool) {
+			object, ok := obj.(*unstructured.Unstructured)
+			if !ok {
+				return
+			}
+
+			// the captures include the objects listed initially, unless they were modified since they started
+			if isInInitialList && objectModifiedTime(object).Before(t.since) {
+				return
+			}
+
+			t.objectChanged(r, object)
+		},
+		UpdateFunc: func(_, newObj interface{}) {
+			if object, ok := newObj.(*unstructured.Unstructured); ok {
+				t.objectChanged(r, object)
+			}
+		},
+		DeleteFunc: func(obj interface{}) {
+			if deleted, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+				obj = deleted.Obj
+			}
+
+			if object, ok := obj.(*unstructured.Unstructured); ok {
+				t.objectChanged(r, object)
+			}
+		},
+	}
+}
+
+// objectModifiedTime returns the time the object was last modified, as recorded by its managed fields, or else
+// its creation time
+func objectModifiedTime(object *unstructured.Unstructured) time.Time {
+	modified := object.GetCreationTimestamp().Time
+
+	for _, managedFieldsEntry := range object.GetManagedFields() {
+		if managedFieldsEntry.Time != nil && managedFieldsEntry.Time.After(modified) {
+			modified = managedFieldsEntry.Time.Time
+		}
+	}
+
+	return modified
+}
+
+// objectChanged queues the capture of a change to the object. Its state is read from the informer once the change
+// is captured, so that the latest of its changes queued meanwhile is captured only.
+func (t *ChangeTracker) objectChanged(r resource, object *unstructured.Unstructured) {
+	t.queue.Add(changeKey{resource: r, namespaceName: object.GetNamespace(), name: object.GetName()})
+}
+
+func (t *ChangeTracker) run(ctx context.Context, synced []cache.InformerSynced) {
+	for _, factory := range t.factories {
+		factory.Start(ctx.Done())
+	}
+
+	// false only if the tracker is stopped
+	if !cache.WaitForCacheSync(ctx.Done(), synced...) {
+		return
+	}
+
+	if err := t.deletionsCapture(); err != nil {
+		t.log.Error(err, "Kube objects deletions capture failed")
+
+		t.mutex.Lock()
+		t.err = err
+		t.mutex.Unlock()
+
+		return
+	}
+
+	t.mutex.Lock()
+	t.current = true
+	t.changesTime = metav1.Now()
+	t.mutex.Unlock()
+
+	t.log.Info("Kube objects changes capture current")
+
+	for t.changeCaptureNext() {
+	}
+}
+
+// changeCaptureNext captures the next queued change. It returns false once the tracker is stopped, or a change
+// failed to be captured, after which no more are.
+func (t *ChangeTracker) changeCaptureNext() bool {
+	key, shutdown := t.queue.Get()
+	if shutdown {
+		return false
+	}
+
+	err := t.changeCapture(key)
+
+	t.queue.Done(key)
+
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	if err != nil {
+		t.log.Error(err, "Kube objects change capture failed")
+		t.err = err
+
+		return false
+	}
+
+	// all changes delivered by now are captured
+	if t.queue.Len() == 0 {
+		t.changesTime = metav1.Now()
+	}
+
+	return true
+}
+
+// changeCapture uploads the object to the captures that select it, or a tombstone if it was deleted, or is no longer
+// selected
+func (t *ChangeTracker) changeCapture(key changeKey) error {
+	object, err := t.liveObject(key.resource, key.namespaceName, key.name)
+	if err != nil {
+		return err
+	}
+
+	for _, c := range t.captures {
+		if err := c.objectChange(key.resource, key.namespaceName, key.name, object); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// liveObject returns the object from its informer, or nil if it does not exist, or its changes are not captured
+func (t *ChangeTracker) liveObject(r resource, namespaceName, name string) (*unstructured.Unstructured, error) {
+	informer := t.informer(r.groupResource(), namespaceName)
+	if informer == nil {
+		return nil, nil
+	}
+
+	key := name
+	if r.namespaced {
+		key = namespaceName + "/" + name
+	}
+
+	item, exists, err := informer.GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, fmt.Errorf("%s %s get: %w", r.groupResource(), key, err)
+	}
+
+	if !exists {
+		return nil, nil
+	}
+
+	object, _ := item.(*unstructured.Unstructured)
+
+	return object, nil
+}
+
+// deletionsCapture tombstones the captured objects that were deleted, or are no longer selected, before the tracker
+// started. Deletions after that are captured as they happen.
+func (t *ChangeTracker) deletionsCapture() error {
+	for _, c := range t.captures {
+		for _, object := range c.objects {
+			if err := t.deletionCapture(c, object); err != nil {
+				return err
+			}
+		}
+
+		c.objects = nil
+	}
+
+	return nil
+}
+
+func (t *ChangeTracker) deletionCapture(c *trackedCapture, object capturedObject) error {
+	if t.informer(object.groupResource(), object.namespaceName) == nil {
+		// changes to the objects of the resource are not captured
+		return nil
+	}
+
+	liveObject, err := t.liveObject(object.resource, object.namespaceName, object.name)
+	if err != nil {
+		return err
+	}
+
+	if liveObject != nil {
+		if selected, err := c.objectSelected(liveObject); err != nil || selected {
+			return err
+		}
+	}
+
+	return c.objectTombstone(object.resource, object.namespaceName, object.name)
+}
+
+// ChangesTime returns the time of the latest change captured, or the time the tracker became current if later
+func (t *ChangeTracker) ChangesTime() metav1.Time {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	return t.changesTime
+}
+
+func (t *ChangeTracker) Err() error {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	return t.err
+}
+
+func (t *ChangeTracker) Stop() {
+	t.cancel()
+	t.queue.ShutDown()
+
+	for _, factory := range t.factories {
+		factory.Shutdown()
+	}
+
+	t.log.Info("Kube objects changes capture stop")
+}
@@ -0,0 +1,228 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package native_test
+
+import (
+	"context"
+	"sync"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	fakedynamic "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/scheme"
+	k8stesting "k8s.io/client-go/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/ramendr/ramen/internal/controller/kubeobjects"
+	"github.com/ramendr/ramen/internal/controller/kubeobjects/native"
+)
+
+// lockedObjectStore is an object store that changes are uploaded to concurrently
+type lockedObjectStore struct {
+	mutex sync.Mutex
+	store objectStore
+}
+
+func (s *lockedObjectStore) UploadObject(key string, object interface{}) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.store.UploadObject(key, object)
+}
+
+func (s *lockedObjectStore) DownloadObject(key string, objectPointer interface{}) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.store.DownloadObject(key, objectPointer)
+}
+
+func (s *lockedObjectStore) ListKeys(keyPrefix string) ([]string, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.store.ListKeys(keyPrefix)
+}
+
+func (s *lockedObjectStore) DeleteObject(key string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.store.DeleteObject(key)
+}
+
+var _ = Describe("ChangeTracker", func() {
+	const (
+		requestNamespaceName = "ramen-system"
+		appNamespaceName     = "app"
+		s3KeyPrefix          = "vrg-ns/vrg/kube-objects/0/"
+		captureName          = "capture"
+	)
+
+	var (
+		ctx           context.Context
+		dynamicClient *fakedynamic.FakeDynamicClient
+		sourceClient  client.Client
+		store         *lockedObjectStore
+		manager       native.RequestsManager
+		tracker       kubeobjects.ChangeTracker
+		captureSpec   kubeobjects.Spec
+		captureKeys   []string
+	)
+
+	captureKeyPrefix := s3KeyPrefix + "native/captures/" + captureName + "/"
+
+	appLabels := map[string]string{"app": "a"}
+	configMapsResource := schema.GroupVersionResource{Version: "v1", Resource: "configmaps"}
+
+	configMap := func(name string, labels map[string]string, data string) *corev1.ConfigMap {
+		return &corev1.ConfigMap{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"},
+			ObjectMeta: metav1.ObjectMeta{Namespace: appNamespaceName, Name: name, Labels: labels},
+			Data:       map[string]string{"key": data},
+		}
+	}
+
+	configMapUnstructured := func(configMap *corev1.ConfigMap) *unstructured.Unstructured {
+		object, err := runtime.DefaultUnstructuredConverter.ToUnstructured(configMap)
+		Expect(err).NotTo(HaveOccurred())
+
+		return &unstructured.Unstructured{Object: object}
+	}
+
+	recoveredConfigMaps := func() map[string]string {
+		targetClient := fake.NewClientBuilder().WithScheme(scheme.Scheme).Build()
+
+		_, err := manager.RecoverRequestCreate(ctx, targetClient, logr.Discard(),
+			"https://s3", "bucket", "region", s3KeyPrefix, nil, nil,
+			kubeobjects.RecoverSpec{}, requestNamespaceName, captureName, nil, "recover", nil, nil)
+		Expect(err).NotTo(HaveOccurred())
+
+		configMaps := &corev1.ConfigMapList{}
+		Expect(targetClient.List(ctx, configMaps, client.InNamespace(appNamespaceName))).To(Succeed())
+
+		data := map[string]string{}
+		for _, configMap := range configMaps.Items {
+			data[configMap.Name] = configMap.Data["key"]
+		}
+
+		return data
+	}
+
+	BeforeEach(func() {
+		ctx = context.TODO()
+		store = &lockedObjectStore{store: objectStore{}}
+		captureSpec = kubeobjects.Spec{
+			KubeResourcesSpec: kubeobjects.KubeResourcesSpec{IncludedNamespaces: []string{appNamespaceName}},
+			LabelSelector:     &metav1.LabelSelector{MatchLabels: appLabels},
+		}
+
+		objects := []client.Object{
+			configMap("app-config", appLabels, "source"),
+			configMap("kept-config", appLabels, "source"),
+			configMap("gone-config", appLabels, "source"),
+			configMap("other-config", nil, "source"),
+		}
+		runtimeObjects := make([]runtime.Object, 0, len(objects))
+
+		for _, object := range objects {
+			runtimeObjects = append(runtimeObjects, object.DeepCopyObject())
+		}
+
+		sourceClient = fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(objects...).Build()
+		dynamicClient = fakedynamic.NewSimpleDynamicClient(scheme.Scheme, runtimeObjects...)
+		manager = native.RequestsManager{
+			Discovery: &fakediscovery.FakeDiscovery{Fake: &k8stesting.Fake{Resources: []*metav1.APIResourceList{{
+				GroupVersion: "v1",
+				APIResources: []metav1.APIResource{{
+					Name: "configmaps", SingularName: "configmap", Kind: "ConfigMap", Namespaced: true,
+					Verbs: []string{"list", "watch"},
+				}},
+			}}}},
+			ObjectStorerGet: func(context.Context, string, string, logr.Logger) (native.ObjectStorer, error) {
+				return store, nil
+			},
+			Dynamic: dynamicClient,
+		}
+
+		since := metav1.Now()
+
+		_, err := manager.ProtectRequestCreate(ctx, sourceClient, logr.Discard(),
+			"https://s3", "bucket", "region", s3KeyPrefix, nil, nil,
+			captureSpec, requestNamespaceName, captureName, nil, nil)
+		Expect(err).NotTo(HaveOccurred())
+
+		captureKeys, err = store.ListKeys(captureKeyPrefix)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(captureKeys).NotTo(BeEmpty())
+
+		// deleted after the capture, before its changes are tracked
+		Expect(dynamicClient.Resource(configMapsResource).Namespace(appNamespaceName).Delete(
+			ctx, "gone-config", metav1.DeleteOptions{})).To(Succeed())
+
+		tracker, err = manager.ChangeTrackerStart(ctx, logr.Discard(), []kubeobjects.ChangeCapture{{
+			S3Url: "https://s3", S3BucketName: "bucket", S3KeyPrefix: s3KeyPrefix, CaptureName: captureName,
+			Spec: captureSpec,
+		}}, since)
+		Expect(err).NotTo(HaveOccurred())
+		DeferCleanup(tracker.Stop)
+
+		Eventually(tracker.ChangesTime).ShouldNot(BeZero())
+	})
+
+	It("captures deletions made before it started", func() {
+		Expect(recoveredConfigMaps()).To(Equal(map[string]string{"app-config": "source", "kept-config": "source"}))
+	})
+
+	It("captures changes as they happen", func() {
+		configMaps := dynamicClient.Resource(configMapsResource).Namespace(appNamespaceName)
+
+		_, err := configMaps.Update(ctx, configMapUnstructured(configMap("app-config", appLabels, "changed")),
+			metav1.UpdateOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		_, err = configMaps.Create(ctx, configMapUnstructured(configMap("new-config", appLabels, "new")),
+			metav1.CreateOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		_, err = configMaps.Create(ctx, configMapUnstructured(configMap("new-other-config", nil, "new")),
+			metav1.CreateOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(configMaps.Delete(ctx, "kept-config", metav1.DeleteOptions{})).To(Succeed())
+
+		Eventually(recoveredConfigMaps).Should(Equal(map[string]string{"app-config": "changed", "new-config": "new"}))
+		Expect(tracker.Err()).NotTo(HaveOccurred())
+	})
+
+	It("captures objects that are no longer selected as deleted, until selected again", func() {
+		configMaps := dynamicClient.Resource(configMapsResource).Namespace(appNamespaceName)
+
+		_, err := configMaps.Update(ctx, configMapUnstructured(configMap("app-config", nil, "source")),
+			metav1.UpdateOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		Eventually(recoveredConfigMaps).Should(Equal(map[string]string{"kept-config": "source"}))
+
+		_, err = configMaps.Update(ctx, configMapUnstructured(configMap("app-config", appLabels, "again")),
+			metav1.UpdateOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		Eventually(recoveredConfigMaps).Should(Equal(map[string]string{"app-config": "again", "kept-config": "source"}))
+	})
+
+	It("leaves the capture unchanged", func() {
+		configMaps := dynamicClient.Resource(configMapsResource).Namespace(appNamespaceName)
+
+		_, err := configMaps.Update(ctx, configMapUnstructured(configMap("app-config", appLabels, "changed")),
+			metav1.UpdateOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		Eventually(recoveredConfigMaps).Should(Equal(map[string]string{"app-config": "changed", "kept-config": "source"}))
+
+		Expect(store.ListKeys(captureKeyPrefix)).To(ConsistOf(captureKeys))
+	})
+})
@@ -0,0 +1,359 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package native
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/go-logr/logr"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/ramendr/ramen/internal/controller/kubeobjects"
+	"github.com/ramendr/ramen/internal/controller/util"
+)
+
+// clusterObjectOwner returns the owner of the requests with the labels, as the owners of cluster scoped objects
+// identify it
+func clusterObjectOwner(labels map[string]string) string {
+	namespaceName, name, ok := util.OwnerNamespaceNameAndName(labels)
+	if !ok {
+		return ""
+	}
+
+	return namespaceName + "/" + name
+}
+
+// clusterResourceSelected returns whether the objects of a cluster scoped resource are captured and recovered as
+// dependencies of the selected objects
+func clusterResourceSelected(r resource, spec *kubeobjects.ClusterResourcesSpec) bool {
+	return spec != nil && !r.namespaced && r.matches(spec.Resources, false)
+}
+
+// clusterObjectReferences are the names of the cluster scoped objects, by resource, that the selected objects refer to
+type clusterObjectReferences map[string][]string
+
+// referrer returns whether the objects of a resource are listed for the cluster scoped objects they refer to, even
+// if they are not captured, e.g. persistent volume claims for their storage classes
+func (clusterObjectReferences) referrer(r resource, spec *kubeobjects.ClusterResourcesSpec) bool {
+	return spec != nil && spec.Referenced && r.groupResource() == "persistentvolumeclaims"
+}
+
+func (references clusterObjectReferences) insert(object *unstructured.Unstructured, r resource) {
+	for groupResource, names := range kubeobjects.ClusterObjectReferences(object, r.groupResource()) {
+		for _, name := range names {
+			if !slices.Contains(references[groupResource], name) {
+				references[groupResource] = append(references[groupResource], name)
+			}
+		}
+	}
+}
+
+// clusterObjectsCapture captures the cluster scoped objects of the resources selected, that match the label selector
+// or that the selected objects refer to. The objects are left as they are.
+func clusterObjectsCapture(
+	ctx context.Context,
+	k8sclient client.Client,
+	resources []resource,
+	objectStorer ObjectStorer,
+	keyPrefix string,
+	spec *kubeobjects.ClusterResourcesSpec,
+	references clusterObjectReferences,
+	capturedObjects kubeobjects.CapturedObjects,
+	log logr.Logger,
+) ([]kubeobjects.ClusterObject, error) {
+	clusterObjects := []kubeobjects.ClusterObject{}
+
+	for _, r := range resources {
+		if !clusterResourceSelected(r, spec) {
+			continue
+		}
+
+		objects, err := resourceObjectsList(ctx, k8sclient, r, nil)
+		if err != nil {
+			return clusterObjects, err
+		}
+
+		for i := range objects {
+			object := &objects[i]
+
+			selected, err := clusterObjectSelected(object, r, spec, references)
+			if err != nil {
+				return clusterObjects, err
+			}
+
+			if !selected {
+				continue
+			}
+
+			if err := objectCapture(objectStorer, keyPrefix, object, r, capturedObjects); err != nil {
+				return clusterObjects, err
+			}
+
+			clusterObjects = append(clusterObjects, kubeobjects.ClusterObject{
+				Resource: r.groupResource(),
+				Name:     object.GetName(),
+				Result:   kubeobjects.ClusterObjectCaptured,
+			})
+		}
+	}
+
+	if len(clusterObjects) > 0 {
+		log.Info("Cluster scoped kube objects captured", "count", len(clusterObjects))
+	}
+
+	return clusterObjects, nil
+}
+
+func clusterObjectSelected(object *unstructured.Unstructured, r resource, spec *kubeobjects.ClusterResourcesSpec,
+	references clusterObjectReferences,
+) (bool, error) {
+	if object.GetLabels()[util.CreatedByRamenLabel] == "true" {
+		return false, nil
+	}
+
+	if spec.Referenced && slices.Contains(references[r.groupResource()], object.GetName()) {
+		return true, nil
+	}
+
+	if spec.LabelSelector == nil {
+		return false, nil
+	}
+
+	return objectSelected(object, spec.LabelSelector, nil)
+}
+
+// clusterObjectRecover recovers a cluster scoped object. If it exists already and differs, it is skipped, overwritten
+// or reported as a conflict as per the conflict policy. The owner is set as the owner of the objects it creates only,
+// so that those that exist already are never released by it.
+func clusterObjectRecover(
+	ctx context.Context,
+	k8sclient client.Client,
+	object *unstructured.Unstructured,
+	r resource,
+	spec kubeobjects.RecoverSpec,
+	owner string,
+	log logr.Logger,
+) (kubeobjects.ClusterObject, error) {
+	clusterObject := kubeobjects.ClusterObject{Resource: r.groupResource(), Name: object.GetName()}
+	log1 := log.WithValues("resource", clusterObject.Resource, "name", clusterObject.Name)
+
+	objectRecoverPrepare(object, r, nil)
+	kubeobjects.ClusterObjectOwnersSet(object, nil)
+
+	if err := kubeobjects.TransformsApply(object, spec.Transforms); err != nil {
+		return clusterObject, err
+	}
+
+	existing := &unstructured.Unstructured{}
+	existing.SetGroupVersionKind(object.GroupVersionKind())
+
+	err := k8sclient.Get(ctx, client.ObjectKey{Name: object.GetName()}, existing)
+	if k8serrors.IsNotFound(err) {
+		if owner != "" {
+			kubeobjects.ClusterObjectOwnersSet(object, []string{owner})
+		}
+
+		if err := k8sclient.Create(ctx, object); err != nil {
+			return clusterObject, fmt.Errorf("%s %s create: %w", clusterObject.Resource, clusterObject.Name, err)
+		}
+
+		log1.Info("Cluster scoped object recovered")
+
+		clusterObject.Result = kubeobjects.ClusterObjectCreated
+
+		return clusterObject, nil
+	}
+
+	if err != nil {
+		return clusterObject, fmt.Errorf("%s %s get: %w", clusterObject.Resource, clusterObject.Name, err)
+	}
+
+	differences := kubeobjects.ClusterObjectDifferences(object, existing)
+	if len(differences) == 0 {
+		clusterObject.Result = kubeobjects.ClusterObjectUnchanged
+
+		return clusterObject, nil
+	}
+
+	clusterObject.Message = "differs in " + strings.Join(differences, ", ")
+
+	switch spec.ClusterResources.ConflictPolicy {
+	case kubeobjects.ConflictPolicyOverwrite:
+		kubeobjects.ClusterObjectOwnersSet(object, kubeobjects.ClusterObjectOwners(existing))
+
+		if err := k8sclient.Patch(ctx, object, client.Merge); err != nil {
+			return clusterObject, fmt.Errorf("%s %s patch: %w", clusterObject.Resource, clusterObject.Name, err)
+		}
+
+		log1.Info("Cluster scoped object exists already and differs; overwritten", "fields", differences)
+
+		clusterObject.Result = kubeobjects.ClusterObjectOverwritten
+
+		return clusterObject, nil
+	case kubeobjects.ConflictPolicyFailIfDifferent:
+		clusterObject.Result = kubeobjects.ClusterObjectConflict
+
+		return clusterObject, fmt.Errorf("%s %s exists already and %s", clusterObject.Resource, clusterObject.Name,
+			clusterObject.Message)
+	default:
+		log1.Info("Cluster scoped object exists already and differs; skipped", "fields", differences)
+
+		clusterObject.Result = kubeobjects.ClusterObjectSkipped
+
+		return clusterObject, nil
+	}
+}
+
+// ClusterObjectsRelease removes the owner identified by the labels from the owners of the cluster scoped objects that
+// it owns, i.e. that its recovery created, and deletes those left without owners that no other objects refer to, so
+// that the cluster scoped objects that other apps depend on are kept. Custom resource definitions are never deleted.
+func (r RequestsManager) ClusterObjectsRelease(
+	ctx context.Context,
+	k8sclient client.Client,
+	log logr.Logger,
+	labels map[string]string,
+	clusterObjects []kubeobjects.ClusterObject,
+) error {
+	owner := clusterObjectOwner(labels)
+	if owner == "" {
+		return nil
+	}
+
+	resources, err := resourcesList(r.Discovery, log)
+	if err != nil {
+		return err
+	}
+
+	errs := []error{}
+
+	for _, clusterObject := range clusterObjects {
+		if !clusterObject.Owned() {
+			continue
+		}
+
+		if err := clusterObjectRelease(ctx, k8sclient, resources, clusterObject, owner, log); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func clusterObjectRelease(
+	ctx context.Context,
+	k8sclient client.Client,
+	resources []resource,
+	clusterObject kubeobjects.ClusterObject,
+	owner string,
+	log logr.Logger,
+) error {
+	log1 := log.WithValues("resource", clusterObject.Resource, "name", clusterObject.Name)
+
+	r, ok := resourceFind(resources, clusterObject.Resource)
+	if !ok {
+		log1.Info("Cluster scoped object resource not served; not released")
+
+		return nil
+	}
+
+	object := &unstructured.Unstructured{}
+	object.SetGroupVersionKind(r.GroupVersionKind)
+
+	if err := k8sclient.Get(ctx, client.ObjectKey{Name: clusterObject.Name}, object); err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil
+		}
+
+		return fmt.Errorf("%s %s get: %w", clusterObject.Resource, clusterObject.Name, err)
+	}
+
+	released := object.DeepCopy()
+	if !kubeobjects.ClusterObjectOwnerRemove(released, owner) {
+		return nil
+	}
+
+	if owners := kubeobjects.ClusterObjectOwners(released); len(owners) > 0 {
+		log1.Info("Cluster scoped object owned by others; kept", "owners", owners)
+
+		return clusterObjectPatch(ctx, k8sclient, released, object)
+	}
+
+	referrer, err := clusterObjectReferrer(ctx, k8sclient, resources, r, clusterObject.Name)
+	if err != nil {
+		return err
+	}
+
+	if referrer != "" {
+		log1.Info("Cluster scoped object referred to; kept", "referrer", referrer)
+
+		return clusterObjectPatch(ctx, k8sclient, released, object)
+	}
+
+	if clusterObject.Resource == kubeobjects.CustomResourceDefinitions {
+		log1.Info("Custom resource definition released; kept")
+
+		return clusterObjectPatch(ctx, k8sclient, released, object)
+	}
+
+	if err := k8sclient.Delete(ctx, object); err != nil && !k8serrors.IsNotFound(err) {
+		return fmt.Errorf("%s %s delete: %w", clusterObject.Resource, clusterObject.Name, err)
+	}
+
+	log1.Info("Cluster scoped object released and deleted")
+
+	return nil
+}
+
+func clusterObjectPatch(ctx context.Context, k8sclient client.Client, patched, object *unstructured.Unstructured,
+) error {
+	if err := k8sclient.Patch(ctx, patched, client.MergeFrom(object)); err != nil {
+		return fmt.Errorf("%s %s patch: %w", object.GetKind(), object.GetName(), err)
+	}
+
+	return nil
+}
+
+// clusterObjectReferrer returns an object that refers to a cluster scoped object, if any
+func clusterObjectReferrer(
+	ctx context.Context, k8sclient client.Client, resources []resource, r resource, name string,
+) (string, error) {
+	for _, referrerResourceName := range kubeobjects.ClusterObjectReferrers(r.groupResource(), name) {
+		referrerResource, ok := resourceFind(resources, referrerResourceName)
+		if !ok {
+			continue
+		}
+
+		objects, err := resourceObjectsList(ctx, k8sclient, referrerResource, nil)
+		if err != nil {
+			return "", err
+		}
+
+		for i := range objects {
+			object := &objects[i]
+			references := kubeobjects.ClusterObjectReferences(object, referrerResourceName)
+
+			if slices.Contains(references[r.groupResource()], name) {
+				return referrerResourceName + " " + object.GetNamespace() + "/" + object.GetName(), nil
+			}
+		}
+	}
+
+	return "", nil
+}
+
+func resourceFind(resources []resource, groupResource string) (resource, bool) {
+	for _, r := range resources {
+		if r.groupResource() == groupResource {
+			return r, true
+		}
+	}
+
+	return resource{}, false
+}
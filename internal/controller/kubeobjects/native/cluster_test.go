@@ -0,0 +1,271 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package native_test
+
+import (
+	"context"
+	"slices"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	"k8s.io/client-go/kubernetes/scheme"
+	k8stesting "k8s.io/client-go/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/ramendr/ramen/internal/controller/kubeobjects"
+	"github.com/ramendr/ramen/internal/controller/kubeobjects/native"
+	"github.com/ramendr/ramen/internal/controller/util"
+)
+
+var _ = Describe("Cluster scoped objects", func() {
+	const (
+		requestNamespaceName = "ramen-system"
+		appNamespaceName     = "app"
+		s3KeyPrefix          = "vrg-ns/vrg/kube-objects/0/"
+		owner                = "vrg-ns/vrg"
+	)
+
+	var (
+		ctx          context.Context
+		sourceClient client.Client
+		store        objectStore
+		manager      native.RequestsManager
+	)
+
+	requestLabels := map[string]string{util.LabelOwnerNamespaceName: "vrg-ns", util.LabelOwnerName: "vrg"}
+
+	discoveryClient := &fakediscovery.FakeDiscovery{Fake: &k8stesting.Fake{Resources: []*metav1.APIResourceList{
+		{
+			GroupVersion: "v1",
+			APIResources: []metav1.APIResource{
+				{
+					Name: "persistentvolumeclaims", SingularName: "persistentvolumeclaim",
+					Kind: "PersistentVolumeClaim", Namespaced: true, Verbs: []string{"list"},
+				},
+				{Name: "configmaps", SingularName: "configmap", Kind: "ConfigMap", Namespaced: true, Verbs: []string{"list"}},
+			},
+		},
+		{
+			GroupVersion: "storage.k8s.io/v1",
+			APIResources: []metav1.APIResource{
+				{Name: "storageclasses", SingularName: "storageclass", Kind: "StorageClass", Verbs: []string{"list"}},
+			},
+		},
+	}}}
+
+	storageClass := func(name, provisioner string, owners ...string) *storagev1.StorageClass {
+		storageClass := &storagev1.StorageClass{
+			ObjectMeta:  metav1.ObjectMeta{Name: name},
+			Provisioner: provisioner,
+		}
+		kubeobjects.ClusterObjectOwnersSet(storageClass, owners)
+
+		return storageClass
+	}
+
+	pvc := func(storageClassName string) *corev1.PersistentVolumeClaim {
+		return &corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{Namespace: appNamespaceName, Name: "data"},
+			Spec:       corev1.PersistentVolumeClaimSpec{StorageClassName: &storageClassName},
+		}
+	}
+
+	clusterResources := func(conflictPolicy kubeobjects.ConflictPolicy) *kubeobjects.ClusterResourcesSpec {
+		return &kubeobjects.ClusterResourcesSpec{
+			Resources:      []string{"storageclasses.storage.k8s.io"},
+			Referenced:     true,
+			ConflictPolicy: conflictPolicy,
+		}
+	}
+
+	spec := func(conflictPolicy kubeobjects.ConflictPolicy) kubeobjects.Spec {
+		return kubeobjects.Spec{
+			KubeResourcesSpec: kubeobjects.KubeResourcesSpec{IncludedNamespaces: []string{appNamespaceName}},
+			ClusterResources:  clusterResources(conflictPolicy),
+		}
+	}
+
+	clusterObjects := func(request kubeobjects.Request) []kubeobjects.ClusterObject {
+		reporter, ok := request.(kubeobjects.ClusterObjectsReporter)
+		Expect(ok).To(BeTrue())
+
+		return reporter.ClusterObjects()
+	}
+
+	recover := func(targetClient client.Client, conflictPolicy kubeobjects.ConflictPolicy,
+	) (kubeobjects.RecoverRequest, error) {
+		request, err := manager.RecoverRequestCreate(ctx, targetClient, logr.Discard(),
+			"https://s3", "bucket", "region", s3KeyPrefix, nil, nil,
+			kubeobjects.RecoverSpec{Spec: spec(conflictPolicy)}, requestNamespaceName, "capture", nil, "recover",
+			requestLabels, nil)
+		Expect(err).NotTo(HaveOccurred())
+
+		return request, request.Status(logr.Discard())
+	}
+
+	storageClassGet := func(k8sclient client.Client, name string) (*storagev1.StorageClass, error) {
+		storageClass := &storagev1.StorageClass{}
+
+		return storageClass, k8sclient.Get(ctx, client.ObjectKey{Name: name}, storageClass)
+	}
+
+	BeforeEach(func() {
+		ctx = context.TODO()
+		store = objectStore{}
+		manager = native.RequestsManager{
+			Discovery: discoveryClient,
+			ObjectStorerGet: func(context.Context, string, string, logr.Logger) (native.ObjectStorer, error) {
+				return store, nil
+			},
+		}
+
+		sourceClient = fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(
+			pvc("fast"),
+			storageClass("fast", "csi.example.com"),
+			storageClass("slow", "csi.example.com"),
+		).Build()
+
+		request, err := manager.ProtectRequestCreate(ctx, sourceClient, logr.Discard(),
+			"https://s3", "bucket", "region", s3KeyPrefix, nil, nil,
+			spec(""), requestNamespaceName, "capture", requestLabels, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(request.Status(logr.Discard())).To(Succeed())
+		Expect(clusterObjects(request)).To(HaveExactElements(kubeobjects.ClusterObject{
+			Resource: "storageclasses.storage.k8s.io", Name: "fast", Result: kubeobjects.ClusterObjectCaptured,
+		}))
+	})
+
+	It("captures the cluster scoped objects referred to, leaving them as they are", func() {
+		fast, err := storageClassGet(sourceClient, "fast")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(kubeobjects.ClusterObjectOwners(fast)).To(BeEmpty())
+
+		slow, err := storageClassGet(sourceClient, "slow")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(kubeobjects.ClusterObjectOwners(slow)).To(BeEmpty())
+	})
+
+	It("recovers cluster scoped objects, owned by the recovering owner only", func() {
+		targetClient := fake.NewClientBuilder().WithScheme(scheme.Scheme).Build()
+
+		request, err := recover(targetClient, "")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(clusterObjects(request)).To(HaveExactElements(HaveField("Result", kubeobjects.ClusterObjectCreated)))
+
+		fast, err := storageClassGet(targetClient, "fast")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(kubeobjects.ClusterObjectOwners(fast)).To(HaveExactElements(owner))
+	})
+
+	DescribeTable("handles cluster scoped objects that exist already as per the conflict policy",
+		func(conflictPolicy kubeobjects.ConflictPolicy, provisioner string, result kubeobjects.ClusterObjectResult,
+			failed bool, owners ...string,
+		) {
+			targetClient := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(
+				storageClass("fast", provisioner, "other/vrg"),
+			).Build()
+
+			request, err := recover(targetClient, conflictPolicy)
+			if failed {
+				Expect(err).To(HaveOccurred())
+			} else {
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			Expect(clusterObjects(request)).To(HaveExactElements(HaveField("Result", result)))
+
+			fast, err := storageClassGet(targetClient, "fast")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(kubeobjects.ClusterObjectOwners(fast)).To(HaveExactElements(owners))
+		},
+		Entry("the same", kubeobjects.ConflictPolicyFailIfDifferent, "csi.example.com",
+			kubeobjects.ClusterObjectUnchanged, false, "other/vrg"),
+		Entry("skip", kubeobjects.ConflictPolicySkip, "csi.other.com",
+			kubeobjects.ClusterObjectSkipped, false, "other/vrg"),
+		Entry("overwrite", kubeobjects.ConflictPolicyOverwrite, "csi.other.com",
+			kubeobjects.ClusterObjectOverwritten, false, "other/vrg"),
+		Entry("fail if different", kubeobjects.ConflictPolicyFailIfDifferent, "csi.other.com",
+			kubeobjects.ClusterObjectConflict, true, "other/vrg"),
+	)
+
+	It("releases only the cluster scoped objects that the recovery created, and never deletes definitions", func() {
+		definition := &apiextensionsv1.CustomResourceDefinition{ObjectMeta: metav1.ObjectMeta{
+			Name:        "databases.example.com",
+			Annotations: map[string]string{kubeobjects.ClusterObjectOwnersAnnotation: owner},
+		}}
+		definitionsScheme := runtime.NewScheme()
+		Expect(scheme.AddToScheme(definitionsScheme)).To(Succeed())
+		Expect(apiextensionsv1.AddToScheme(definitionsScheme)).To(Succeed())
+
+		k8sclient := fake.NewClientBuilder().WithScheme(definitionsScheme).WithObjects(
+			storageClass("fast", "csi.example.com", owner), definition,
+		).Build()
+		manager.Discovery = &fakediscovery.FakeDiscovery{Fake: &k8stesting.Fake{Resources: append(
+			slices.Clone(discoveryClient.Resources), &metav1.APIResourceList{
+				GroupVersion: "apiextensions.k8s.io/v1",
+				APIResources: []metav1.APIResource{{
+					Name: "customresourcedefinitions", SingularName: "customresourcedefinition",
+					Kind: "CustomResourceDefinition", Verbs: []string{"list"},
+				}},
+			},
+		)}}
+
+		Expect(manager.ClusterObjectsRelease(ctx, k8sclient, logr.Discard(), requestLabels,
+			[]kubeobjects.ClusterObject{
+				{Resource: "storageclasses.storage.k8s.io", Name: "fast", Result: kubeobjects.ClusterObjectCaptured},
+				{
+					Resource: "customresourcedefinitions.apiextensions.k8s.io", Name: definition.Name,
+					Result: kubeobjects.ClusterObjectCreated,
+				},
+			},
+		)).To(Succeed())
+
+		fast, err := storageClassGet(k8sclient, "fast")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(kubeobjects.ClusterObjectOwners(fast)).To(HaveExactElements(owner))
+
+		Expect(k8sclient.Get(ctx, client.ObjectKeyFromObject(definition), definition)).To(Succeed())
+		Expect(kubeobjects.ClusterObjectOwners(definition)).To(BeEmpty())
+	})
+
+	DescribeTable("releases cluster scoped objects, deleting those no longer owned nor referred to",
+		func(owners []string, referred, deleted bool) {
+			objects := []client.Object{storageClass("fast", "csi.example.com", owners...)}
+			if referred {
+				objects = append(objects, pvc("fast"))
+			}
+
+			k8sclient := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(objects...).Build()
+
+			Expect(manager.ClusterObjectsRelease(ctx, k8sclient, logr.Discard(), requestLabels,
+				[]kubeobjects.ClusterObject{{
+					Resource: "storageclasses.storage.k8s.io", Name: "fast", Result: kubeobjects.ClusterObjectCreated,
+				}},
+			)).To(Succeed())
+
+			fast, err := storageClassGet(k8sclient, "fast")
+			if deleted {
+				Expect(k8serrors.IsNotFound(err)).To(BeTrue())
+
+				return
+			}
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(kubeobjects.ClusterObjectOwners(fast)).NotTo(ContainElement(owner))
+		},
+		Entry("owned only", []string{owner}, false, true),
+		Entry("owned by others too", []string{owner, "other/vrg"}, false, false),
+		Entry("referred to", []string{owner}, true, false),
+		Entry("not owned", []string{"other/vrg"}, false, false),
+	)
+})
@@ -0,0 +1,627 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package native
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/go-logr/logr"
+	velero "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/ramendr/ramen/internal/controller/kubeobjects"
+	"github.com/ramendr/ramen/internal/controller/util"
+)
+
+const (
+	resourcesPath       = "resources/"
+	tombstonesPath      = "tombstones/"
+	capturedObjectsKey  = "resource-list"
+	namespacedPath      = "namespaces/"
+	clusterPath         = "cluster/"
+	resourceNameAnyOf   = "*"
+	objectStatusField   = "status"
+	objectMetadataField = "metadata"
+)
+
+// restorePriorities lists the resources that are recovered before all others, in order, so that the objects
+// recovered later can refer to them
+var restorePriorities = []string{
+	"customresourcedefinitions.apiextensions.k8s.io",
+	"namespaces",
+	"storageclasses.storage.k8s.io",
+	"serviceaccounts",
+	"secrets",
+	"configmaps",
+	"limitranges",
+	"resourcequotas",
+}
+
+// metadataFieldsRestored are the only metadata fields recovered, as the others are set by the api server
+var metadataFieldsRestored = []string{"name", "namespace", "generateName", "labels", "annotations"}
+
+type resource struct {
+	schema.GroupVersionKind
+	name         string
+	singularName string
+	namespaced   bool
+	watchable    bool
+}
+
+func (r resource) groupVersionResource() schema.GroupVersionResource {
+	return r.GroupVersion().WithResource(r.name)
+}
+
+func (r resource) groupResource() string {
+	return schema.GroupResource{Group: r.Group, Resource: r.name}.String()
+}
+
+// matches returns whether any of the resource names are the plural, singular, group qualified or kind name of
+// the resource. The wildcard name matches all resources, unless wildcard matching is not allowed.
+func (r resource) matches(resourceNames []string, wildcardAllowed bool) bool {
+	for _, resourceName := range resourceNames {
+		if resourceName == resourceNameAnyOf {
+			if wildcardAllowed {
+				return true
+			}
+
+			continue
+		}
+
+		for _, name := range []string{r.name, r.groupResource(), r.singularName, r.Kind} {
+			if name != "" && strings.EqualFold(name, resourceName) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// selected returns whether objects of the resource are included by the spec. Like Velero, cluster scoped resources
+// are included if the spec includes cluster resources, or if it leaves that unset and names the resource explicitly.
+func (r resource) selected(included, excluded []string, includeClusterResources *bool) bool {
+	if r.matches(excluded, true) {
+		return false
+	}
+
+	if !r.namespaced {
+		if includeClusterResources == nil {
+			return r.matches(included, false)
+		}
+
+		if !*includeClusterResources {
+			return false
+		}
+	}
+
+	return len(included) == 0 || r.matches(included, true)
+}
+
+func resourcesList(discoveryClient discovery.DiscoveryInterface, log logr.Logger) ([]resource, error) {
+	resourceLists, err := discovery.ServerPreferredResources(discoveryClient)
+	if err != nil {
+		if !discovery.IsGroupDiscoveryFailedError(err) {
+			return nil, fmt.Errorf("resources discovery: %w", err)
+		}
+
+		// resources of the remaining groups can still be captured
+		log.Info("Resources discovery incomplete", "error", err)
+	}
+
+	resources := []resource{}
+
+	for _, resourceList := range resourceLists {
+		groupVersion, err := schema.ParseGroupVersion(resourceList.GroupVersion)
+		if err != nil {
+			return nil, fmt.Errorf("resources discovery group version %s: %w", resourceList.GroupVersion, err)
+		}
+
+		for _, apiResource := range resourceList.APIResources {
+			// skip subresources and resources that cannot be listed
+			if strings.Contains(apiResource.Name, "/") || !slices.Contains(apiResource.Verbs, "list") {
+				continue
+			}
+
+			resources = append(resources, resource{
+				GroupVersionKind: groupVersion.WithKind(apiResource.Kind),
+				name:             apiResource.Name,
+				singularName:     apiResource.SingularName,
+				namespaced:       apiResource.Namespaced,
+				watchable:        slices.Contains(apiResource.Verbs, "watch"),
+			})
+		}
+	}
+
+	return resources, nil
+}
+
+// objectSelected returns whether the object labels match the label selector or, if set, any of the "or" label
+// selectors. Objects created by Ramen are never selected.
+func objectSelected(
+	object *unstructured.Unstructured, labelSelector *metav1.LabelSelector, orLabelSelectors []*metav1.LabelSelector,
+) (bool, error) {
+	objectLabels := labels.Set(object.GetLabels())
+
+	if objectLabels[util.CreatedByRamenLabel] == "true" {
+		return false, nil
+	}
+
+	labelSelectors := orLabelSelectors
+	if len(labelSelectors) == 0 {
+		labelSelectors = []*metav1.LabelSelector{labelSelector}
+	}
+
+	for _, labelSelector := range labelSelectors {
+		if labelSelector == nil {
+			return true, nil
+		}
+
+		selector, err := metav1.LabelSelectorAsSelector(labelSelector)
+		if err != nil {
+			return false, fmt.Errorf("label selector %v: %w", labelSelector, err)
+		}
+
+		if selector.Matches(objectLabels) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func objectKey(r resource, namespaceName, name string) string {
+	if !r.namespaced {
+		return resourcesPath + r.groupResource() + "/" + clusterPath + name
+	}
+
+	return resourcesPath + r.groupResource() + "/" + namespacedPath + namespaceName + "/" + name
+}
+
+// objectsCapture captures the selected objects, and the cluster scoped objects that they depend on, if selected
+func objectsCapture(
+	ctx context.Context,
+	k8sclient client.Client,
+	discoveryClient discovery.DiscoveryInterface,
+	objectStorer ObjectStorer,
+	keyPrefix string,
+	spec kubeobjects.Spec,
+	log logr.Logger,
+) (int, []kubeobjects.ClusterObject, error) {
+	resources, err := resourcesList(discoveryClient, log)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	excluded := append(slices.Clone(spec.ExcludedResources), kubeobjects.ExcludedResourcesDefault...)
+	capturedObjects := kubeobjects.CapturedObjects{}
+	references := clusterObjectReferences{}
+
+	for _, r := range resources {
+		selected := r.selected(spec.IncludedResources, excluded, spec.IncludeClusterResources)
+		if !selected && !references.referrer(r, spec.ClusterResources) {
+			continue
+		}
+
+		objects, err := resourceObjectsList(ctx, k8sclient, r, spec.IncludedNamespaces)
+		if err != nil {
+			return capturedObjects.Count(), nil, err
+		}
+
+		for i := range objects {
+			object := &objects[i]
+
+			objectSelected, err := objectSelected(object, spec.LabelSelector, spec.OrLabelSelectors)
+			if err != nil {
+				return capturedObjects.Count(), nil, err
+			}
+
+			if !objectSelected {
+				continue
+			}
+
+			references.insert(object, r)
+
+			if !selected {
+				continue
+			}
+
+			if err := objectCapture(objectStorer, keyPrefix, object, r, capturedObjects); err != nil {
+				return capturedObjects.Count(), nil, err
+			}
+		}
+	}
+
+	clusterObjects, err := clusterObjectsCapture(ctx, k8sclient, resources, objectStorer, keyPrefix,
+		spec.ClusterResources, references, capturedObjects, log)
+	if err != nil {
+		return capturedObjects.Count(), clusterObjects, err
+	}
+
+	if err := objectStorer.UploadObject(keyPrefix+capturedObjectsKey, capturedObjects); err != nil {
+		return capturedObjects.Count(), clusterObjects, fmt.Errorf("captured objects list upload: %w", err)
+	}
+
+	log.Info("Kube objects captured", "count", capturedObjects.Count(), "cluster scoped", len(clusterObjects))
+
+	return capturedObjects.Count(), clusterObjects, nil
+}
+
+func objectCapture(objectStorer ObjectStorer, keyPrefix string, object *unstructured.Unstructured, r resource,
+	capturedObjects kubeobjects.CapturedObjects,
+) error {
+	object.SetManagedFields(nil)
+
+	key := keyPrefix + objectKey(r, object.GetNamespace(), object.GetName())
+	if err := objectStorer.UploadObject(key, object.Object); err != nil {
+		return fmt.Errorf("object %s upload: %w", key, err)
+	}
+
+	capturedObjects.Insert(kubeobjects.CapturedObjectsKind(r.GroupVersionKind),
+		kubeobjects.CapturedObjectName(object.GetNamespace(), object.GetName()))
+
+	return nil
+}
+
+func resourceObjectsList(
+	ctx context.Context, reader client.Reader, r resource, namespaceNames []string,
+) ([]unstructured.Unstructured, error) {
+	// an empty namespace name lists objects in all namespaces, or cluster scoped objects
+	if !r.namespaced || len(namespaceNames) == 0 {
+		namespaceNames = []string{""}
+	}
+
+	objects := []unstructured.Unstructured{}
+
+	for _, namespaceName := range namespaceNames {
+		list := &unstructured.UnstructuredList{}
+		list.SetGroupVersionKind(r.GroupVersion().WithKind(r.Kind + "List"))
+
+		if err := reader.List(ctx, list, client.InNamespace(namespaceName)); err != nil {
+			return nil, fmt.Errorf("%s list in namespace %q: %w", r.groupResource(), namespaceName, err)
+		}
+
+		objects = append(objects, list.Items...)
+	}
+
+	return objects, nil
+}
+
+type capturedObject struct {
+	key string
+	resource
+	namespaceName string
+	name          string
+}
+
+// capturedObjectParse parses the resource and namespace of a captured object from its key
+func capturedObjectParse(keyPrefix, key string) (capturedObject, error) {
+	path, ok := strings.CutPrefix(key, keyPrefix+resourcesPath)
+	if !ok {
+		return capturedObject{}, fmt.Errorf("object key %s unexpected", key)
+	}
+
+	groupResourceName, objectPath, _ := strings.Cut(path, "/")
+	groupResource := schema.ParseGroupResource(groupResourceName)
+	object := capturedObject{key: key, resource: resource{name: groupResource.Resource}}
+	object.Group = groupResource.Group
+
+	if namespacePath, ok := strings.CutPrefix(objectPath, namespacedPath); ok {
+		object.namespaced = true
+		object.namespaceName, object.name, ok = strings.Cut(namespacePath, "/")
+
+		if ok {
+			return object, nil
+		}
+	} else if object.name, ok = strings.CutPrefix(objectPath, clusterPath); ok {
+		return object, nil
+	}
+
+	return capturedObject{}, fmt.Errorf("object key %s unexpected", key)
+}
+
+func restorePriority(groupResource string) int {
+	if index := slices.Index(restorePriorities, groupResource); index >= 0 {
+		return index
+	}
+
+	return len(restorePriorities)
+}
+
+// tombstonedObjectKeys returns the keys, relative to the capture, of the captured objects that were deleted after
+// the capture
+func tombstonedObjectKeys(objectStorer ObjectStorer, changesKeyPrefix string) (map[string]struct{}, error) {
+	keys, err := objectStorer.ListKeys(changesKeyPrefix + tombstonesPath)
+	if err != nil {
+		return nil, fmt.Errorf("tombstones list: %w", err)
+	}
+
+	objectKeys := make(map[string]struct{}, len(keys))
+
+	for _, key := range keys {
+		objectKeys[strings.TrimPrefix(key, changesKeyPrefix+tombstonesPath)] = struct{}{}
+	}
+
+	return objectKeys, nil
+}
+
+func tombstoneKey(changesKeyPrefix, objectKey string) string {
+	return changesKeyPrefix + tombstonesPath + objectKey
+}
+
+// capturedObjectsList lists the captured objects, in restore priority order. Objects changed after the capture are
+// listed as changed, and those deleted are not listed.
+func capturedObjectsList(objectStorer ObjectStorer, keyPrefix, changesKeyPrefix string, log logr.Logger,
+) ([]capturedObject, error) {
+	keys, err := objectStorer.ListKeys(keyPrefix + resourcesPath)
+	if err != nil {
+		return nil, fmt.Errorf("captured objects list: %w", err)
+	}
+
+	changedKeys, err := objectStorer.ListKeys(changesKeyPrefix + resourcesPath)
+	if err != nil {
+		return nil, fmt.Errorf("changed objects list: %w", err)
+	}
+
+	tombstoned, err := tombstonedObjectKeys(objectStorer, changesKeyPrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	changed := make(map[string]struct{}, len(changedKeys))
+	for _, key := range changedKeys {
+		changed[strings.TrimPrefix(key, changesKeyPrefix)] = struct{}{}
+	}
+
+	objects := make([]capturedObject, 0, len(keys)+len(changedKeys))
+	objects = capturedObjectsAppend(objects, keyPrefix, keys, log, tombstoned, changed)
+	objects = capturedObjectsAppend(objects, changesKeyPrefix, changedKeys, log, tombstoned)
+
+	sort.SliceStable(objects, func(i, j int) bool {
+		return restorePriority(objects[i].groupResource()) < restorePriority(objects[j].groupResource())
+	})
+
+	return objects, nil
+}
+
+// capturedObjectsAppend appends the objects with the passed in keys, except those whose keys relative to the key
+// prefix are in any of the passed in sets
+func capturedObjectsAppend(objects []capturedObject, keyPrefix string, keys []string, log logr.Logger,
+	skipped ...map[string]struct{},
+) []capturedObject {
+	for _, key := range keys {
+		if slices.ContainsFunc(skipped, func(objectKeys map[string]struct{}) bool {
+			_, ok := objectKeys[strings.TrimPrefix(key, keyPrefix)]
+
+			return ok
+		}) {
+			continue
+		}
+
+		object, err := capturedObjectParse(keyPrefix, key)
+		if err != nil {
+			log.Info("Captured object skipped", "error", err)
+
+			continue
+		}
+
+		objects = append(objects, object)
+	}
+
+	return objects
+}
+
+func objectsRecover(
+	ctx context.Context,
+	k8sclient client.Client,
+	objectStorer ObjectStorer,
+	keyPrefix string,
+	changesKeyPrefix string,
+	spec kubeobjects.RecoverSpec,
+	owner string,
+	recoverName string,
+	log logr.Logger,
+) (int, []kubeobjects.ClusterObject, error) {
+	objects, err := capturedObjectsList(objectStorer, keyPrefix, changesKeyPrefix, log)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	if spec.OrderByOwners {
+		objects, err = capturedObjectsOrderByOwners(objectStorer, objects, spec.IncludedNamespaces)
+		if err != nil {
+			return 0, nil, err
+		}
+	}
+
+	count := 0
+	clusterObjects := []kubeobjects.ClusterObject{}
+	errs := []error{}
+
+	for _, capturedObject := range objects {
+		recovered, clusterObject, err := objectRecover(ctx, k8sclient, objectStorer, capturedObject, spec, owner,
+			recoverName, log)
+		if clusterObject != nil {
+			clusterObjects = append(clusterObjects, *clusterObject)
+		}
+
+		if err != nil {
+			errs = append(errs, err)
+
+			continue
+		}
+
+		if recovered {
+			count++
+		}
+	}
+
+	log.Info("Kube objects recovered", "count", count, "cluster scoped", len(clusterObjects), "errors", len(errs))
+
+	return count, clusterObjects, errors.Join(errs...)
+}
+
+// objectRecover recovers a captured object, if selected, and returns whether it did, and the outcome of recovering
+// it if it is a cluster scoped object that the selected objects depend on
+func objectRecover(
+	ctx context.Context,
+	k8sclient client.Client,
+	objectStorer ObjectStorer,
+	capturedObject capturedObject,
+	spec kubeobjects.RecoverSpec,
+	owner string,
+	recoverName string,
+	log logr.Logger,
+) (bool, *kubeobjects.ClusterObject, error) {
+	if len(spec.IncludedNamespaces) > 0 && capturedObject.namespaced &&
+		!slices.Contains(spec.IncludedNamespaces, capturedObject.namespaceName) {
+		return false, nil, nil
+	}
+
+	object := &unstructured.Unstructured{}
+	if err := objectStorer.DownloadObject(capturedObject.key, &object.Object); err != nil {
+		return false, nil, fmt.Errorf("object %s download: %w", capturedObject.key, err)
+	}
+
+	r := capturedObject.resource
+	r.GroupVersionKind = object.GroupVersionKind()
+
+	if clusterResourceSelected(r, spec.ClusterResources) {
+		clusterObject, err := clusterObjectRecover(ctx, k8sclient, object, r, spec, owner, log)
+
+		return err == nil, &clusterObject, err
+	}
+
+	if !r.selected(spec.IncludedResources, spec.ExcludedResources, spec.IncludeClusterResources) ||
+		spec.CustomResources != nil && kubeobjects.CustomResourceGroup(r.Group) != *spec.CustomResources {
+		return false, nil, nil
+	}
+
+	selected, err := objectSelected(object, spec.LabelSelector, spec.OrLabelSelectors)
+	if err != nil || !selected {
+		return false, nil, err
+	}
+
+	status, statusRestored := object.Object[objectStatusField]
+	statusRestored = statusRestored && spec.RestoreStatus != nil &&
+		r.selected(spec.RestoreStatus.IncludedResources, spec.RestoreStatus.ExcludedResources, nil)
+
+	objectRecoverPrepare(object, r, spec.NamespaceMapping)
+
+	if err := kubeobjects.TransformsApply(object, spec.Transforms); err != nil {
+		return false, nil, err
+	}
+
+	applied, err := objectApply(ctx, k8sclient, object, spec.ExistingResourcePolicy, recoverName, log)
+	if err != nil {
+		return false, nil, err
+	}
+
+	if applied && statusRestored {
+		object.Object[objectStatusField] = status
+
+		if err := k8sclient.Status().Update(ctx, object); err != nil {
+			return false, nil, fmt.Errorf("%s %s/%s status update: %w", r.groupResource(), object.GetNamespace(),
+				object.GetName(), err)
+		}
+	}
+
+	return true, nil, nil
+}
+
+// objectRecoverPrepare removes the fields that are set by the api server, or assigned by the cluster, the same way
+// Velero does on restore, and maps the object's namespace
+func objectRecoverPrepare(object *unstructured.Unstructured, r resource, namespaceMapping map[string]string) {
+	if metadata, ok := object.Object[objectMetadataField].(map[string]interface{}); ok {
+		for field := range metadata {
+			if !slices.Contains(metadataFieldsRestored, field) {
+				delete(metadata, field)
+			}
+		}
+	}
+
+	delete(object.Object, objectStatusField)
+
+	if r.groupResource() == "services" {
+		if clusterIP, _, _ := unstructured.NestedString(object.Object, "spec", "clusterIP"); clusterIP != "None" {
+			unstructured.RemoveNestedField(object.Object, "spec", "clusterIP")
+			unstructured.RemoveNestedField(object.Object, "spec", "clusterIPs")
+		}
+
+		// node ports may be in use on the recovering cluster, so let them be assigned again
+		if ports, ok, _ := unstructured.NestedSlice(object.Object, "spec", "ports"); ok {
+			for _, port := range ports {
+				if port, ok := port.(map[string]interface{}); ok {
+					delete(port, "nodePort")
+				}
+			}
+
+			_ = unstructured.SetNestedSlice(object.Object, ports, "spec", "ports")
+		}
+	}
+
+	if r.groupResource() == "namespaces" {
+		if name, ok := namespaceMapping[object.GetName()]; ok {
+			object.SetName(name)
+		}
+
+		return
+	}
+
+	if namespaceName, ok := namespaceMapping[object.GetNamespace()]; ok && object.GetNamespace() != "" {
+		object.SetNamespace(namespaceName)
+	}
+}
+
+// objectApply creates the object, labeled with the name of the recover request, so that the objects it created are
+// told apart from those that existed already. If the object exists already, it is left unchanged unless the existing
+// resource policy is update, in which case it is patched with the recovered object. It returns whether the object
+// was created or patched.
+func objectApply(
+	ctx context.Context, k8sclient client.Client, object *unstructured.Unstructured,
+	existingResourcePolicy velero.PolicyType, recoverName string, log logr.Logger,
+) (bool, error) {
+	log1 := log.WithValues("kind", object.GetKind(), "namespace", object.GetNamespace(), "name", object.GetName())
+
+	created := object.DeepCopy()
+	util.AddLabel(created, recoverNameLabel, recoverName)
+
+	err := k8sclient.Create(ctx, created)
+	if err == nil {
+		log1.Info("Object recovered")
+
+		created.DeepCopyInto(object)
+
+		return true, nil
+	}
+
+	if !k8serrors.IsAlreadyExists(err) {
+		return false, fmt.Errorf("%s %s/%s create: %w", object.GetKind(), object.GetNamespace(), object.GetName(), err)
+	}
+
+	if existingResourcePolicy != velero.PolicyTypeUpdate {
+		log1.Info("Object exists already; not updated", "policy", existingResourcePolicy)
+
+		return false, nil
+	}
+
+	if err := k8sclient.Patch(ctx, object, client.Merge); err != nil {
+		return false, fmt.Errorf("%s %s/%s patch: %w", object.GetKind(), object.GetNamespace(), object.GetName(), err)
+	}
+
+	log1.Info("Object exists already; updated")
+
+	return true, nil
+}
@@ -0,0 +1,129 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package native
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/ramendr/ramen/internal/controller/kubeobjects"
+)
+
+// builtinOwners lists, by the resource of built-in objects, the resources of the built-in objects that own them
+var builtinOwners = map[string][]string{
+	"replicasets.apps":                {"deployments.apps"},
+	"jobs.batch":                      {"cronjobs.batch"},
+	"controllerrevisions.apps":        {"statefulsets.apps", "daemonsets.apps"},
+	"endpointslices.discovery.k8s.io": {"services"},
+	"pods": {
+		"replicasets.apps", "statefulsets.apps", "daemonsets.apps", "jobs.batch", "replicationcontrollers",
+	},
+}
+
+// capturedObjectsOrderByOwners orders the captured objects by restore priority, then built-in kinds before custom
+// ones, then the kinds that own others before the kinds they own. The objects are ordered by the resources in their
+// keys: built-in resources as per the resources known to own them, and custom resources as per the owner references
+// of an object of each, so that only one object of each custom resource is downloaded.
+func capturedObjectsOrderByOwners(objectStorer ObjectStorer, objects []capturedObject, includedNamespaces []string,
+) ([]capturedObject, error) {
+	ranks := ownerRanks(builtinOwners)
+
+	customOwners, err := customResourceOwners(objectStorer, objects, includedNamespaces)
+	if err != nil {
+		return nil, err
+	}
+
+	for groupResource, rank := range ownerRanks(customOwners) {
+		ranks[groupResource] = rank
+	}
+
+	rank := func(object capturedObject) []int {
+		custom := 0
+		if kubeobjects.CustomResourceGroup(object.Group) {
+			custom = 1
+		}
+
+		return []int{restorePriority(object.groupResource()), custom, ranks[object.groupResource()]}
+	}
+
+	sort.SliceStable(objects, func(i, j int) bool {
+		return slices.Compare(rank(objects[i]), rank(objects[j])) < 0
+	})
+
+	return objects, nil
+}
+
+// customResourceOwners downloads an object of each custom resource of the included namespaces, and returns the
+// custom resources of their owners, by custom resource. Owners not captured are ignored.
+func customResourceOwners(objectStorer ObjectStorer, objects []capturedObject, includedNamespaces []string,
+) (map[string][]string, error) {
+	groupResources := map[schema.GroupKind]string{}
+	ownerKinds := map[string][]schema.GroupKind{}
+
+	for _, object := range objects {
+		groupResource := object.groupResource()
+		if _, ok := ownerKinds[groupResource]; ok || !kubeobjects.CustomResourceGroup(object.Group) ||
+			len(includedNamespaces) > 0 && object.namespaced &&
+				!slices.Contains(includedNamespaces, object.namespaceName) {
+			continue
+		}
+
+		downloaded := &unstructured.Unstructured{}
+		if err := objectStorer.DownloadObject(object.key, &downloaded.Object); err != nil {
+			return nil, fmt.Errorf("object %s download: %w", object.key, err)
+		}
+
+		groupResources[downloaded.GroupVersionKind().GroupKind()] = groupResource
+		ownerKinds[groupResource] = []schema.GroupKind{}
+
+		for _, ownerReference := range downloaded.GetOwnerReferences() {
+			ownerKinds[groupResource] = append(ownerKinds[groupResource],
+				schema.FromAPIVersionAndKind(ownerReference.APIVersion, ownerReference.Kind).GroupKind())
+		}
+	}
+
+	owners := make(map[string][]string, len(ownerKinds))
+
+	for groupResource, kinds := range ownerKinds {
+		owners[groupResource] = []string{}
+
+		for _, kind := range kinds {
+			if owner, ok := groupResources[kind]; ok && owner != groupResource {
+				owners[groupResource] = append(owners[groupResource], owner)
+			}
+		}
+	}
+
+	return owners, nil
+}
+
+// ownerRanks ranks the resources by the length of the longest chain of resources that own them, so that owners rank
+// before the resources they own. Resources that own each other rank no higher than the number of resources.
+func ownerRanks(owners map[string][]string) map[string]int {
+	ranks := map[string]int{}
+
+	for owned, ownedOwners := range owners {
+		ranks[owned] = 0
+
+		for _, owner := range ownedOwners {
+			ranks[owner] = 0
+		}
+	}
+
+	for range ranks {
+		for owned, ownedOwners := range owners {
+			for _, owner := range ownedOwners {
+				if rank := ranks[owner] + 1; rank > ranks[owned] && rank <= len(ranks) {
+					ranks[owned] = rank
+				}
+			}
+		}
+	}
+
+	return ranks
+}
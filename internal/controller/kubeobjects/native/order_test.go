@@ -0,0 +1,99 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package native_test
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	"k8s.io/client-go/kubernetes/scheme"
+	k8stesting "k8s.io/client-go/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	"github.com/ramendr/ramen/internal/controller/kubeobjects"
+	"github.com/ramendr/ramen/internal/controller/kubeobjects/native"
+	"github.com/ramendr/ramen/internal/controller/util"
+)
+
+var _ = Describe("Recover order", func() {
+	const (
+		appNamespaceName = "app"
+		s3KeyPrefix      = "vrg-ns/vrg/kube-objects/0/"
+	)
+
+	requestLabels := map[string]string{util.LabelOwnerNamespaceName: "vrg-ns", util.LabelOwnerName: "vrg"}
+
+	discoveryClient := &fakediscovery.FakeDiscovery{Fake: &k8stesting.Fake{Resources: []*metav1.APIResourceList{{
+		GroupVersion: "v1",
+		APIResources: []metav1.APIResource{
+			{Name: "pods", SingularName: "pod", Kind: "Pod", Namespaced: true, Verbs: []string{"list"}},
+			{
+				Name: "replicationcontrollers", SingularName: "replicationcontroller", Kind: "ReplicationController",
+				Namespaced: true, Verbs: []string{"list"},
+			},
+		},
+	}}}}
+
+	DescribeTable("recovers the kinds that own others first if ordered by owners",
+		func(orderByOwners bool, kinds ...string) {
+			ctx := context.TODO()
+			store := objectStore{}
+			manager := native.RequestsManager{
+				Discovery: discoveryClient,
+				ObjectStorerGet: func(context.Context, string, string, logr.Logger) (native.ObjectStorer, error) {
+					return store, nil
+				},
+			}
+			spec := kubeobjects.Spec{
+				KubeResourcesSpec: kubeobjects.KubeResourcesSpec{IncludedNamespaces: []string{appNamespaceName}},
+			}
+
+			sourceClient := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(
+				&corev1.ReplicationController{ObjectMeta: metav1.ObjectMeta{Namespace: appNamespaceName, Name: "a"}},
+				&corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+					Namespace: appNamespaceName, Name: "a-1",
+					OwnerReferences: []metav1.OwnerReference{
+						{APIVersion: "v1", Kind: "ReplicationController", Name: "a", UID: "1"},
+					},
+				}},
+			).Build()
+
+			request, err := manager.ProtectRequestCreate(ctx, sourceClient, logr.Discard(),
+				"https://s3", "bucket", "region", s3KeyPrefix, nil, nil,
+				spec, "ramen-system", "capture", requestLabels, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(request.Status(logr.Discard())).To(Succeed())
+
+			created := []string{}
+			targetClient := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithInterceptorFuncs(interceptor.Funcs{
+				Create: func(ctx context.Context, c client.WithWatch, object client.Object, opts ...client.CreateOption,
+				) error {
+					// the request config map is typed, so without a kind
+					if kind := object.GetObjectKind().GroupVersionKind().Kind; kind != "" {
+						created = append(created, kind)
+					}
+
+					return c.Create(ctx, object, opts...)
+				},
+			}).Build()
+
+			recoverRequest, err := manager.RecoverRequestCreate(ctx, targetClient, logr.Discard(),
+				"https://s3", "bucket", "region", s3KeyPrefix, nil, nil,
+				kubeobjects.RecoverSpec{Spec: spec, OrderByOwners: orderByOwners}, "ramen-system", "capture", nil,
+				"recover", requestLabels, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(recoverRequest.Status(logr.Discard())).To(Succeed())
+			Expect(created).To(HaveExactElements(kinds))
+		},
+		Entry("not ordered", false, "Pod", "ReplicationController"),
+		Entry("ordered by owners", true, "ReplicationController", "Pod"),
+	)
+})
@@ -0,0 +1,413 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+// +kubebuilder:rbac:groups="",resources=configmaps,verbs=create;delete;deletecollection;get;list;watch
+
+// Package native implements a kube objects requests manager that captures and recovers kube objects without
+// Velero. Objects are listed with the api server and stored directly in the S3 stores. Each request is recorded
+// in a config map, which holds the outcome of the request once it has been processed. Capturing and recovering
+// arbitrary kinds requires access to them, which the config/dr-cluster/native-kubeobjects component grants.
+package native
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/vmware-tanzu/velero/pkg/label"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/dynamic"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/ramendr/ramen/internal/controller/kubeobjects"
+	"github.com/ramendr/ramen/internal/controller/util"
+)
+
+const (
+	path         = "native/"
+	protectsPath = path + "captures/"
+	recoversPath = path + "recovers/"
+	changesPath  = path + "changes/"
+
+	requestTypeLabel    = "kubeobjects.ramendr.openshift.io/request"
+	recoverNameLabel    = "kubeobjects.ramendr.openshift.io/recover"
+	requestTypeCapture  = "capture"
+	requestTypeRecover  = "recover"
+	requestPhaseKey     = "phase"
+	requestErrorKey     = "error"
+	requestItemCountKey = "items"
+	requestStartTimeKey = "startTime"
+	requestEndTimeKey   = "endTime"
+
+	requestClusterObjectsKey = "clusterObjects"
+
+	requestPhaseCompleted = "Completed"
+	requestPhaseFailed    = "Failed"
+)
+
+// ObjectStorer is the subset of the S3 object store operations used to store captured kube objects
+type ObjectStorer interface {
+	UploadObject(key string, object interface{}) error
+	DownloadObject(key string, objectPointer interface{}) error
+	ListKeys(keyPrefix string) (keys []string, err error)
+	DeleteObject(key string) error
+}
+
+// ObjectStorerGetter returns the object store for an S3 endpoint and bucket
+type ObjectStorerGetter func(ctx context.Context, s3Url, s3BucketName string, log logr.Logger) (ObjectStorer, error)
+
+type (
+	CaptureRequest struct{ configMap *corev1.ConfigMap }
+	RecoverRequest struct{ configMap *corev1.ConfigMap }
+)
+
+type (
+	CaptureRequests struct{ configMaps *corev1.ConfigMapList }
+	RecoverRequests struct{ configMaps *corev1.ConfigMapList }
+)
+
+func (r CaptureRequest) Object() client.Object        { return r.configMap }
+func (r RecoverRequest) Object() client.Object        { return r.configMap }
+func (r CaptureRequest) Name() string                 { return r.configMap.Name }
+func (r RecoverRequest) Name() string                 { return r.configMap.Name }
+func (r CaptureRequest) StartTime() metav1.Time       { return requestTime(r.configMap, requestStartTimeKey) }
+func (r RecoverRequest) StartTime() metav1.Time       { return requestTime(r.configMap, requestStartTimeKey) }
+func (r CaptureRequest) EndTime() metav1.Time         { return requestTime(r.configMap, requestEndTimeKey) }
+func (r RecoverRequest) EndTime() metav1.Time         { return requestTime(r.configMap, requestEndTimeKey) }
+func (r CaptureRequest) Status(log logr.Logger) error { return requestStatus(r.configMap, log) }
+func (r RecoverRequest) Status(log logr.Logger) error { return requestStatus(r.configMap, log) }
+func (r CaptureRequest) ClusterObjects() []kubeobjects.ClusterObject {
+	return requestClusterObjects(r.configMap)
+}
+
+func (r RecoverRequest) ClusterObjects() []kubeobjects.ClusterObject {
+	return requestClusterObjects(r.configMap)
+}
+
+func (r CaptureRequests) Count() int { return len(r.configMaps.Items) }
+func (r RecoverRequests) Count() int { return len(r.configMaps.Items) }
+func (r CaptureRequests) Get(i int) kubeobjects.Request {
+	return CaptureRequest{&r.configMaps.Items[i]}
+}
+func (r RecoverRequests) Get(i int) kubeobjects.Request {
+	return RecoverRequest{&r.configMaps.Items[i]}
+}
+
+func (r CaptureRequest) Deallocate(ctx context.Context, k8sclient client.Client, log logr.Logger) error {
+	return requestDelete(ctx, k8sclient, r.configMap, log)
+}
+
+func (r RecoverRequest) Deallocate(ctx context.Context, k8sclient client.Client, log logr.Logger) error {
+	return requestDelete(ctx, k8sclient, r.configMap, log)
+}
+
+type RequestsManager struct {
+	// Discovery lists the resources served by the api server, to capture objects of all included resources
+	Discovery discovery.DiscoveryInterface
+	// ObjectStorerGet returns the object store that captured objects are uploaded to and downloaded from
+	ObjectStorerGet ObjectStorerGetter
+	// Dynamic watches the objects of captures to capture their changes incrementally
+	Dynamic dynamic.Interface
+}
+
+func (RequestsManager) ProtectsPath() string { return protectsPath }
+func (RequestsManager) RecoversPath() string { return recoversPath }
+
+// RecoveredObjectLabel returns the label of the objects created by a recover request. Objects that exist already are
+// not labeled, even if updated.
+func (RequestsManager) RecoveredObjectLabel(recoverName string) (string, string) {
+	return recoverNameLabel, label.GetValidName(recoverName)
+}
+
+func (RequestsManager) CapturedObjectsKey(captureName string) string {
+	return captureName + "/" + capturedObjectsKey
+}
+
+func (RequestsManager) ProtectRequestNew() kubeobjects.ProtectRequest {
+	return CaptureRequest{&corev1.ConfigMap{}}
+}
+
+func (RequestsManager) RecoverRequestNew() kubeobjects.RecoverRequest {
+	return RecoverRequest{&corev1.ConfigMap{}}
+}
+
+func (RequestsManager) ProtectRequestsGet(
+	ctx context.Context,
+	reader client.Reader,
+	requestNamespaceName string,
+	labels map[string]string,
+) (kubeobjects.Requests, error) {
+	requests := CaptureRequests{&corev1.ConfigMapList{}}
+
+	return requests, reader.List(ctx, requests.configMaps,
+		client.InNamespace(requestNamespaceName),
+		client.MatchingLabels(requestLabels(labels, requestTypeCapture)),
+	)
+}
+
+func (RequestsManager) RecoverRequestsGet(
+	ctx context.Context,
+	reader client.Reader,
+	requestNamespaceName string,
+	labels map[string]string,
+) (kubeobjects.Requests, error) {
+	requests := RecoverRequests{&corev1.ConfigMapList{}}
+
+	return requests, reader.List(ctx, requests.configMaps,
+		client.InNamespace(requestNamespaceName),
+		client.MatchingLabels(requestLabels(labels, requestTypeRecover)),
+	)
+}
+
+func (RequestsManager) ProtectRequestsDelete(
+	ctx context.Context,
+	writer client.Writer,
+	requestNamespaceName string,
+	labels map[string]string,
+) error {
+	if err := writer.DeleteAllOf(ctx, &corev1.ConfigMap{},
+		client.InNamespace(requestNamespaceName),
+		client.MatchingLabels(requestLabels(labels, requestTypeCapture)),
+	); err != nil {
+		return fmt.Errorf("capture requests delete: %w", err)
+	}
+
+	return nil
+}
+
+func (r RequestsManager) RecoverRequestsDelete(
+	ctx context.Context,
+	writer client.Writer,
+	requestNamespaceName string,
+	labels map[string]string,
+) error {
+	if err := writer.DeleteAllOf(ctx, &corev1.ConfigMap{},
+		client.InNamespace(requestNamespaceName),
+		client.MatchingLabels(requestLabels(labels, requestTypeRecover)),
+	); err != nil {
+		return fmt.Errorf("recover requests delete: %w", err)
+	}
+
+	return r.ProtectRequestsDelete(ctx, writer, requestNamespaceName, labels)
+}
+
+func (r RequestsManager) ProtectRequestCreate(
+	ctx context.Context,
+	k8sclient client.Client,
+	log logr.Logger,
+	s3Url string,
+	s3BucketName string,
+	s3RegionName string,
+	s3KeyPrefix string,
+	secretKeyRef *corev1.SecretKeySelector,
+	caCertificates []byte,
+	objectsSpec kubeobjects.Spec,
+	requestNamespaceName string,
+	captureName string,
+	labels map[string]string,
+	annotations map[string]string,
+) (kubeobjects.ProtectRequest, error) {
+	log.Info("Kube objects protect",
+		"s3 url", s3Url,
+		"s3 bucket", s3BucketName,
+		"s3 key prefix", s3KeyPrefix,
+		"source namespaces", objectsSpec.IncludedNamespaces,
+		"request namespace", requestNamespaceName,
+		"capture name", captureName,
+		"label set", labels,
+		"annotations", annotations,
+	)
+
+	startTime := metav1.Now()
+
+	objectStorer, err := r.ObjectStorerGet(ctx, s3Url, s3BucketName, log)
+	if err != nil {
+		return nil, fmt.Errorf("object store get: %w", err)
+	}
+
+	count, clusterObjects, captureErr := objectsCapture(ctx, k8sclient, r.Discovery, objectStorer,
+		captureKeyPrefix(s3KeyPrefix, captureName), objectsSpec, log)
+
+	configMap := requestConfigMap(requestNamespaceName, captureName, requestTypeCapture, labels, annotations,
+		startTime, count, clusterObjects, captureErr)
+
+	return CaptureRequest{configMap}, requestCreate(ctx, k8sclient, configMap, log)
+}
+
+func (r RequestsManager) RecoverRequestCreate(
+	ctx context.Context,
+	k8sclient client.Client,
+	log logr.Logger,
+	s3Url string,
+	s3BucketName string,
+	s3RegionName string,
+	s3KeyPrefix string,
+	secretKeyRef *corev1.SecretKeySelector,
+	caCertificates []byte,
+	recoverSpec kubeobjects.RecoverSpec,
+	requestNamespaceName string,
+	captureName string,
+	captureRequest kubeobjects.ProtectRequest,
+	recoverName string,
+	labels map[string]string,
+	annotations map[string]string,
+) (kubeobjects.RecoverRequest, error) {
+	log.Info("Kube objects recover",
+		"s3 url", s3Url,
+		"s3 bucket", s3BucketName,
+		"s3 key prefix", s3KeyPrefix,
+		"request namespace", requestNamespaceName,
+		"capture name", captureName,
+		"recover name", recoverName,
+		"label set", labels,
+		"annotations", annotations,
+	)
+
+	startTime := metav1.Now()
+
+	// the capture is read from the object store, so the capture request may be absent, e.g. on a failover cluster
+	objectStorer, err := r.ObjectStorerGet(ctx, s3Url, s3BucketName, log)
+	if err != nil {
+		return nil, fmt.Errorf("object store get: %w", err)
+	}
+
+	count, clusterObjects, recoverErr := objectsRecover(ctx, k8sclient, objectStorer,
+		captureKeyPrefix(s3KeyPrefix, captureName), changesKeyPrefix(s3KeyPrefix, captureName), recoverSpec,
+		clusterObjectOwner(labels), label.GetValidName(recoverName), log)
+
+	configMap := requestConfigMap(requestNamespaceName, recoverName, requestTypeRecover, labels, annotations,
+		startTime, count, clusterObjects, recoverErr)
+
+	return RecoverRequest{configMap}, requestCreate(ctx, k8sclient, configMap, log)
+}
+
+func captureKeyPrefix(s3KeyPrefix, captureName string) string {
+	return s3KeyPrefix + protectsPath + captureName + "/"
+}
+
+// changesKeyPrefix returns the key prefix of the changes to the objects of a capture, which are recovered in place
+// of the captured objects, so that the capture itself is not modified
+func changesKeyPrefix(s3KeyPrefix, captureName string) string {
+	return s3KeyPrefix + changesPath + captureName + "/"
+}
+
+func requestLabels(labels map[string]string, requestType string) map[string]string {
+	requestLabels := make(map[string]string, len(labels)+1)
+
+	for key, value := range labels {
+		requestLabels[key] = value
+	}
+
+	requestLabels[requestTypeLabel] = requestType
+
+	return requestLabels
+}
+
+func requestConfigMap(
+	namespaceName, name, requestType string,
+	labels, annotations map[string]string,
+	startTime metav1.Time, itemCount int, clusterObjects []kubeobjects.ClusterObject, err error,
+) *corev1.ConfigMap {
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   namespaceName,
+			Name:        name,
+			Labels:      requestLabels(labels, requestType),
+			Annotations: annotations,
+		},
+		Data: map[string]string{
+			requestPhaseKey:     requestPhaseCompleted,
+			requestItemCountKey: strconv.Itoa(itemCount),
+			requestStartTimeKey: startTime.UTC().Format(time.RFC3339),
+			requestEndTimeKey:   time.Now().UTC().Format(time.RFC3339),
+		},
+	}
+	util.AddLabel(configMap, util.CreatedByRamenLabel, "true")
+
+	if len(clusterObjects) > 0 {
+		if data, err := json.Marshal(clusterObjects); err == nil {
+			configMap.Data[requestClusterObjectsKey] = string(data)
+		}
+	}
+
+	if err != nil {
+		configMap.Data[requestPhaseKey] = requestPhaseFailed
+		configMap.Data[requestErrorKey] = err.Error()
+	}
+
+	return configMap
+}
+
+func requestCreate(ctx context.Context, k8sclient client.Client, configMap *corev1.ConfigMap, log logr.Logger,
+) error {
+	if err := k8sclient.Create(ctx, configMap); err != nil {
+		return fmt.Errorf("request %s/%s create: %w", configMap.Namespace, configMap.Name, err)
+	}
+
+	log.Info("Request processed", "name", configMap.Name, "phase", configMap.Data[requestPhaseKey],
+		"items", configMap.Data[requestItemCountKey])
+
+	return nil
+}
+
+func requestDelete(ctx context.Context, k8sclient client.Client, configMap *corev1.ConfigMap, log logr.Logger,
+) error {
+	if err := k8sclient.Delete(ctx, configMap); err != nil {
+		if !k8serrors.IsNotFound(err) {
+			return fmt.Errorf("request %s/%s delete: %w", configMap.Namespace, configMap.Name, err)
+		}
+
+		log.Info("Request deleted previously", "name", configMap.Name)
+
+		return nil
+	}
+
+	log.Info("Request deleted successfully", "name", configMap.Name)
+
+	return nil
+}
+
+func requestClusterObjects(configMap *corev1.ConfigMap) []kubeobjects.ClusterObject {
+	clusterObjects := []kubeobjects.ClusterObject{}
+
+	if data, ok := configMap.Data[requestClusterObjectsKey]; ok {
+		_ = json.Unmarshal([]byte(data), &clusterObjects)
+	}
+
+	return clusterObjects
+}
+
+func requestTime(configMap *corev1.ConfigMap, key string) metav1.Time {
+	if parsed, err := time.Parse(time.RFC3339, configMap.Data[key]); err == nil {
+		return metav1.NewTime(parsed)
+	}
+
+	return metav1.Now()
+}
+
+func requestStatus(configMap *corev1.ConfigMap, log logr.Logger) error {
+	phase := configMap.Data[requestPhaseKey]
+
+	log.Info("Request",
+		"name", configMap.Name,
+		"phase", phase,
+		"items", configMap.Data[requestItemCountKey],
+		"error", configMap.Data[requestErrorKey],
+	)
+
+	switch phase {
+	case requestPhaseCompleted:
+		return nil
+	case requestPhaseFailed:
+		return errors.New("request" + phase + ": " + configMap.Data[requestErrorKey])
+	default:
+		return kubeobjects.RequestProcessingErrorCreate("request.phase absent")
+	}
+}
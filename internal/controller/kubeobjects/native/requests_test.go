@@ -0,0 +1,289 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package native_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	velero "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	"k8s.io/client-go/kubernetes/scheme"
+	k8stesting "k8s.io/client-go/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/ramendr/ramen/internal/controller/kubeobjects"
+	"github.com/ramendr/ramen/internal/controller/kubeobjects/native"
+	"github.com/ramendr/ramen/internal/controller/util"
+)
+
+type objectStore map[string][]byte
+
+func (s objectStore) UploadObject(key string, object interface{}) (err error) {
+	s[key], err = json.Marshal(object)
+
+	return
+}
+
+func (s objectStore) DownloadObject(key string, objectPointer interface{}) error {
+	data, ok := s[key]
+	if !ok {
+		return fmt.Errorf("%s not found", key)
+	}
+
+	return json.Unmarshal(data, objectPointer)
+}
+
+func (s objectStore) ListKeys(keyPrefix string) ([]string, error) {
+	keys := []string{}
+
+	for key := range s {
+		if strings.HasPrefix(key, keyPrefix) {
+			keys = append(keys, key)
+		}
+	}
+
+	sort.Strings(keys)
+
+	return keys, nil
+}
+
+func (s objectStore) DeleteObject(key string) error {
+	delete(s, key)
+
+	return nil
+}
+
+var _ = Describe("RequestsManager", func() {
+	const (
+		requestNamespaceName = "ramen-system"
+		appNamespaceName     = "app"
+		s3KeyPrefix          = "vrg-ns/vrg/kube-objects/0/"
+		captureName          = "capture"
+		recoverName          = "recover"
+	)
+
+	var (
+		ctx           context.Context
+		sourceClient  client.Client
+		targetClient  client.Client
+		store         objectStore
+		manager       native.RequestsManager
+		requestLabels map[string]string
+	)
+
+	appLabels := map[string]string{"app": "a"}
+
+	discoveryClient := &fakediscovery.FakeDiscovery{Fake: &k8stesting.Fake{Resources: []*metav1.APIResourceList{{
+		GroupVersion: "v1",
+		APIResources: []metav1.APIResource{
+			{Name: "configmaps", SingularName: "configmap", Kind: "ConfigMap", Namespaced: true, Verbs: []string{"list"}},
+			{Name: "services", SingularName: "service", Kind: "Service", Namespaced: true, Verbs: []string{"list"}},
+			{Name: "services/status", Kind: "Service", Namespaced: true, Verbs: []string{"get"}},
+			{Name: "endpoints", SingularName: "endpoints", Kind: "Endpoints", Namespaced: true, Verbs: []string{"list"}},
+			{Name: "namespaces", SingularName: "namespace", Kind: "Namespace", Verbs: []string{"list"}},
+		},
+	}}}}
+
+	configMap := func(name string, labels map[string]string, data string) *corev1.ConfigMap {
+		return &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Namespace: appNamespaceName, Name: name, Labels: labels},
+			Data:       map[string]string{"key": data},
+		}
+	}
+
+	capture := func(spec kubeobjects.Spec) kubeobjects.ProtectRequest {
+		request, err := manager.ProtectRequestCreate(ctx, sourceClient, logr.Discard(),
+			"https://s3", "bucket", "region", s3KeyPrefix, nil, nil,
+			spec, requestNamespaceName, captureName, requestLabels, nil)
+		Expect(err).NotTo(HaveOccurred())
+
+		return request
+	}
+
+	recover := func(spec kubeobjects.RecoverSpec) kubeobjects.RecoverRequest {
+		request, err := manager.RecoverRequestCreate(ctx, targetClient, logr.Discard(),
+			"https://s3", "bucket", "region", s3KeyPrefix, nil, nil,
+			spec, requestNamespaceName, captureName, nil, recoverName, requestLabels, nil)
+		Expect(err).NotTo(HaveOccurred())
+
+		return request
+	}
+
+	BeforeEach(func() {
+		ctx = context.TODO()
+		store = objectStore{}
+		requestLabels = map[string]string{"owner": "vrg"}
+		manager = native.RequestsManager{
+			Discovery: discoveryClient,
+			ObjectStorerGet: func(context.Context, string, string, logr.Logger) (native.ObjectStorer, error) {
+				return store, nil
+			},
+		}
+
+		sourceClient = fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(
+			&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: appNamespaceName, Labels: appLabels}},
+			configMap("app-config", appLabels, "source"),
+			configMap("other-config", nil, "source"),
+			configMap("ramen-config", map[string]string{"app": "a", util.CreatedByRamenLabel: "true"}, "source"),
+			&corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{Namespace: appNamespaceName, Name: "app-service", Labels: appLabels},
+				Spec: corev1.ServiceSpec{
+					ClusterIP: "10.0.0.1",
+					Ports:     []corev1.ServicePort{{Port: 80, NodePort: 30080}},
+				},
+			},
+			&corev1.Endpoints{ObjectMeta: metav1.ObjectMeta{
+				Namespace: appNamespaceName, Name: "app-service", Labels: appLabels,
+			}},
+		).Build()
+		targetClient = fake.NewClientBuilder().WithScheme(scheme.Scheme).Build()
+	})
+
+	It("captures the selected objects of the included namespaces", func() {
+		request := capture(kubeobjects.Spec{
+			KubeResourcesSpec: kubeobjects.KubeResourcesSpec{IncludedNamespaces: []string{appNamespaceName}},
+			LabelSelector:     &metav1.LabelSelector{MatchLabels: appLabels},
+		})
+		Expect(request.Status(logr.Discard())).To(Succeed())
+
+		keys, err := store.ListKeys(s3KeyPrefix + manager.ProtectsPath() + captureName + "/")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(keys).To(ConsistOf(
+			s3KeyPrefix+"native/captures/capture/resources/configmaps/namespaces/app/app-config",
+			s3KeyPrefix+"native/captures/capture/resources/services/namespaces/app/app-service",
+			s3KeyPrefix+manager.ProtectsPath()+manager.CapturedObjectsKey(captureName),
+		))
+
+		capturedObjects := kubeobjects.CapturedObjects{}
+		Expect(store.DownloadObject(s3KeyPrefix+manager.ProtectsPath()+manager.CapturedObjectsKey(captureName),
+			&capturedObjects)).To(Succeed())
+		Expect(capturedObjects).To(Equal(kubeobjects.CapturedObjects{
+			"v1/ConfigMap": {"app/app-config"},
+			"v1/Service":   {"app/app-service"},
+		}))
+
+		requests, err := manager.ProtectRequestsGet(ctx, sourceClient, requestNamespaceName, requestLabels)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(requests.Count()).To(Equal(1))
+		Expect(requests.Get(0).Name()).To(Equal(captureName))
+	})
+
+	It("captures cluster scoped objects if cluster resources are included", func() {
+		capture(kubeobjects.Spec{
+			KubeResourcesSpec:       kubeobjects.KubeResourcesSpec{IncludedResources: []string{"namespace", "configmap"}},
+			LabelSelector:           &metav1.LabelSelector{MatchLabels: appLabels},
+			IncludeClusterResources: new(bool),
+		})
+		Expect(store).To(HaveLen(2))
+
+		Expect(sourceClient.Delete(ctx, &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{
+			Namespace: requestNamespaceName, Name: captureName,
+		}})).To(Succeed())
+
+		store = objectStore{}
+		includeClusterResources := true
+		capture(kubeobjects.Spec{
+			KubeResourcesSpec:       kubeobjects.KubeResourcesSpec{IncludedResources: []string{"namespace", "configmap"}},
+			LabelSelector:           &metav1.LabelSelector{MatchLabels: appLabels},
+			IncludeClusterResources: &includeClusterResources,
+		})
+		Expect(store).To(HaveKey(s3KeyPrefix + "native/captures/capture/resources/namespaces/cluster/app"))
+		Expect(store).To(HaveLen(3))
+	})
+
+	It("recovers captured objects to mapped namespaces without cluster assigned fields", func() {
+		capture(kubeobjects.Spec{
+			KubeResourcesSpec: kubeobjects.KubeResourcesSpec{IncludedNamespaces: []string{appNamespaceName}},
+			LabelSelector:     &metav1.LabelSelector{MatchLabels: appLabels},
+		})
+
+		request := recover(kubeobjects.RecoverSpec{NamespaceMapping: map[string]string{appNamespaceName: "app2"}})
+		Expect(request.Status(logr.Discard())).To(Succeed())
+
+		recoveredConfigMap := &corev1.ConfigMap{}
+		Expect(targetClient.Get(ctx, client.ObjectKey{Namespace: "app2", Name: "app-config"},
+			recoveredConfigMap)).To(Succeed())
+		Expect(recoveredConfigMap.Data).To(HaveKeyWithValue("key", "source"))
+
+		service := &corev1.Service{}
+		Expect(targetClient.Get(ctx, client.ObjectKey{Namespace: "app2", Name: "app-service"}, service)).To(Succeed())
+		Expect(service.Spec.ClusterIP).To(BeEmpty())
+		Expect(service.Spec.Ports[0].NodePort).To(BeZero())
+	})
+
+	It("recovers captured objects transformed", func() {
+		capture(kubeobjects.Spec{
+			KubeResourcesSpec: kubeobjects.KubeResourcesSpec{IncludedNamespaces: []string{appNamespaceName}},
+		})
+
+		request := recover(kubeobjects.RecoverSpec{Transforms: []kubeobjects.Transform{{
+			Name:          "endpoint",
+			Kind:          "ConfigMap",
+			LabelSelector: &metav1.LabelSelector{MatchLabels: appLabels},
+			PatchType:     kubeobjects.TransformMergePatch,
+			Patch:         `{"data": {"key": "target"}}`,
+		}}})
+		Expect(request.Status(logr.Discard())).To(Succeed())
+
+		for name, expected := range map[string]string{"app-config": "target", "other-config": "source"} {
+			recoveredConfigMap := &corev1.ConfigMap{}
+			Expect(targetClient.Get(ctx, client.ObjectKey{Namespace: appNamespaceName, Name: name},
+				recoveredConfigMap)).To(Succeed())
+			Expect(recoveredConfigMap.Data).To(HaveKeyWithValue("key", expected))
+		}
+	})
+
+	DescribeTable("recovers objects that exist already as per the existing resource policy",
+		func(existingResourcePolicy velero.PolicyType, expected string) {
+			capture(kubeobjects.Spec{
+				KubeResourcesSpec: kubeobjects.KubeResourcesSpec{IncludedResources: []string{"configmaps"}},
+			})
+			Expect(targetClient.Create(ctx, configMap("app-config", nil, "target"))).To(Succeed())
+
+			request := recover(kubeobjects.RecoverSpec{ExistingResourcePolicy: existingResourcePolicy})
+			Expect(request.Status(logr.Discard())).To(Succeed())
+
+			recoveredConfigMap := &corev1.ConfigMap{}
+			Expect(targetClient.Get(ctx, client.ObjectKey{Namespace: appNamespaceName, Name: "app-config"},
+				recoveredConfigMap)).To(Succeed())
+			Expect(recoveredConfigMap.Data).To(HaveKeyWithValue("key", expected))
+
+			// only the objects created are labeled as recovered
+			key, value := manager.RecoveredObjectLabel(recoverName)
+			Expect(recoveredConfigMap.Labels).NotTo(HaveKey(key))
+
+			createdConfigMap := &corev1.ConfigMap{}
+			Expect(targetClient.Get(ctx, client.ObjectKey{Namespace: appNamespaceName, Name: "other-config"},
+				createdConfigMap)).To(Succeed())
+			Expect(createdConfigMap.Labels).To(HaveKeyWithValue(key, value))
+		},
+		Entry("none", velero.PolicyTypeNone, "target"),
+		Entry("update", velero.PolicyTypeUpdate, "source"),
+	)
+
+	It("deletes capture and recover requests", func() {
+		capture(kubeobjects.Spec{})
+		recover(kubeobjects.RecoverSpec{})
+
+		Expect(manager.RecoverRequestsDelete(ctx, targetClient, requestNamespaceName, requestLabels)).To(Succeed())
+
+		requests, err := manager.RecoverRequestsGet(ctx, targetClient, requestNamespaceName, requestLabels)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(requests.Count()).To(BeZero())
+
+		requests, err = manager.ProtectRequestsGet(ctx, sourceClient, requestNamespaceName, requestLabels)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(requests.Count()).To(Equal(1))
+	})
+})
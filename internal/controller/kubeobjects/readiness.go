@@ -0,0 +1,184 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package kubeobjects
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+const (
+	readinessReplicasFieldDefault = "spec.replicas"
+	readinessReplicasDefault      = 1
+)
+
+// ReadinessRule determines whether objects of a kind are ready. A rule with neither a condition type nor a ready
+// replicas field determines readiness by the Ready or Available condition, if any.
+type ReadinessRule struct {
+	//+optional
+	Group string `json:"group,omitempty"`
+	Kind  string `json:"kind"`
+	//+optional
+	ConditionType string `json:"conditionType,omitempty"`
+	//+optional
+	ReadyReplicasField string `json:"readyReplicasField,omitempty"`
+	//+optional
+	ReplicasField string `json:"replicasField,omitempty"`
+	//+optional
+	Ignore bool `json:"ignore,omitempty"`
+}
+
+// ReadinessRulesDefault lists the rules of kinds whose readiness is not determined by the Ready or Available condition
+var ReadinessRulesDefault = []ReadinessRule{
+	{Group: "apps", Kind: "Deployment", ReadyReplicasField: "status.availableReplicas"},
+	{Group: "apps", Kind: "StatefulSet", ReadyReplicasField: "status.readyReplicas"},
+	{Group: "apps", Kind: "ReplicaSet", ReadyReplicasField: "status.readyReplicas"},
+	{
+		Group: "apps", Kind: "DaemonSet", ReadyReplicasField: "status.numberReady",
+		ReplicasField: "status.desiredNumberScheduled",
+	},
+}
+
+const virtualMachineGroup = "kubevirt.io"
+
+// ObjectReady returns whether an object is ready as per the first rule of its kind, or a default rule of its kind
+// if none, and if not, why not
+func ObjectReady(object *unstructured.Unstructured, rules []ReadinessRule) (bool, string, error) {
+	rule := readinessRule(object, rules)
+	if rule.Ignore {
+		return true, "", nil
+	}
+
+	if ready, reason := objectGenerationObserved(object); !ready {
+		return false, reason, nil
+	}
+
+	if rule.ReadyReplicasField != "" {
+		return objectReplicasReady(object, rule)
+	}
+
+	if rule.ConditionType != "" {
+		ready, reason := objectConditionTrue(object, rule.ConditionType, true)
+
+		return ready, reason, nil
+	}
+
+	if virtualMachineHalted(object) {
+		return true, "", nil
+	}
+
+	conditionType := "Ready"
+	if _, _, found := objectCondition(object, conditionType); !found {
+		conditionType = "Available"
+	}
+
+	ready, reason := objectConditionTrue(object, conditionType, false)
+
+	return ready, reason, nil
+}
+
+func readinessRule(object *unstructured.Unstructured, rules []ReadinessRule) ReadinessRule {
+	groupVersionKind := object.GroupVersionKind()
+
+	for _, rules := range [][]ReadinessRule{rules, ReadinessRulesDefault} {
+		for _, rule := range rules {
+			if rule.Group == groupVersionKind.Group && rule.Kind == groupVersionKind.Kind {
+				return rule
+			}
+		}
+	}
+
+	return ReadinessRule{Group: groupVersionKind.Group, Kind: groupVersionKind.Kind}
+}
+
+func objectGenerationObserved(object *unstructured.Unstructured) (bool, string) {
+	observedGeneration, found, err := unstructured.NestedInt64(object.Object, "status", "observedGeneration")
+	if err != nil || !found || observedGeneration >= object.GetGeneration() {
+		return true, ""
+	}
+
+	return false, fmt.Sprintf("generation %d not observed yet, observed %d", object.GetGeneration(),
+		observedGeneration)
+}
+
+func objectReplicasReady(object *unstructured.Unstructured, rule ReadinessRule) (bool, string, error) {
+	replicasField := rule.ReplicasField
+	if replicasField == "" {
+		replicasField = readinessReplicasFieldDefault
+	}
+
+	replicas, found, err := unstructured.NestedInt64(object.Object, strings.Split(replicasField, ".")...)
+	if err != nil {
+		return false, "", fmt.Errorf("%s field %s: %w", rule.Kind, replicasField, err)
+	}
+
+	if !found {
+		replicas = readinessReplicasDefault
+	}
+
+	readyReplicas, _, err := unstructured.NestedInt64(object.Object, strings.Split(rule.ReadyReplicasField, ".")...)
+	if err != nil {
+		return false, "", fmt.Errorf("%s field %s: %w", rule.Kind, rule.ReadyReplicasField, err)
+	}
+
+	if readyReplicas < replicas {
+		return false, fmt.Sprintf("%d of %d replicas ready", readyReplicas, replicas), nil
+	}
+
+	return true, "", nil
+}
+
+// objectCondition returns the status and message of the object's condition of a type, if found
+func objectCondition(object *unstructured.Unstructured, conditionType string) (string, string, bool) {
+	conditions, _, _ := unstructured.NestedSlice(object.Object, "status", "conditions")
+
+	for _, condition := range conditions {
+		condition, ok := condition.(map[string]interface{})
+		if !ok || condition["type"] != conditionType {
+			continue
+		}
+
+		status, _ := condition["status"].(string)
+		message, _ := condition["message"].(string)
+
+		return status, message, true
+	}
+
+	return "", "", false
+}
+
+// objectConditionTrue returns whether the object's condition is True, and if not, why not. A missing condition is
+// True unless required.
+func objectConditionTrue(object *unstructured.Unstructured, conditionType string, required bool) (bool, string) {
+	status, message, found := objectCondition(object, conditionType)
+
+	switch {
+	case !found && required:
+		return false, fmt.Sprintf("condition %s not found", conditionType)
+	case !found, status == "True":
+		return true, ""
+	default:
+		return false, fmt.Sprintf("condition %s is %s: %s", conditionType, status, message)
+	}
+}
+
+// virtualMachineHalted returns whether the object is a virtual machine that is not meant to run, and so is never
+// Ready
+func virtualMachineHalted(object *unstructured.Unstructured) bool {
+	groupVersionKind := object.GroupVersionKind()
+	if groupVersionKind.Group != virtualMachineGroup || groupVersionKind.Kind != "VirtualMachine" {
+		return false
+	}
+
+	running, found, _ := unstructured.NestedBool(object.Object, "spec", "running")
+	if found {
+		return !running
+	}
+
+	runStrategy, _, _ := unstructured.NestedString(object.Object, "spec", "runStrategy")
+
+	return runStrategy == "Halted"
+}
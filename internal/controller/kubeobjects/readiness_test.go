@@ -0,0 +1,96 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package kubeobjects_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/ramendr/ramen/internal/controller/kubeobjects"
+)
+
+var _ = Describe("Readiness", func() {
+	object := func(apiVersion, kind string, spec, status map[string]interface{}) *unstructured.Unstructured {
+		return &unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": apiVersion,
+			"kind":       kind,
+			"metadata":   map[string]interface{}{"name": "a", "namespace": "app", "generation": int64(2)},
+			"spec":       spec,
+			"status":     status,
+		}}
+	}
+
+	condition := func(conditionType, status string) map[string]interface{} {
+		return map[string]interface{}{"type": conditionType, "status": status, "message": "m"}
+	}
+
+	ready := func(object *unstructured.Unstructured, rules ...kubeobjects.ReadinessRule) bool {
+		ready, reason, err := kubeobjects.ObjectReady(object, rules)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(reason == "").To(Equal(ready))
+
+		return ready
+	}
+
+	It("determines the readiness of workloads by their replicas", func() {
+		Expect(ready(object("apps/v1", "Deployment", map[string]interface{}{"replicas": int64(3)},
+			map[string]interface{}{"observedGeneration": int64(2), "availableReplicas": int64(2)}))).To(BeFalse())
+		Expect(ready(object("apps/v1", "Deployment", map[string]interface{}{"replicas": int64(3)},
+			map[string]interface{}{"observedGeneration": int64(2), "availableReplicas": int64(3)}))).To(BeTrue())
+		Expect(ready(object("apps/v1", "StatefulSet", map[string]interface{}{},
+			map[string]interface{}{"observedGeneration": int64(2)}))).To(BeFalse())
+		Expect(ready(object("apps/v1", "StatefulSet", map[string]interface{}{"replicas": int64(0)},
+			map[string]interface{}{"observedGeneration": int64(2)}))).To(BeTrue())
+	})
+
+	It("determines objects whose generation is not observed yet to be not ready", func() {
+		Expect(ready(object("apps/v1", "Deployment", map[string]interface{}{"replicas": int64(1)},
+			map[string]interface{}{"observedGeneration": int64(1), "availableReplicas": int64(1)}))).To(BeFalse())
+	})
+
+	It("determines the readiness of other kinds by their Ready or Available condition, if any", func() {
+		Expect(ready(object("example.com/v1", "Database", nil, map[string]interface{}{
+			"conditions": []interface{}{condition("Available", "True"), condition("Ready", "False")},
+		}))).To(BeFalse())
+		Expect(ready(object("example.com/v1", "Database", nil, map[string]interface{}{
+			"conditions": []interface{}{condition("Available", "False")},
+		}))).To(BeFalse())
+		Expect(ready(object("example.com/v1", "Database", nil, map[string]interface{}{
+			"conditions": []interface{}{condition("Ready", "True")},
+		}))).To(BeTrue())
+		Expect(ready(object("v1", "ConfigMap", nil, nil))).To(BeTrue())
+	})
+
+	It("determines halted virtual machines to be ready", func() {
+		Expect(ready(object("kubevirt.io/v1", "VirtualMachine", map[string]interface{}{"runStrategy": "Always"},
+			map[string]interface{}{"conditions": []interface{}{condition("Ready", "False")}}))).To(BeFalse())
+		Expect(ready(object("kubevirt.io/v1", "VirtualMachine", map[string]interface{}{"runStrategy": "Halted"},
+			map[string]interface{}{"conditions": []interface{}{condition("Ready", "False")}}))).To(BeTrue())
+		Expect(ready(object("kubevirt.io/v1", "VirtualMachine", map[string]interface{}{"running": false},
+			map[string]interface{}{"conditions": []interface{}{condition("Ready", "False")}}))).To(BeTrue())
+	})
+
+	It("overrides the default with rules of kinds", func() {
+		database := object("example.com/v1", "Database", map[string]interface{}{"members": int64(3)},
+			map[string]interface{}{
+				"readyMembers": int64(2),
+				"conditions":   []interface{}{condition("Ready", "True")},
+			})
+		Expect(ready(database, kubeobjects.ReadinessRule{
+			Group: "example.com", Kind: "Database", ReadyReplicasField: "status.readyMembers",
+			ReplicasField: "spec.members",
+		})).To(BeFalse())
+		Expect(ready(database, kubeobjects.ReadinessRule{
+			Group: "example.com", Kind: "Database", ConditionType: "Synced",
+		})).To(BeFalse())
+		Expect(ready(database, kubeobjects.ReadinessRule{
+			Group: "example.com", Kind: "Database", ConditionType: "Ready",
+		})).To(BeTrue())
+
+		deployment := object("apps/v1", "Deployment", map[string]interface{}{"replicas": int64(3)}, nil)
+		Expect(ready(deployment, kubeobjects.ReadinessRule{Group: "apps", Kind: "Deployment", Ignore: true})).
+			To(BeTrue())
+	})
+})
@@ -43,6 +43,18 @@ func RequestsMapKeyedByName(requestsStruct Requests) map[string]Request {
 	return requests
 }
 
+// ExcludedResourcesDefault lists resources that are never captured:
+// exclude VRs from Backup so VRG can create them: see https://github.com/RamenDR/ramen/issues/884
+// exclude EndpointSlices/Endpoints to prevent Submariner conflicts: see https://github.com/RamenDR/ramen/issues/1889
+// exclude VolumeSnapshots and VolumeGroupSnapshots from backup
+var ExcludedResourcesDefault = []string{
+	"volumereplications.replication.storage.openshift.io",
+	"volumegroupreplications.replication.storage.openshift.io", "replicationsources.volsync.backube",
+	"replicationdestinations.volsync.backube", "PersistentVolumeClaims", "PersistentVolumes",
+	"endpointslices.discovery.k8s.io", "endpoints", "volumesnapshots.snapshot.storage.k8s.io",
+	"volumegroupsnapshots.groupsnapshot.storage.k8s.io",
+}
+
 type RequestProcessingError struct{ string }
 
 type CaptureSpec struct {
@@ -61,6 +73,19 @@ type RecoverSpec struct {
 	RestoreStatus *velero.RestoreStatusSpec `json:"restoreStatus,omitempty"`
 	//+optional
 	ExistingResourcePolicy velero.PolicyType `json:"existingResourcePolicy,omitempty"`
+	//+optional
+	Transforms []Transform `json:"transforms,omitempty"`
+	// WaitFor lists the conditions to wait for before the objects are recovered
+	//+optional
+	WaitFor []WaitFor `json:"waitFor,omitempty"`
+	// OrderByOwners recovers the kinds that own others before the kinds they own, and built-in kinds before custom
+	// ones. Only the native requests manager orders by owners.
+	//+optional
+	OrderByOwners bool `json:"orderByOwners,omitempty"`
+	// CustomResources selects only the objects of custom resources if true, or only those of built-in ones if false.
+	// Only the native requests manager selects by it.
+	//+optional
+	CustomResources *bool `json:"customResources,omitempty"`
 }
 
 type Spec struct {
@@ -73,6 +98,11 @@ type Spec struct {
 
 	//+optional
 	IncludeClusterResources *bool `json:"includeClusterResources,omitempty"`
+
+	// ClusterResources selects the cluster scoped objects that the selected objects depend on. Only the native
+	// requests manager captures and recovers them.
+	//+optional
+	ClusterResources *ClusterResourcesSpec `json:"clusterResources,omitempty"`
 }
 
 type KubeResourcesSpec struct {
@@ -163,6 +193,10 @@ func (RequestProcessingError) Is(target error) bool {
 type RequestsManager interface {
 	ProtectsPath() string
 	RecoversPath() string
+	// RecoveredObjectLabel returns the key and value of the label of the objects recovered by a recover request
+	RecoveredObjectLabel(recoverRequestName string) (string, string)
+	// CapturedObjectsKey returns the key, relative to the protects path, of the CapturedObjects of a capture
+	CapturedObjectsKey(protectRequestName string) string
 	ProtectRequestNew() ProtectRequest
 	RecoverRequestNew() RecoverRequest
 	ProtectRequestCreate(
@@ -204,3 +238,30 @@ type RequestsManager interface {
 	ProtectRequestsDelete(c context.Context, w client.Writer, requestNamespaceName string, labels map[string]string) error
 	RecoverRequestsDelete(c context.Context, w client.Writer, requestNamespaceName string, labels map[string]string) error
 }
+
+// ChangeCapture identifies a completed capture, as created by ProtectRequestCreate, that changes are captured to
+type ChangeCapture struct {
+	S3Url        string
+	S3BucketName string
+	S3KeyPrefix  string
+	CaptureName  string
+	Spec         Spec
+}
+
+// ChangeTracker captures the changes to the objects selected by captures as they happen, until stopped
+type ChangeTracker interface {
+	// ChangesTime returns the time up to which changes are captured, zero until the tracker is current
+	ChangesTime() metav1.Time
+	// Err returns the error that the tracker failed with, if any, after which changes are no longer captured
+	Err() error
+	Stop()
+}
+
+// ChangeTrackingRequestsManager is implemented by requests managers that capture changes incrementally
+type ChangeTrackingRequestsManager interface {
+	// ChangeTrackerStart starts capturing the changes to the objects of the captures, which started at the time
+	// specified. Changes made since then, before the tracker started, are captured too.
+	ChangeTrackerStart(
+		c context.Context, l logr.Logger, captures []ChangeCapture, since metav1.Time,
+	) (ChangeTracker, error)
+}
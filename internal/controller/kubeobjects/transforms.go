@@ -0,0 +1,142 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package kubeobjects
+
+import (
+	"encoding/json"
+	"fmt"
+
+	jsonpatch "github.com/evanphx/json-patch/v5"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/strategicpatch"
+	"k8s.io/client-go/kubernetes/scheme"
+)
+
+type TransformPatchType string
+
+const (
+	TransformJSONPatch           = TransformPatchType("JSONPatch")
+	TransformMergePatch          = TransformPatchType("MergePatch")
+	TransformStrategicMergePatch = TransformPatchType("StrategicMergePatch")
+)
+
+// Transform patches the recovered objects of a kind that match the label selector
+type Transform struct {
+	Name string `json:"name"`
+	//+optional
+	Group string `json:"group,omitempty"`
+	Kind  string `json:"kind"`
+	//+optional
+	LabelSelector *metav1.LabelSelector `json:"labelSelector,omitempty"`
+	PatchType     TransformPatchType    `json:"patchType"`
+	Patch         string                `json:"patch"`
+}
+
+func (t Transform) Matches(object *unstructured.Unstructured) (bool, error) {
+	groupVersionKind := object.GroupVersionKind()
+	if groupVersionKind.Group != t.Group || groupVersionKind.Kind != t.Kind {
+		return false, nil
+	}
+
+	if t.LabelSelector == nil {
+		return true, nil
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(t.LabelSelector)
+	if err != nil {
+		return false, fmt.Errorf("transform %s label selector: %w", t.Name, err)
+	}
+
+	return selector.Matches(labels.Set(object.GetLabels())), nil
+}
+
+// Validate returns an error if the patch cannot be decoded as per its type
+func (t Transform) Validate() error {
+	var err error
+
+	switch t.PatchType {
+	case TransformJSONPatch:
+		_, err = jsonpatch.DecodePatch([]byte(t.Patch))
+	case TransformMergePatch, TransformStrategicMergePatch:
+		patch := map[string]interface{}{}
+		err = json.Unmarshal([]byte(t.Patch), &patch)
+	default:
+		err = fmt.Errorf("patch type %q unsupported", t.PatchType)
+	}
+
+	if err != nil {
+		return fmt.Errorf("transform %s patch invalid: %w", t.Name, err)
+	}
+
+	return nil
+}
+
+// Apply patches the object. Strategic merge patches of kinds unknown to the client-go scheme, such as custom
+// resources, are applied as merge patches.
+func (t Transform) Apply(object *unstructured.Unstructured) error {
+	original, err := object.MarshalJSON()
+	if err != nil {
+		return fmt.Errorf("transform %s object marshal: %w", t.Name, err)
+	}
+
+	patched, err := t.patch(object, original)
+	if err != nil {
+		return fmt.Errorf("transform %s apply to %s %s/%s: %w", t.Name, object.GetKind(), object.GetNamespace(),
+			object.GetName(), err)
+	}
+
+	patchedObject := &unstructured.Unstructured{}
+	if err := patchedObject.UnmarshalJSON(patched); err != nil {
+		return fmt.Errorf("transform %s object unmarshal: %w", t.Name, err)
+	}
+
+	object.Object = patchedObject.Object
+
+	return nil
+}
+
+func (t Transform) patch(object *unstructured.Unstructured, original []byte) ([]byte, error) {
+	switch t.PatchType {
+	case TransformJSONPatch:
+		patch, err := jsonpatch.DecodePatch([]byte(t.Patch))
+		if err != nil {
+			return nil, err
+		}
+
+		return patch.Apply(original)
+	case TransformStrategicMergePatch:
+		dataStruct, err := scheme.Scheme.New(object.GroupVersionKind())
+		if err == nil {
+			return strategicpatch.StrategicMergePatch(original, []byte(t.Patch), dataStruct)
+		}
+
+		fallthrough
+	case TransformMergePatch:
+		return jsonpatch.MergePatch(original, []byte(t.Patch))
+	default:
+		return nil, fmt.Errorf("patch type %q unsupported", t.PatchType)
+	}
+}
+
+// TransformsApply applies the transforms that match the object, in order
+func TransformsApply(object *unstructured.Unstructured, transforms []Transform) error {
+	for _, transform := range transforms {
+		matches, err := transform.Matches(object)
+		if err != nil {
+			return err
+		}
+
+		if !matches {
+			continue
+		}
+
+		if err := transform.Apply(object); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,118 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package kubeobjects_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/ramendr/ramen/internal/controller/kubeobjects"
+)
+
+var _ = Describe("Transforms", func() {
+	var deployment *unstructured.Unstructured
+
+	BeforeEach(func() {
+		deployment = &unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "apps/v1",
+			"kind":       "Deployment",
+			"metadata": map[string]interface{}{
+				"name":      "web",
+				"namespace": "app",
+				"labels":    map[string]interface{}{"tier": "web"},
+			},
+			"spec": map[string]interface{}{
+				"replicas": int64(3),
+				"template": map[string]interface{}{
+					"spec": map[string]interface{}{
+						"containers": []interface{}{
+							map[string]interface{}{"name": "web", "image": "registry.east/web:1"},
+							map[string]interface{}{"name": "sidecar", "image": "registry.east/sidecar:1"},
+						},
+					},
+				},
+			},
+		}}
+	})
+
+	containers := func() []interface{} {
+		containers, _, err := unstructured.NestedSlice(deployment.Object, "spec", "template", "spec", "containers")
+		Expect(err).NotTo(HaveOccurred())
+
+		return containers
+	}
+
+	It("applies json patches", func() {
+		Expect(kubeobjects.TransformsApply(deployment, []kubeobjects.Transform{{
+			Name:      "replicas",
+			Group:     "apps",
+			Kind:      "Deployment",
+			PatchType: kubeobjects.TransformJSONPatch,
+			Patch:     `[{"op": "replace", "path": "/spec/replicas", "value": 1}]`,
+		}})).To(Succeed())
+		Expect(deployment.Object["spec"]).To(HaveKeyWithValue("replicas", int64(1)))
+	})
+
+	It("applies strategic merge patches to known kinds", func() {
+		Expect(kubeobjects.TransformsApply(deployment, []kubeobjects.Transform{{
+			Name:      "registry",
+			Group:     "apps",
+			Kind:      "Deployment",
+			PatchType: kubeobjects.TransformStrategicMergePatch,
+			Patch:     `{"spec": {"template": {"spec": {"containers": [{"name": "web", "image": "registry.west/web:1"}]}}}}`,
+		}})).To(Succeed())
+		Expect(containers()).To(HaveLen(2))
+		Expect(containers()[0]).To(HaveKeyWithValue("image", "registry.west/web:1"))
+	})
+
+	It("applies strategic merge patches to unknown kinds as merge patches", func() {
+		deployment.SetAPIVersion("example.com/v1")
+		Expect(kubeobjects.TransformsApply(deployment, []kubeobjects.Transform{{
+			Name:      "registry",
+			Group:     "example.com",
+			Kind:      "Deployment",
+			PatchType: kubeobjects.TransformStrategicMergePatch,
+			Patch:     `{"spec": {"template": {"spec": {"containers": [{"name": "web", "image": "registry.west/web:1"}]}}}}`,
+		}})).To(Succeed())
+		Expect(containers()).To(HaveLen(1))
+	})
+
+	It("skips objects of other kinds or labels", func() {
+		Expect(kubeobjects.TransformsApply(deployment, []kubeobjects.Transform{
+			{
+				Name:      "kind",
+				Kind:      "Deployment",
+				PatchType: kubeobjects.TransformMergePatch,
+				Patch:     `{"spec": {"replicas": 1}}`,
+			},
+			{
+				Name:          "labels",
+				Group:         "apps",
+				Kind:          "Deployment",
+				LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"tier": "db"}},
+				PatchType:     kubeobjects.TransformMergePatch,
+				Patch:         `{"spec": {"replicas": 2}}`,
+			},
+		})).To(Succeed())
+		Expect(deployment.Object["spec"]).To(HaveKeyWithValue("replicas", int64(3)))
+	})
+
+	DescribeTable("validates patches",
+		func(patchType kubeobjects.TransformPatchType, patch string, valid bool) {
+			err := kubeobjects.Transform{Name: "t", PatchType: patchType, Patch: patch}.Validate()
+			if valid {
+				Expect(err).NotTo(HaveOccurred())
+			} else {
+				Expect(err).To(HaveOccurred())
+			}
+		},
+		Entry("json patch", kubeobjects.TransformJSONPatch, `[{"op": "remove", "path": "/spec"}]`, true),
+		Entry("invalid json patch", kubeobjects.TransformJSONPatch, `{"spec": {}}`, false),
+		Entry("merge patch", kubeobjects.TransformMergePatch, `{"spec": {}}`, true),
+		Entry("invalid merge patch", kubeobjects.TransformMergePatch, `spec`, false),
+		Entry("unsupported patch type", kubeobjects.TransformPatchType("Unknown"), `{}`, false),
+	)
+})
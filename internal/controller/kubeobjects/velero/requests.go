@@ -17,6 +17,7 @@ import (
 
 	"github.com/go-logr/logr"
 	velero "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/label"
 	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -99,8 +100,19 @@ func (r RestoreRequests) Get(i int) kubeobjects.Request { return RestoreRequest{
 type RequestsManager struct{}
 
 func (RequestsManager) ProtectsPath() string { return protectsPath }
+
+// CapturedObjectsKey returns the key of a backup's resource list, which Velero uploads with the backup
+func (RequestsManager) CapturedObjectsKey(backupName string) string {
+	return backupName + "/" + backupName + "-resource-list.json.gz"
+}
 func (RequestsManager) RecoversPath() string { return recoversPath }
 
+// RecoveredObjectLabel returns the label of the objects recovered by a restore. Velero labels the objects it updates
+// as well as those it creates.
+func (RequestsManager) RecoveredObjectLabel(restoreName string) (string, string) {
+	return velero.RestoreNameLabel, label.GetValidName(restoreName)
+}
+
 func (RequestsManager) ProtectRequestNew() kubeobjects.ProtectRequest {
 	return BackupRequest{&velero.Backup{TypeMeta: backupTypeMeta()}}
 }
@@ -168,6 +180,13 @@ func (r RequestsManager) RecoverRequestsDelete(
 		return fmt.Errorf("restore requests delete: %w", err)
 	}
 
+	if err := writer.DeleteAllOf(ctx, &corev1.ConfigMap{},
+		client.InNamespace(requestNamespaceName),
+		client.MatchingLabels(labels),
+	); err != nil {
+		return fmt.Errorf("restore resource modifiers delete: %w", err)
+	}
+
 	return r.ProtectRequestsDelete(ctx, writer, requestNamespaceName, labels)
 }
 
@@ -307,6 +326,20 @@ func backupRestore(
 	labels map[string]string,
 ) (*velero.Restore, error) {
 	restore := restore(backup.Namespace, restoreName, recoverSpec, backup.Name, labels)
+
+	if len(recoverSpec.Transforms) > 0 {
+		configMap, err := w.resourceModifiersConfigMap(backup.Namespace, restoreName, recoverSpec.Transforms, labels)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := w.objectCreate(configMap); err != nil {
+			return nil, err
+		}
+
+		restore.Spec.ResourceModifier = &corev1.TypedLocalObjectReference{Kind: "ConfigMap", Name: configMap.Name}
+	}
+
 	if err := w.objectCreate(restore); err != nil {
 		return nil, err
 	}
@@ -426,16 +459,9 @@ func getBackupSpecFromObjectsSpec(objectsSpec kubeobjects.Spec) velero.BackupSpe
 	)
 
 	return velero.BackupSpec{
-		IncludedNamespaces: objectsSpec.IncludedNamespaces,
-		IncludedResources:  objectsSpec.IncludedResources,
-		// exclude VRs from Backup so VRG can create them: see https://github.com/RamenDR/ramen/issues/884
-		// exclude EndpointSlices/Endpoints to prevent Submariner conflicts: see https://github.com/RamenDR/ramen/issues/1889
-		// exclude VolumeSnapshots and VolumeGroupSnapshots from backup
-		ExcludedResources: append(objectsSpec.ExcludedResources, "volumereplications.replication.storage.openshift.io",
-			"volumegroupreplications.replication.storage.openshift.io", "replicationsources.volsync.backube",
-			"replicationdestinations.volsync.backube", "PersistentVolumeClaims", "PersistentVolumes",
-			"endpointslices.discovery.k8s.io", "endpoints", "volumesnapshots.snapshot.storage.k8s.io",
-			"volumegroupsnapshots.groupsnapshot.storage.k8s.io"),
+		IncludedNamespaces:      objectsSpec.IncludedNamespaces,
+		IncludedResources:       objectsSpec.IncludedResources,
+		ExcludedResources:       append(objectsSpec.ExcludedResources, kubeobjects.ExcludedResourcesDefault...),
 		LabelSelector:           newLabelSelector,
 		OrLabelSelectors:        objectsSpec.OrLabelSelectors,
 		TTL:                     metav1.Duration{}, // TODO: set default here
@@ -572,6 +598,12 @@ func (w objectWriter) restoreObjectsDelete(
 		return err
 	}
 
+	if err := w.objectDelete(&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{
+		Namespace: restore.Namespace, Name: restore.Name,
+	}}); err != nil {
+		return err
+	}
+
 	return w.backupObjectsDelete(backupLocation, backup)
 }
 
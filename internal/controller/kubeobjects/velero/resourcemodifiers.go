@@ -0,0 +1,144 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+// +kubebuilder:rbac:groups="",resources=configmaps,verbs=create;delete;deletecollection;get;list;watch
+
+package velero
+
+import (
+	"encoding/json"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/yaml"
+
+	"github.com/ramendr/ramen/internal/controller/kubeobjects"
+)
+
+// The following types mirror Velero's resource modifiers, which are internal to Velero. Velero applies them to the
+// objects it restores, as specified in a config map referenced by the restore.
+// See https://velero.io/docs/main/restore-resource-modifiers/
+
+const (
+	resourceModifiersVersion = "v1"
+	resourceModifiersDataKey = "resource-modifiers.yaml"
+)
+
+type resourceModifiers struct {
+	Version               string                 `json:"version"`
+	ResourceModifierRules []resourceModifierRule `json:"resourceModifierRules"`
+}
+
+type resourceModifierRule struct {
+	Conditions       resourceModifierConditions  `json:"conditions"`
+	Patches          []resourceModifierJSONPatch `json:"patches,omitempty"`
+	MergePatches     []resourceModifierPatchData `json:"mergePatches,omitempty"`
+	StrategicPatches []resourceModifierPatchData `json:"strategicPatches,omitempty"`
+}
+
+type resourceModifierConditions struct {
+	GroupResource string                `json:"groupResource"`
+	LabelSelector *metav1.LabelSelector `json:"labelSelector,omitempty"`
+}
+
+type resourceModifierJSONPatch struct {
+	Operation string `json:"operation"`
+	From      string `json:"from,omitempty"`
+	Path      string `json:"path"`
+	// Value is the JSON encoding of the value
+	Value string `json:"value,omitempty"`
+}
+
+type resourceModifierPatchData struct {
+	PatchData string `json:"patchData,omitempty"`
+}
+
+// resourceModifiersConfigMap returns a config map with resource modifier rules equivalent to the transforms
+func (w objectWriter) resourceModifiersConfigMap(
+	namespaceName, name string, transforms []kubeobjects.Transform, labels map[string]string,
+) (*corev1.ConfigMap, error) {
+	modifiers := resourceModifiers{Version: resourceModifiersVersion}
+
+	for _, transform := range transforms {
+		rule, err := w.resourceModifierRule(transform)
+		if err != nil {
+			return nil, err
+		}
+
+		modifiers.ResourceModifierRules = append(modifiers.ResourceModifierRules, rule)
+	}
+
+	data, err := yaml.Marshal(modifiers)
+	if err != nil {
+		return nil, fmt.Errorf("resource modifiers marshal: %w", err)
+	}
+
+	return &corev1.ConfigMap{
+		TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespaceName,
+			Name:      name,
+			Labels:    labels,
+		},
+		Data: map[string]string{resourceModifiersDataKey: string(data)},
+	}, nil
+}
+
+func (w objectWriter) resourceModifierRule(transform kubeobjects.Transform) (resourceModifierRule, error) {
+	mapping, err := w.RESTMapper().RESTMapping(schema.GroupKind{Group: transform.Group, Kind: transform.Kind})
+	if err != nil {
+		return resourceModifierRule{}, fmt.Errorf("transform %s resource: %w", transform.Name, err)
+	}
+
+	rule := resourceModifierRule{
+		Conditions: resourceModifierConditions{
+			GroupResource: mapping.Resource.GroupResource().String(),
+			LabelSelector: transform.LabelSelector,
+		},
+	}
+
+	switch transform.PatchType {
+	case kubeobjects.TransformJSONPatch:
+		rule.Patches, err = resourceModifierJSONPatches(transform.Patch)
+		if err != nil {
+			return resourceModifierRule{}, fmt.Errorf("transform %s patch: %w", transform.Name, err)
+		}
+	case kubeobjects.TransformMergePatch:
+		rule.MergePatches = []resourceModifierPatchData{{PatchData: transform.Patch}}
+	case kubeobjects.TransformStrategicMergePatch:
+		rule.StrategicPatches = []resourceModifierPatchData{{PatchData: transform.Patch}}
+	default:
+		return resourceModifierRule{}, fmt.Errorf("transform %s patch type %q unsupported", transform.Name,
+			transform.PatchType)
+	}
+
+	return rule, nil
+}
+
+func resourceModifierJSONPatches(patch string) ([]resourceModifierJSONPatch, error) {
+	operations := []struct {
+		Op    string          `json:"op"`
+		From  string          `json:"from,omitempty"`
+		Path  string          `json:"path"`
+		Value json.RawMessage `json:"value,omitempty"`
+	}{}
+
+	if err := json.Unmarshal([]byte(patch), &operations); err != nil {
+		return nil, err
+	}
+
+	patches := make([]resourceModifierJSONPatch, 0, len(operations))
+
+	for _, operation := range operations {
+		patches = append(patches, resourceModifierJSONPatch{
+			Operation: operation.Op,
+			From:      operation.From,
+			Path:      operation.Path,
+			Value:     string(operation.Value),
+		})
+	}
+
+	return patches, nil
+}
@@ -0,0 +1,270 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package kubeobjects
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// WaitForTimeoutDefault is how long a condition is waited for if its timeout is unset
+const WaitForTimeoutDefault = 5 * time.Minute
+
+// WaitFor is a condition to wait for before objects are recovered. Exactly one of CustomResourceDefinition,
+// CustomResourceDefinitionsRecovered, Deployment, Webhook and WebhookNamespaces is set.
+type WaitFor struct {
+	// CustomResourceDefinition is the name of a custom resource definition to be established
+	//+optional
+	CustomResourceDefinition string `json:"customResourceDefinition,omitempty"`
+	// CustomResourceDefinitionsRecovered waits for the custom resource definitions recovered before to be
+	// established. The caller resolves it to the definitions recovered before checking the conditions.
+	//+optional
+	CustomResourceDefinitionsRecovered bool `json:"customResourceDefinitionsRecovered,omitempty"`
+	// Deployment is a deployment to be available
+	//+optional
+	Deployment *types.NamespacedName `json:"deployment,omitempty"`
+	// Webhook is the name of a validating or mutating webhook configuration whose webhooks' services have ready
+	// endpoints
+	//+optional
+	Webhook string `json:"webhook,omitempty"`
+	// WebhookNamespaces are the namespaces whose services serve webhooks, of any validating or mutating webhook
+	// configuration, that are to have ready endpoints
+	//+optional
+	WebhookNamespaces []string `json:"webhookNamespaces,omitempty"`
+	//+optional
+	Timeout time.Duration `json:"timeout,omitempty"`
+}
+
+func (w WaitFor) String() string {
+	switch {
+	case w.CustomResourceDefinition != "":
+		return "custom resource definition " + w.CustomResourceDefinition
+	case w.CustomResourceDefinitionsRecovered:
+		return "custom resource definitions recovered"
+	case w.Deployment != nil:
+		return "deployment " + w.Deployment.String()
+	case len(w.WebhookNamespaces) > 0:
+		return "webhooks served in namespaces " + strings.Join(w.WebhookNamespaces, ", ")
+	default:
+		return "webhook configuration " + w.Webhook
+	}
+}
+
+func (w WaitFor) Validate() error {
+	set := 0
+
+	for _, isSet := range []bool{
+		w.CustomResourceDefinition != "", w.CustomResourceDefinitionsRecovered, w.Deployment != nil, w.Webhook != "",
+		len(w.WebhookNamespaces) > 0,
+	} {
+		if isSet {
+			set++
+		}
+	}
+
+	if set != 1 {
+		return fmt.Errorf("wait for condition sets %d of customResourceDefinition, "+
+			"customResourceDefinitionsRecovered, deployment, webhook and webhookNamespaces, instead of one", set)
+	}
+
+	return nil
+}
+
+// WaitForTimeout returns the longest timeout of the conditions
+func WaitForTimeout(waitFor []WaitFor) time.Duration {
+	var timeout time.Duration
+
+	for _, w := range waitFor {
+		timeout = max(timeout, w.timeout())
+	}
+
+	return timeout
+}
+
+func (w WaitFor) timeout() time.Duration {
+	if w.Timeout == 0 {
+		return WaitForTimeoutDefault
+	}
+
+	return w.Timeout
+}
+
+var customResourceDefinitionEstablished = []ReadinessRule{
+	{Group: "apiextensions.k8s.io", Kind: "CustomResourceDefinition", ConditionType: "Established"},
+}
+
+// WaitForUnmet returns the conditions that are not met, and why not
+func WaitForUnmet(ctx context.Context, reader client.Reader, waitFor []WaitFor) ([]string, error) {
+	unmet := []string{}
+
+	for _, w := range waitFor {
+		met, reason, err := w.met(ctx, reader)
+		if err != nil {
+			return nil, fmt.Errorf("%v: %w", w, err)
+		}
+
+		if !met {
+			unmet = append(unmet, fmt.Sprintf("%v: %s", w, reason))
+		}
+	}
+
+	return unmet, nil
+}
+
+func (w WaitFor) met(ctx context.Context, reader client.Reader) (bool, string, error) {
+	switch {
+	case w.CustomResourceDefinition != "":
+		return objectReadyGet(ctx, reader, "apiextensions.k8s.io/v1", "CustomResourceDefinition",
+			types.NamespacedName{Name: w.CustomResourceDefinition}, customResourceDefinitionEstablished)
+	case w.CustomResourceDefinitionsRecovered:
+		return false, "", errors.New("custom resource definitions recovered not resolved")
+	case w.Deployment != nil:
+		return objectReadyGet(ctx, reader, "apps/v1", "Deployment", *w.Deployment, nil)
+	case len(w.WebhookNamespaces) > 0:
+		return webhooksInNamespacesReady(ctx, reader, w.WebhookNamespaces)
+	default:
+		return webhookReady(ctx, reader, w.Webhook)
+	}
+}
+
+func objectReadyGet(ctx context.Context, reader client.Reader, apiVersion, kind string, key types.NamespacedName,
+	rules []ReadinessRule,
+) (bool, string, error) {
+	object := &unstructured.Unstructured{}
+	object.SetAPIVersion(apiVersion)
+	object.SetKind(kind)
+
+	if err := reader.Get(ctx, key, object); err != nil {
+		if k8serrors.IsNotFound(err) {
+			return false, "not found", nil
+		}
+
+		return false, "", err
+	}
+
+	return ObjectReady(object, rules)
+}
+
+// webhookReady returns whether the webhooks of the validating, or else mutating, webhook configuration of a name
+// are ready, i.e. their services, if any, have a ready endpoint
+func webhookReady(ctx context.Context, reader client.Reader, name string) (bool, string, error) {
+	configuration := &unstructured.Unstructured{}
+	configuration.SetAPIVersion("admissionregistration.k8s.io/v1")
+
+	for _, kind := range []string{"ValidatingWebhookConfiguration", "MutatingWebhookConfiguration"} {
+		configuration.SetKind(kind)
+
+		err := reader.Get(ctx, types.NamespacedName{Name: name}, configuration)
+		if err == nil {
+			return webhookServicesReady(ctx, reader, configuration, nil)
+		}
+
+		if !k8serrors.IsNotFound(err) {
+			return false, "", err
+		}
+	}
+
+	return false, "not found", nil
+}
+
+// webhooksInNamespacesReady returns whether the webhooks of the validating and mutating webhook configurations, that
+// services of the namespaces serve, are ready
+func webhooksInNamespacesReady(ctx context.Context, reader client.Reader, namespaceNames []string,
+) (bool, string, error) {
+	for _, kind := range []string{"ValidatingWebhookConfigurationList", "MutatingWebhookConfigurationList"} {
+		configurations := &unstructured.UnstructuredList{}
+		configurations.SetAPIVersion("admissionregistration.k8s.io/v1")
+		configurations.SetKind(kind)
+
+		if err := reader.List(ctx, configurations); err != nil {
+			return false, "", err
+		}
+
+		for i := range configurations.Items {
+			ready, reason, err := webhookServicesReady(ctx, reader, &configurations.Items[i], namespaceNames)
+			if err != nil || !ready {
+				return ready, reason, err
+			}
+		}
+	}
+
+	return true, "", nil
+}
+
+// webhookServicesReady returns whether the services of the webhooks of a configuration, of the namespaces if any,
+// have a ready endpoint
+func webhookServicesReady(ctx context.Context, reader client.Reader, configuration *unstructured.Unstructured,
+	namespaceNames []string,
+) (bool, string, error) {
+	webhooks, _, err := unstructured.NestedSlice(configuration.Object, "webhooks")
+	if err != nil {
+		return false, "", err
+	}
+
+	for _, webhook := range webhooks {
+		webhook, ok := webhook.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		service, found, _ := unstructured.NestedStringMap(webhook, "clientConfig", "service")
+		if !found || len(namespaceNames) > 0 && !slices.Contains(namespaceNames, service["namespace"]) {
+			continue
+		}
+
+		ready, err := serviceEndpointReady(ctx, reader, service["namespace"], service["name"])
+		if err != nil {
+			return false, "", err
+		}
+
+		if !ready {
+			return false, fmt.Sprintf("service %s/%s has no ready endpoint", service["namespace"], service["name"]),
+				nil
+		}
+	}
+
+	return true, "", nil
+}
+
+// serviceEndpointReady returns whether an endpoint slice of the service has a ready endpoint
+func serviceEndpointReady(ctx context.Context, reader client.Reader, namespaceName, name string) (bool, error) {
+	endpointSlices := &unstructured.UnstructuredList{}
+	endpointSlices.SetAPIVersion("discovery.k8s.io/v1")
+	endpointSlices.SetKind("EndpointSliceList")
+
+	if err := reader.List(ctx, endpointSlices, client.InNamespace(namespaceName),
+		client.MatchingLabels{"kubernetes.io/service-name": name},
+	); err != nil {
+		return false, err
+	}
+
+	for _, endpointSlice := range endpointSlices.Items {
+		endpoints, _, _ := unstructured.NestedSlice(endpointSlice.Object, "endpoints")
+
+		for _, endpoint := range endpoints {
+			endpoint, ok := endpoint.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			addresses, _, _ := unstructured.NestedStringSlice(endpoint, "addresses")
+			ready, found, _ := unstructured.NestedBool(endpoint, "conditions", "ready")
+
+			if len(addresses) > 0 && (!found || ready) {
+				return true, nil
+			}
+		}
+	}
+
+	return false, nil
+}
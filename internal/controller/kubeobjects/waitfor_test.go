@@ -0,0 +1,123 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package kubeobjects_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/ramendr/ramen/internal/controller/kubeobjects"
+)
+
+var _ = Describe("WaitFor", func() {
+	customResourceDefinition := func(established string) *unstructured.Unstructured {
+		return &unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "apiextensions.k8s.io/v1",
+			"kind":       "CustomResourceDefinition",
+			"metadata":   map[string]interface{}{"name": "databases.example.com"},
+			"status": map[string]interface{}{"conditions": []interface{}{
+				map[string]interface{}{"type": "Established", "status": established},
+			}},
+		}}
+	}
+
+	deployment := func(availableReplicas int32) *appsv1.Deployment {
+		return &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "operator", Name: "manager"},
+			Spec:       appsv1.DeploymentSpec{Replicas: ptr.To(int32(1))},
+			Status:     appsv1.DeploymentStatus{AvailableReplicas: availableReplicas},
+		}
+	}
+
+	webhookConfiguration := &admissionregistrationv1.ValidatingWebhookConfiguration{
+		ObjectMeta: metav1.ObjectMeta{Name: "databases"},
+		Webhooks: []admissionregistrationv1.ValidatingWebhook{{
+			Name: "databases.example.com",
+			ClientConfig: admissionregistrationv1.WebhookClientConfig{
+				Service: &admissionregistrationv1.ServiceReference{Namespace: "operator", Name: "webhook"},
+			},
+		}},
+	}
+
+	endpointSlice := func(ready bool) *discoveryv1.EndpointSlice {
+		return &discoveryv1.EndpointSlice{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "operator", Name: "webhook-1",
+				Labels: map[string]string{"kubernetes.io/service-name": "webhook"},
+			},
+			AddressType: discoveryv1.AddressTypeIPv4,
+			Endpoints: []discoveryv1.Endpoint{{
+				Addresses:  []string{"10.0.0.1"},
+				Conditions: discoveryv1.EndpointConditions{Ready: &ready},
+			}},
+		}
+	}
+
+	waitFor := []kubeobjects.WaitFor{
+		{CustomResourceDefinition: "databases.example.com"},
+		{Deployment: &types.NamespacedName{Namespace: "operator", Name: "manager"}},
+		{Webhook: "databases"},
+	}
+
+	unmet := func(objects ...client.Object) []string {
+		reader := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(objects...).Build()
+
+		unmet, err := kubeobjects.WaitForUnmet(context.TODO(), reader, waitFor)
+		Expect(err).NotTo(HaveOccurred())
+
+		return unmet
+	}
+
+	It("is not met until the objects waited for exist", func() {
+		Expect(unmet()).To(HaveExactElements(
+			"custom resource definition databases.example.com: not found",
+			"deployment operator/manager: not found",
+			"webhook configuration databases: not found",
+		))
+	})
+
+	It("is not met until the objects waited for are ready", func() {
+		Expect(unmet(customResourceDefinition("False"), deployment(0), webhookConfiguration, endpointSlice(false))).
+			To(HaveExactElements(
+				ContainSubstring("condition Established is False"),
+				"deployment operator/manager: 0 of 1 replicas ready",
+				"webhook configuration databases: service operator/webhook has no ready endpoint",
+			))
+	})
+
+	It("is met once the objects waited for are ready", func() {
+		Expect(unmet(customResourceDefinition("True"), deployment(1), webhookConfiguration, endpointSlice(true))).
+			To(BeEmpty())
+	})
+
+	It("waits for the webhooks served in the namespaces only", func() {
+		reader := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(
+			webhookConfiguration, endpointSlice(false)).Build()
+
+		unmet, err := kubeobjects.WaitForUnmet(context.TODO(), reader, []kubeobjects.WaitFor{
+			{WebhookNamespaces: []string{"operator"}}, {WebhookNamespaces: []string{"app"}},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(unmet).To(HaveExactElements(
+			"webhooks served in namespaces operator: service operator/webhook has no ready endpoint"))
+	})
+
+	It("sets exactly one condition", func() {
+		Expect(kubeobjects.WaitFor{}.Validate()).NotTo(Succeed())
+		Expect(kubeobjects.WaitFor{CustomResourceDefinition: "a", Webhook: "b"}.Validate()).NotTo(Succeed())
+		Expect(kubeobjects.WaitFor{Webhook: "b"}.Validate()).To(Succeed())
+	})
+})
@@ -22,6 +22,7 @@ const (
 	LastSyncTimestampSeconds = "last_sync_timestamp_seconds"
 	LastSyncDurationSeconds  = "last_sync_duration_seconds"
 	LastSyncDataBytes        = "last_sync_data_bytes"
+	SyncFailedAttemptBytes      = "sync_failed_attempt_bytes"
 	WorkloadProtectionStatus = "workload_protection_status"
 	CGEnabled                = "unsupported_consistency_grouping_enabled"
 )
@@ -46,6 +47,10 @@ type SyncDataBytesMetrics struct {
 	LastSyncDataBytes prometheus.Gauge
 }
 
+type SyncFailedAttemptBytesMetrics struct {
+	SyncFailedAttemptBytes prometheus.Gauge
+}
+
 type WorkloadProtectionMetrics struct {
 	WorkloadProtectionStatus prometheus.Gauge
 }
@@ -61,6 +66,7 @@ type SyncMetrics struct {
 	SyncTimeMetrics
 	SyncDurationMetrics
 	SyncDataBytesMetrics
+	SyncFailedAttemptBytesMetrics
 }
 
 const (
@@ -98,6 +104,13 @@ var (
 		SchedulingInterval, // Value from DRPolicy
 	}
 
+	syncFailedAttemptBytesMetricLabels = []string{
+		ObjType,            // Name of the type of the resource [drpc]
+		ObjName,            // Name of the resoure [drpc-name]
+		ObjNamespace,       // DRPC namespace name
+		SchedulingInterval, // Value from DRPolicy
+	}
+
 	workloadProtectionStatusLabels = []string{
 		ObjType,      // Name of the type of the resource [drpc]
 		ObjName,      // Name of the resoure [drpc-name]
@@ -153,6 +166,15 @@ var (
 		syncDataBytesMetricLabels,
 	)
 
+	syncFailedAttemptBytes = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name:      SyncFailedAttemptBytes,
+			Namespace: metricNamespace,
+			Help:      "Data transferred in bytes by the last failed data mover attempt of in-flight syncs",
+		},
+		syncFailedAttemptBytesMetricLabels,
+	)
+
 	workloadProtectionStatus = prometheus.NewGaugeVec(
 		prometheus.GaugeOpts{
 			Name:      WorkloadProtectionStatus,
@@ -257,6 +279,27 @@ func DeleteSyncDataBytesMetric(labels prometheus.Labels) bool {
 	return lastSyncDataBytes.Delete(labels)
 }
 
+// syncFailedAttemptBytes Metric reports the bytes transferred by the last failed data mover attempt, from
+// groupSyncProgress taken from DRPC status
+func SyncFailedAttemptBytesMetricLabels(drPolicy *rmn.DRPolicy, drpc *rmn.DRPlacementControl) prometheus.Labels {
+	return prometheus.Labels{
+		ObjType:            "DRPlacementControl",
+		ObjName:            drpc.Name,
+		ObjNamespace:       drpc.Namespace,
+		SchedulingInterval: drPolicy.Spec.SchedulingInterval,
+	}
+}
+
+func NewSyncFailedAttemptBytesMetric(labels prometheus.Labels) SyncFailedAttemptBytesMetrics {
+	return SyncFailedAttemptBytesMetrics{
+		SyncFailedAttemptBytes: syncFailedAttemptBytes.With(labels),
+	}
+}
+
+func DeleteSyncFailedAttemptBytesMetric(labels prometheus.Labels) bool {
+	return syncFailedAttemptBytes.Delete(labels)
+}
+
 // workloadProtectionStatus Metric reports information regarding workload protection condition from DRPC
 func WorkloadProtectionStatusLabels(drpc *rmn.DRPlacementControl) prometheus.Labels {
 	return prometheus.Labels{
@@ -319,6 +362,7 @@ func init() {
 	metrics.Registry.MustRegister(lastSyncTime)
 	metrics.Registry.MustRegister(lastSyncDuration)
 	metrics.Registry.MustRegister(lastSyncDataBytes)
+	metrics.Registry.MustRegister(syncFailedAttemptBytes)
 	metrics.Registry.MustRegister(workloadProtectionStatus)
 	metrics.Registry.MustRegister(cgEnabled)
 	metrics.Registry.MustRegister(invalidCIDRsDetected)
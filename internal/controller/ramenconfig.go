@@ -20,6 +20,7 @@ import (
 	"sigs.k8s.io/yaml"
 
 	ramendrv1alpha1 "github.com/ramendr/ramen/api/v1alpha1"
+	"github.com/ramendr/ramen/internal/controller/volsync"
 )
 
 const (
@@ -343,6 +344,32 @@ func cephFSCSIDriverNameOrDefault(ramenConfig *ramendrv1alpha1.RamenConfig) stri
 	return ramenConfig.VolSync.CephFSCSIDriverName
 }
 
+func kubeObjectProtectionProviderOrDefault(
+	ramenConfig *ramendrv1alpha1.RamenConfig,
+) ramendrv1alpha1.KubeObjectProtectionProvider {
+	if ramenConfig.KubeObjectProtection.Provider == "" {
+		return ramendrv1alpha1.KubeObjectProtectionProviderVelero
+	}
+
+	return ramenConfig.KubeObjectProtection.Provider
+}
+
+func volSyncPSKRotation(ramenConfig *ramendrv1alpha1.RamenConfig) volsync.PSKRotation {
+	return volsync.PSKRotation{
+		Interval: ramenConfig.VolSync.PSKRotationInterval.Duration,
+	}
+}
+
+func volSyncSecretPropagationOrDefault(
+	ramenConfig *ramendrv1alpha1.RamenConfig,
+) ramendrv1alpha1.VolSyncSecretPropagation {
+	if ramenConfig.VolSync.SecretPropagation == "" {
+		return ramendrv1alpha1.VolSyncSecretPropagationPolicy
+	}
+
+	return ramenConfig.VolSync.SecretPropagation
+}
+
 func volSyncDestinationCopyMethodOrDefault(ramenConfig *ramendrv1alpha1.RamenConfig) string {
 	if ramenConfig.VolSync.DestinationCopyMethod == "" {
 		return DefaultVolSyncCopyMethod
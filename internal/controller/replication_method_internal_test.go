@@ -0,0 +1,127 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	volrep "github.com/csi-addons/kubernetes-csi-addons/api/replication.storage/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	ramendrv1alpha1 "github.com/ramendr/ramen/api/v1alpha1"
+)
+
+var _ = Describe("ReplicationMethod", func() {
+	pvcWithLabels := func(pvcLabels map[string]string) *corev1.PersistentVolumeClaim {
+		return &corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{Name: "pvc", Namespace: "ns", Labels: pvcLabels},
+		}
+	}
+
+	Describe("replicationMethodOverride", func() {
+		v := &VRGInstance{instance: &ramendrv1alpha1.VolumeReplicationGroup{
+			Spec: ramendrv1alpha1.VolumeReplicationGroupSpec{
+				ReplicationMethodOverrides: []ramendrv1alpha1.ReplicationMethodOverride{
+					{
+						Selector: metav1.LabelSelector{MatchLabels: map[string]string{"tier": "db"}},
+						Method:   ramendrv1alpha1.ReplicationMethodVolGroupRep,
+					},
+					{
+						Selector: metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{
+							Key:      "tier",
+							Operator: metav1.LabelSelectorOpExists,
+						}}},
+						Method: ramendrv1alpha1.ReplicationMethodVolSync,
+					},
+				},
+			},
+		}}
+
+		DescribeTable("selects the method of the first matching override",
+			func(pvcLabels map[string]string, expected ramendrv1alpha1.ReplicationMethod) {
+				method, err := v.replicationMethodOverride(pvcWithLabels(pvcLabels))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(method).To(Equal(expected))
+			},
+			Entry("first override", map[string]string{"tier": "db"}, ramendrv1alpha1.ReplicationMethodVolGroupRep),
+			Entry("second override", map[string]string{"tier": "web"}, ramendrv1alpha1.ReplicationMethodVolSync),
+			Entry("no override", map[string]string{"app": "web"}, ramendrv1alpha1.ReplicationMethod("")),
+		)
+
+		It("fails on an invalid selector", func() {
+			v := &VRGInstance{instance: &ramendrv1alpha1.VolumeReplicationGroup{
+				Spec: ramendrv1alpha1.VolumeReplicationGroupSpec{
+					ReplicationMethodOverrides: []ramendrv1alpha1.ReplicationMethodOverride{{
+						Selector: metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{
+							Key:      "tier",
+							Operator: "Unknown",
+						}}},
+						Method: ramendrv1alpha1.ReplicationMethodVolRep,
+					}},
+				},
+			}}
+
+			_, err := v.replicationMethodOverride(pvcWithLabels(nil))
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("hasReplicationClassForOverride", func() {
+		storageClass := &storagev1.StorageClass{
+			ObjectMeta:  metav1.ObjectMeta{Name: "sc", Labels: map[string]string{StorageIDLabel: "sid"}},
+			Provisioner: "csi",
+		}
+
+		v := &VRGInstance{
+			replClassList: &volrep.VolumeReplicationClassList{Items: []volrep.VolumeReplicationClass{{
+				ObjectMeta: metav1.ObjectMeta{Name: "vrc", Labels: map[string]string{
+					StorageIDLabel: "sid", ReplicationIDLabel: "rid",
+				}},
+				Spec: volrep.VolumeReplicationClassSpec{Provisioner: "csi"},
+			}}},
+			grpReplClassList: &volrep.VolumeGroupReplicationClassList{},
+		}
+
+		DescribeTable("validates the override against the peer class",
+			func(peerClass *ramendrv1alpha1.PeerClass, grouping, expected bool) {
+				Expect(v.hasReplicationClassForOverride(peerClass, storageClass, grouping)).To(Equal(expected))
+			},
+			Entry("no peer class, matching provisioner", nil, false, true),
+			Entry("no peer class, no group class", nil, true, false),
+			Entry("matching replicationID",
+				&ramendrv1alpha1.PeerClass{ReplicationID: "rid", StorageID: []string{"sid"}}, false, true),
+			Entry("mismatched replicationID",
+				&ramendrv1alpha1.PeerClass{ReplicationID: "other", StorageID: []string{"sid"}}, false, false),
+			Entry("peer class without replicationID",
+				&ramendrv1alpha1.PeerClass{StorageID: []string{"sid"}}, false, false),
+			Entry("peer class without groupReplicationID",
+				&ramendrv1alpha1.PeerClass{ReplicationID: "rid", StorageID: []string{"sid"}}, true, false),
+		)
+	})
+
+	Describe("extractProtectedPVCReplicationMethods", func() {
+		It("maps protected PVCs to their replication method", func() {
+			vrg := &ramendrv1alpha1.VolumeReplicationGroup{
+				Status: ramendrv1alpha1.VolumeReplicationGroupStatus{
+					ProtectedPVCs: []ramendrv1alpha1.ProtectedPVC{
+						{Namespace: "ns1", Name: "pvc", ReplicationMethod: ramendrv1alpha1.ReplicationMethodVolRep},
+						{Namespace: "ns2", Name: "pvc", ReplicationMethod: ramendrv1alpha1.ReplicationMethodVolSync},
+						{Namespace: "ns2", Name: "unreported"},
+					},
+				},
+			}
+
+			Expect(extractProtectedPVCReplicationMethods(vrg)).To(Equal(map[string]ramendrv1alpha1.ReplicationMethod{
+				"ns1/pvc": ramendrv1alpha1.ReplicationMethodVolRep,
+				"ns2/pvc": ramendrv1alpha1.ReplicationMethodVolSync,
+			}))
+		})
+
+		It("reports nothing for a VRG without protected PVCs", func() {
+			Expect(extractProtectedPVCReplicationMethods(&ramendrv1alpha1.VolumeReplicationGroup{})).To(BeNil())
+		})
+	})
+})
@@ -88,6 +88,7 @@ func (r *ReplicationGroupDestinationReconciler) Reconcile(ctx context.Context, r
 			volsync.NewVSHandler(ctx, r.Client, logger, vrg,
 				&ramendrv1alpha1.VRGAsyncSpec{
 					VolumeSnapshotClassSelector: rgd.Spec.VolumeSnapshotClassSelector,
+					StorageClassMapping:         vrgStorageClassMappingOrNil(vrg),
 				}, defaultCephFSCSIDriverName, volSyncDestinationCopyMethodOrDefault(ramenConfig), adminNamespaceVRG,
 			),
 			logger,
@@ -105,6 +106,15 @@ func (r *ReplicationGroupDestinationReconciler) Reconcile(ctx context.Context, r
 	return result, err
 }
 
+// vrgStorageClassMappingOrNil returns the StorageClass mapping of the VRG, if it has one
+func vrgStorageClassMappingOrNil(vrg *ramendrv1alpha1.VolumeReplicationGroup) map[string]string {
+	if vrg == nil || vrg.Spec.Async == nil {
+		return nil
+	}
+
+	return vrg.Spec.Async.StorageClassMapping
+}
+
 // SetupWithManager sets up the controller with the Manager.
 func (r *ReplicationGroupDestinationReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	vsMapFun := handler.EnqueueRequestsFromMapFunc(handler.MapFunc(
@@ -0,0 +1,250 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	ramen "github.com/ramendr/ramen/api/v1alpha1"
+)
+
+func s3WriteQuorumOrDefault(quorum ramen.S3WriteQuorum) ramen.S3WriteQuorum {
+	if quorum == "" {
+		return ramen.S3WriteQuorumAll
+	}
+
+	return quorum
+}
+
+// s3WriteQuorumSize returns the number of s3 stores, of those given, that writes must succeed to
+func s3WriteQuorumSize(quorum ramen.S3WriteQuorum, total int) int {
+	switch s3WriteQuorumOrDefault(quorum) {
+	case ramen.S3WriteQuorumAny:
+		return min(1, total)
+	case ramen.S3WriteQuorumMajority:
+		return total/2 + 1
+	default:
+		return total
+	}
+}
+
+// s3Writes records the outcome of writing to each of a VRG's s3 stores, and whether the writes meet its quorum
+type s3Writes struct {
+	quorum    ramen.S3WriteQuorum
+	total     int
+	succeeded []string
+	failed    map[string]error
+}
+
+func newS3Writes(quorum ramen.S3WriteQuorum, total int) *s3Writes {
+	return &s3Writes{quorum: s3WriteQuorumOrDefault(quorum), total: total, failed: map[string]error{}}
+}
+
+// s3AccessorWritesNew returns the writes to the VRG's s3 stores, those inaccessible failed already
+func (v *VRGInstance) s3AccessorWritesNew() *s3Writes {
+	writes := newS3Writes(v.instance.Spec.S3WriteQuorum, len(v.s3StoreAccessors)+len(v.s3StoresInaccessible))
+
+	for s3ProfileName, err := range v.s3StoresInaccessible {
+		writes.fail(s3ProfileName, err)
+	}
+
+	return writes
+}
+
+func (w *s3Writes) succeed(s3ProfileName string) {
+	if !slices.Contains(w.succeeded, s3ProfileName) {
+		w.succeeded = append(w.succeeded, s3ProfileName)
+	}
+
+	delete(w.failed, s3ProfileName)
+}
+
+func (w *s3Writes) fail(s3ProfileName string, err error) {
+	w.succeeded = slices.DeleteFunc(w.succeeded, func(name string) bool { return name == s3ProfileName })
+	w.failed[s3ProfileName] = err
+}
+
+func (w *s3Writes) all() bool {
+	return len(w.succeeded) == w.total
+}
+
+func (w *s3Writes) quorumMet() bool {
+	return len(w.succeeded) >= s3WriteQuorumSize(w.quorum, w.total)
+}
+
+func (w *s3Writes) failedProfileNames() []string {
+	return slices.Sorted(maps.Keys(w.failed))
+}
+
+// err returns the errors of the failed writes, if any
+func (w *s3Writes) err() error {
+	errs := make([]error, 0, len(w.failed))
+
+	for _, name := range w.failedProfileNames() {
+		errs = append(errs, fmt.Errorf("%s: %w", name, w.failed[name]))
+	}
+
+	return errors.Join(errs...)
+}
+
+// String describes the writes, e.g. "2 of 3 S3 profiles [a b], Majority quorum of 2 met; failed c: error"
+func (w *s3Writes) String() string {
+	met := "met"
+	if !w.quorumMet() {
+		met = "not met"
+	}
+
+	description := fmt.Sprintf("%d of %d S3 profiles %v, %s quorum of %d %s", len(w.succeeded), w.total,
+		w.succeeded, w.quorum, s3WriteQuorumSize(w.quorum, w.total), met)
+
+	if len(w.failed) == 0 {
+		return description
+	}
+
+	failures := make([]string, 0, len(w.failed))
+	for _, name := range w.failedProfileNames() {
+		failures = append(failures, fmt.Sprintf("%s: %v", name, w.failed[name]))
+	}
+
+	return description + "; failed " + strings.Join(failures, "; ")
+}
+
+// s3WritesRecord records the outcome of writes to the VRG's s3 stores, of what is written, for its S3WriteQuorum
+// condition. Of several writes of the same kind of data, the one to the fewest stores is recorded.
+func (v *VRGInstance) s3WritesRecord(what string, writes *s3Writes) {
+	if v.s3Writes == nil {
+		v.s3Writes = map[string]*s3Writes{}
+	}
+
+	if recorded, ok := v.s3Writes[what]; ok && len(recorded.succeeded) <= len(writes.succeeded) {
+		return
+	}
+
+	v.s3Writes[what] = writes
+}
+
+// updateVRGS3WriteQuorumCondition sets the S3WriteQuorum condition per the writes to the VRG's s3 stores in this
+// reconcile, if any. Writes that are not to all of the stores are described.
+func (v *VRGInstance) updateVRGS3WriteQuorumCondition() {
+	if len(v.s3Writes) == 0 {
+		return
+	}
+
+	status, reason := metav1.ConditionTrue, VRGConditionReasonS3WrittenToAll
+	descriptions := []string{}
+
+	for _, what := range slices.Sorted(maps.Keys(v.s3Writes)) {
+		writes := v.s3Writes[what]
+		if writes.all() {
+			continue
+		}
+
+		descriptions = append(descriptions, fmt.Sprintf("%s written to %s", what, writes))
+
+		switch {
+		case !writes.quorumMet():
+			status, reason = metav1.ConditionFalse, VRGConditionReasonS3QuorumNotMet
+		case status == metav1.ConditionTrue:
+			reason = VRGConditionReasonS3QuorumMet
+		}
+	}
+
+	message := "Written to all S3 profiles"
+	if len(descriptions) > 0 {
+		message = strings.Join(descriptions, ". ")
+	}
+
+	setVRGS3WriteQuorumCondition(&v.instance.Status.Conditions, v.instance.Generation, status, reason, message)
+}
+
+// s3Source is a VRG's s3 store to restore from, how recent its copy of the VRG's cluster data is, and its copy of the
+// VRG, if any
+type s3Source struct {
+	s3StoreAccessor
+	time metav1.Time
+	vrg  *ramen.VolumeReplicationGroup
+}
+
+// s3SourcesOrder orders the s3 stores to restore from, the most recent first, keeping the preferred order of those
+// equally recent, and describes why stores other than the preferred one come first
+func s3SourcesOrder(sources []s3Source) string {
+	if len(sources) == 0 {
+		return ""
+	}
+
+	preferred := sources[0]
+
+	sort.SliceStable(sources, func(i, j int) bool {
+		return sources[i].time.After(sources[j].time.Time)
+	})
+
+	if sources[0].S3ProfileName == preferred.S3ProfileName {
+		return ""
+	}
+
+	return fmt.Sprintf("S3 profile %s copy of %s is older than S3 profile %s copy of %s",
+		preferred.S3ProfileName, preferred.time.UTC().Format(time.RFC3339),
+		sources[0].S3ProfileName, sources[0].time.UTC().Format(time.RFC3339))
+}
+
+// s3SourceMissingPVCs returns the PVCs protected using VolRep whose cluster data the passed in copy of the VRG reports
+// protected, but whose PV or PVC the passed in cluster data of its s3 store lacks. A store that missed an upload while
+// the others met the write quorum is not uploaded to again until the PVC changes, so it may lack them.
+func s3SourceMissingPVCs(vrg *ramen.VolumeReplicationGroup, pvs []corev1.PersistentVolume,
+	pvcs []corev1.PersistentVolumeClaim,
+) []string {
+	if vrg == nil {
+		return nil
+	}
+
+	pvClaims := map[string]bool{}
+
+	for i := range pvs {
+		if claimRef := pvs[i].Spec.ClaimRef; claimRef != nil {
+			pvClaims[claimRef.Namespace+"/"+claimRef.Name] = true
+		}
+	}
+
+	pvcNames := map[string]bool{}
+	for i := range pvcs {
+		pvcNames[pvcs[i].Namespace+"/"+pvcs[i].Name] = true
+	}
+
+	missing := []string{}
+
+	for i := range vrg.Status.ProtectedPVCs {
+		protectedPVC := &vrg.Status.ProtectedPVCs[i]
+		if protectedPVC.ProtectedByVolSync ||
+			!meta.IsStatusConditionTrue(protectedPVC.Conditions, VRGConditionTypeClusterDataProtected) {
+			continue
+		}
+
+		key := protectedPVC.Namespace + "/" + protectedPVC.Name
+		if !pvClaims[key] || !pvcNames[key] {
+			missing = append(missing, key)
+		}
+	}
+
+	return missing
+}
+
+// s3SourceMessage describes what is restored from which s3 store, and why from that one if not the preferred one
+func s3SourceMessage(what, s3ProfileName string, reasons []string) string {
+	message := fmt.Sprintf("%s from S3 profile %s", what, s3ProfileName)
+	if len(reasons) == 0 {
+		return message
+	}
+
+	return message + "; " + strings.Join(reasons, "; ")
+}
@@ -0,0 +1,119 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"errors"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	ramen "github.com/ramendr/ramen/api/v1alpha1"
+)
+
+var _ = Describe("S3 write quorum", func() {
+	DescribeTable("s3WriteQuorumSize",
+		func(quorum ramen.S3WriteQuorum, total, size int) {
+			Expect(s3WriteQuorumSize(quorum, total)).To(Equal(size))
+		},
+		Entry("defaults to all", ramen.S3WriteQuorum(""), 3, 3),
+		Entry("any", ramen.S3WriteQuorumAny, 3, 1),
+		Entry("any of none", ramen.S3WriteQuorumAny, 0, 0),
+		Entry("majority of an odd number", ramen.S3WriteQuorumMajority, 3, 2),
+		Entry("majority of an even number", ramen.S3WriteQuorumMajority, 4, 3),
+		Entry("all", ramen.S3WriteQuorumAll, 2, 2),
+	)
+
+	It("meets the quorum once enough writes succeed", func() {
+		writes := newS3Writes(ramen.S3WriteQuorumMajority, 3)
+		writes.fail("c", errors.New("unreachable"))
+		writes.succeed("a")
+		Expect(writes.quorumMet()).To(BeFalse())
+
+		writes.succeed("b")
+		Expect(writes.quorumMet()).To(BeTrue())
+		Expect(writes.all()).To(BeFalse())
+		Expect(writes.String()).To(Equal(
+			"2 of 3 S3 profiles [a b], Majority quorum of 2 met; failed c: unreachable"))
+
+		writes.succeed("c")
+		Expect(writes.all()).To(BeTrue())
+		Expect(writes.err()).To(BeNil())
+	})
+
+	It("orders s3 sources by the most recent copy, keeping the preferred order otherwise", func() {
+		now := time.Now()
+		source := func(s3ProfileName string, age time.Duration) s3Source {
+			source := s3Source{time: metav1.NewTime(now.Add(-age))}
+			source.S3ProfileName = s3ProfileName
+
+			return source
+		}
+		names := func(sources []s3Source) []string {
+			names := []string{}
+			for _, source := range sources {
+				names = append(names, source.S3ProfileName)
+			}
+
+			return names
+		}
+
+		sources := []s3Source{source("a", time.Minute), source("b", time.Minute), source("c", time.Hour)}
+		Expect(s3SourcesOrder(sources)).To(BeEmpty())
+		Expect(names(sources)).To(HaveExactElements("a", "b", "c"))
+
+		sources = []s3Source{source("a", time.Hour), source("b", time.Minute), source("c", time.Minute)}
+		Expect(s3SourcesOrder(sources)).To(ContainSubstring("S3 profile a copy of"))
+		Expect(names(sources)).To(HaveExactElements("b", "c", "a"))
+	})
+
+	It("reports the protected PVCs whose cluster data an s3 store lacks", func() {
+		protected := []metav1.Condition{{Type: VRGConditionTypeClusterDataProtected, Status: metav1.ConditionTrue}}
+		vrg := &ramen.VolumeReplicationGroup{Status: ramen.VolumeReplicationGroupStatus{
+			ProtectedPVCs: []ramen.ProtectedPVC{
+				{Namespace: "ns", Name: "a", Conditions: protected},
+				{Namespace: "ns", Name: "b", Conditions: protected},
+				{Namespace: "ns", Name: "c", Conditions: protected},
+				{Namespace: "ns", Name: "uploading"},
+				{Namespace: "ns", Name: "volsync", ProtectedByVolSync: true, Conditions: protected},
+			},
+		}}
+		pv := func(claimName string) corev1.PersistentVolume {
+			return corev1.PersistentVolume{Spec: corev1.PersistentVolumeSpec{
+				ClaimRef: &corev1.ObjectReference{Namespace: "ns", Name: claimName},
+			}}
+		}
+		pvc := func(name string) corev1.PersistentVolumeClaim {
+			return corev1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: name}}
+		}
+
+		Expect(s3SourceMissingPVCs(vrg, []corev1.PersistentVolume{pv("a"), pv("b")},
+			[]corev1.PersistentVolumeClaim{pvc("a"), pvc("c")})).To(HaveExactElements("ns/b", "ns/c"))
+		Expect(s3SourceMissingPVCs(vrg, []corev1.PersistentVolume{pv("a"), pv("b"), pv("c")},
+			[]corev1.PersistentVolumeClaim{pvc("a"), pvc("b"), pvc("c")})).To(BeEmpty())
+		Expect(s3SourceMissingPVCs(nil, nil, nil)).To(BeEmpty())
+	})
+
+	DescribeTable("s3StoreAccessorsGet skips the s3 stores if any is inaccessible, unless a write quorum is set",
+		func(quorum ramen.S3WriteQuorum, accessible, inaccessible int) {
+			accessors, errs := s3StoreAccessorsGet([]string{"a", "b"}, quorum,
+				func(s3ProfileName string) (ObjectStorer, ramen.S3StoreProfile, error) {
+					if s3ProfileName == "b" {
+						return nil, ramen.S3StoreProfile{}, errors.New("unreachable")
+					}
+
+					return nil, ramen.S3StoreProfile{S3ProfileName: s3ProfileName}, nil
+				}, logr.Discard())
+			Expect(accessors).To(HaveLen(accessible))
+			Expect(errs).To(HaveLen(inaccessible))
+		},
+		Entry("unset", ramen.S3WriteQuorum(""), 0, 0),
+		Entry("all", ramen.S3WriteQuorumAll, 1, 1),
+		Entry("any", ramen.S3WriteQuorumAny, 1, 1),
+	)
+})
@@ -16,12 +16,17 @@ type s3StoreAccessor struct {
 	ramen.S3StoreProfile
 }
 
+// s3StoreAccessorsGet returns the accessors of the s3 stores of the profiles, and the errors of those inaccessible.
+// Whether enough are accessible is up to their users, as per the write quorum, if set. If not, none are returned if
+// any is inaccessible, so that the s3 stores are skipped.
 func s3StoreAccessorsGet(
 	s3ProfileNames []string,
+	writeQuorum ramen.S3WriteQuorum,
 	objectStorerGet func(string) (ObjectStorer, ramen.S3StoreProfile, error),
 	log logr.Logger,
-) []s3StoreAccessor {
+) ([]s3StoreAccessor, map[string]error) {
 	s3StoreAccessors := make([]s3StoreAccessor, 0, len(s3ProfileNames))
+	inaccessible := map[string]error{}
 
 	for _, s3ProfileName := range s3ProfileNames {
 		if s3ProfileName == NoS3StoreAvailable {
@@ -34,7 +39,13 @@ func s3StoreAccessorsGet(
 		if err != nil {
 			log.Error(err, "Kube object protection store inaccessible", "name", s3ProfileName)
 
-			return nil
+			if writeQuorum == "" {
+				return nil, nil
+			}
+
+			inaccessible[s3ProfileName] = err
+
+			continue
 		}
 
 		if s3StoreProfile.VeleroNamespaceSecretKeyRef == nil {
@@ -52,5 +63,5 @@ func s3StoreAccessorsGet(
 		})
 	}
 
-	return s3StoreAccessors
+	return s3StoreAccessors, inaccessible
 }
@@ -36,6 +36,15 @@ const (
 	// Kube objects required for the app to be active in the cluster are ready.
 	VRGConditionTypeKubeObjectsReady = "KubeObjectsReady"
 
+	// Recovered kube objects are ready, as per the VRG's kube object recover
+	// readiness spec. This condition is only set when the spec is.
+	VRGConditionTypeKubeObjectsRecovered = "Recovered"
+
+	// Cluster data is written to a quorum of the VRG's S3 profiles, as per
+	// its S3 write quorum. This condition is only set when cluster data is
+	// written.
+	VRGConditionTypeS3WriteQuorum = "S3WriteQuorum"
+
 	// PV cluster data is protected.  This condition indicates whether an app,
 	// which is active in a cluster, has all its PV related cluster data
 	// protected from a disaster by uploading it to the required S3 store(s).
@@ -55,6 +64,10 @@ const (
 	// Indicates no conflict in PVC and Kubernetes resource data
 	// between primary and secondary clusters.
 	VRGConditionTypeNoClusterDataConflict = "NoClusterDataConflict"
+
+	// PVCs whose volumes were primary when the VRG was demoted after a failover, and may hold writes that were not
+	// replicated. True until their diverged data is discarded. This condition is only set when such PVCs are found.
+	VRGConditionTypeSplitBrain = "SplitBrain"
 )
 
 // VRG condition reasons
@@ -83,6 +96,7 @@ const (
 	VRGConditionReasonClusterDataAnnotationFailed = "AnnotationFailed"
 	VRGConditionReasonPeerClassNotFound           = "PeerClassNotFound"
 	VRGConditionReasonStorageIDNotFound           = "StorageIDNotFound"
+	VRGConditionReasonUnmountedPVCNotSnapshotable = "UnmountedPVCNotSnapshotable"
 	// Indicates a conflict in cluster data detected on the primary cluster.
 	VRGConditionReasonClusterDataConflictPrimary = "ClusterDataConflictPrimary"
 
@@ -92,9 +106,23 @@ const (
 	// Indicates no conflict in cluster data detected on both the primary and secondary cluster.
 	VRGConditionReasonNoConflictDetected = "NoConflictDetected"
 
+	VRGConditionReasonKubeObjectsWaiting    = "Waiting"
+	VRGConditionReasonKubeObjectsNotReady   = "NotReady"
+	VRGConditionReasonKubeObjectsRetrying   = "Retrying"
+	VRGConditionReasonKubeObjectsRolledBack = "RolledBack"
+	VRGConditionReasonKubeObjectsUnchecked  = "Unchecked"
+
+	VRGConditionReasonS3WrittenToAll = "WrittenToAll"
+	VRGConditionReasonS3QuorumMet    = "QuorumMet"
+	VRGConditionReasonS3QuorumNotMet = "QuorumNotMet"
+
 	VRGConditionReasonAutoCleanupProgressing = "Progressing"
 	VRGConditionReasonAutoCleanupNotFeasible = "NotFeasible"
 	VRGConditionReasonAutoCleanupCompleted   = "Completed"
+
+	VRGConditionReasonSplitBrainAcknowledgementRequired = "AcknowledgementRequired"
+	VRGConditionReasonSplitBrainDetected                = "Detected"
+	VRGConditionReasonSplitBrainResolved                = "Resolved"
 )
 
 const (
@@ -463,6 +491,32 @@ func setVRGKubeObjectsErrorCondition(conditions *[]metav1.Condition, observedGen
 	})
 }
 
+// sets the condition of the readiness of recovered kube objects
+func setVRGKubeObjectsRecoveredCondition(conditions *[]metav1.Condition, observedGeneration int64,
+	status metav1.ConditionStatus, reason, message string,
+) {
+	util.SetStatusCondition(conditions, metav1.Condition{
+		Type:               VRGConditionTypeKubeObjectsRecovered,
+		Reason:             reason,
+		ObservedGeneration: observedGeneration,
+		Status:             status,
+		Message:            message,
+	})
+}
+
+// sets the condition of whether cluster data is written to a quorum of the S3 profiles
+func setVRGS3WriteQuorumCondition(conditions *[]metav1.Condition, observedGeneration int64,
+	status metav1.ConditionStatus, reason, message string,
+) {
+	util.SetStatusCondition(conditions, metav1.Condition{
+		Type:               VRGConditionTypeS3WriteQuorum,
+		Reason:             reason,
+		ObservedGeneration: observedGeneration,
+		Status:             status,
+		Message:            message,
+	})
+}
+
 // sets conditions when Primary VolSync has finished setting up the Replication Source
 func setVRGConditionTypeVolSyncRepSourceSetupComplete(conditions *[]metav1.Condition, observedGeneration int64,
 	message string,
@@ -489,6 +543,19 @@ func setVRGConditionTypeVolSyncRepSourceSetupError(conditions *[]metav1.Conditio
 	})
 }
 
+// sets conditions when Primary cannot initialize the Replication Source for an unmounted PVC without mounting it
+func setVRGConditionTypeVolSyncRepSourceSetupUnmountedPVCNotSnapshotable(conditions *[]metav1.Condition,
+	observedGeneration int64, message string,
+) {
+	util.SetStatusCondition(conditions, metav1.Condition{
+		Type:               VRGConditionTypeVolSyncRepSourceSetup,
+		Reason:             VRGConditionReasonUnmountedPVCNotSnapshotable,
+		ObservedGeneration: observedGeneration,
+		Status:             metav1.ConditionFalse,
+		Message:            message,
+	})
+}
+
 // sets conditions when Primary VolSync has finished setting up the Replication Destination
 func setVRGConditionTypeVolSyncPVRestoreComplete(conditions *[]metav1.Condition, observedGeneration int64,
 	message string,
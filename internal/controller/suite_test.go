@@ -361,6 +361,7 @@ var _ = BeforeSuite(func() {
 		},
 		ObjectStoreGetter: fakeObjectStoreGetter{},
 		RateLimiter:       &rateLimiter,
+		FencingProviders:  map[string]ramencontrollers.FencingProvider{fakeFencingProviderName: fakeFencingProvider{}},
 	}).SetupWithManager(k8sManager)).To(Succeed())
 
 	Expect((&ramencontrollers.DRPolicyReconciler{
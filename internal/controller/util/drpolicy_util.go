@@ -91,9 +91,12 @@ func DRPolicyS3Profiles(drpolicy *rmn.DRPolicy, drclusters []rmn.DRCluster) sets
 	return mustHaveS3Profiles
 }
 
-//nolint:mnd
 func GetSecondsFromSchedulingInterval(drpolicy *rmn.DRPolicy) (float64, error) {
-	schedulingInterval := drpolicy.Spec.SchedulingInterval
+	return SchedulingIntervalSeconds(drpolicy.Spec.SchedulingInterval)
+}
+
+//nolint:mnd
+func SchedulingIntervalSeconds(schedulingInterval string) (float64, error) {
 	if schedulingInterval == "" {
 		return 0, nil
 	}
@@ -118,3 +121,87 @@ func GetSecondsFromSchedulingInterval(drpolicy *rmn.DRPolicy) (float64, error) {
 func DrpolicyContainsDrcluster(drpolicy *rmn.DRPolicy, drcluster string) bool {
 	return slices.Contains(DRPolicyClusterNames(drpolicy), drcluster)
 }
+
+// FindDRPolicyPeer returns the peer from the passed in peers that declares the pair of clusters clusterA and
+// clusterB, in any order, or nil if the pair is not declared
+func FindDRPolicyPeer(peers []rmn.DRPolicyPeer, clusterA, clusterB string) *rmn.DRPolicyPeer {
+	if clusterA == clusterB {
+		return nil
+	}
+
+	for idx := range peers {
+		if slices.Contains(peers[idx].Clusters, clusterA) && slices.Contains(peers[idx].Clusters, clusterB) {
+			return &peers[idx]
+		}
+	}
+
+	return nil
+}
+
+// DRPolicyPeerSchedulingInterval returns the scheduling interval for replication between the pair of clusters
+// clusterA and clusterB, which is the interval of the pair if declared in the policy peers, or the policy interval
+func DRPolicyPeerSchedulingInterval(drpolicy *rmn.DRPolicy, clusterA, clusterB string) string {
+	peer := FindDRPolicyPeer(drpolicy.Spec.Peers, clusterA, clusterB)
+	if peer == nil {
+		return drpolicy.Spec.SchedulingInterval
+	}
+
+	return peer.SchedulingInterval
+}
+
+// DRPolicyClusterSchedulingIntervals returns the distinct non-empty scheduling intervals for replication between
+// the passed in cluster and each of its peers in the policy
+func DRPolicyClusterSchedulingIntervals(drpolicy *rmn.DRPolicy, cluster string) []string {
+	schedulingIntervals := []string{}
+
+	if !DrpolicyContainsDrcluster(drpolicy, cluster) {
+		return schedulingIntervals
+	}
+
+	for _, peerCluster := range DRPolicyClusterNames(drpolicy) {
+		if peerCluster == cluster {
+			continue
+		}
+
+		schedulingInterval := DRPolicyPeerSchedulingInterval(drpolicy, cluster, peerCluster)
+		if schedulingInterval == "" || slices.Contains(schedulingIntervals, schedulingInterval) {
+			continue
+		}
+
+		schedulingIntervals = append(schedulingIntervals, schedulingInterval)
+	}
+
+	return schedulingIntervals
+}
+
+// DRPolicyClusterSchedulingInterval returns the shortest scheduling interval for replication between the passed
+// in cluster and its peers in the policy, as a cluster replicates its volumes to all its peers at the same
+// interval, or the policy interval if the cluster has no asynchronous peers
+func DRPolicyClusterSchedulingInterval(drpolicy *rmn.DRPolicy, cluster string) string {
+	shortest := drpolicy.Spec.SchedulingInterval
+	shortestSeconds := float64(-1)
+
+	for _, schedulingInterval := range DRPolicyClusterSchedulingIntervals(drpolicy, cluster) {
+		seconds, err := SchedulingIntervalSeconds(schedulingInterval)
+		if err != nil {
+			continue
+		}
+
+		if shortestSeconds < 0 || seconds < shortestSeconds {
+			shortest, shortestSeconds = schedulingInterval, seconds
+		}
+	}
+
+	return shortest
+}
+
+// DRPolicySchedulingTier returns the scheduling tier of the policy with the passed in name, or nil if not found
+func DRPolicySchedulingTier(drpolicy *rmn.DRPolicy, name string) *rmn.SchedulingTier {
+	for idx := range drpolicy.Spec.SchedulingTiers {
+		if drpolicy.Spec.SchedulingTiers[idx].Name == name {
+			return &drpolicy.Spec.SchedulingTiers[idx]
+		}
+	}
+
+	return nil
+}
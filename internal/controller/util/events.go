@@ -44,6 +44,10 @@ const (
 	// EventReasonVrgUploadFailed is used when VRG fails to upload VRG object
 	EventReasonVrgUploadFailed = "VrgUploadFailed"
 
+	// EventReasonSplitBrainDetected is used when VRG finds PVCs whose volumes may hold writes that were not
+	// replicated, as it is demoted after a failover
+	EventReasonSplitBrainDetected = "SplitBrainDetected"
+
 	// EventReasonPrimarySuccess is an event generated when VRG is successfully
 	// processed as Primary.
 	EventReasonPrimarySuccess = "PrimaryVRGProcessSuccess"
@@ -55,6 +59,20 @@ const (
 	// EventReasonSecondarySuccess is an event generated when VRG is successfully
 	// processed as Primary.
 	EventReasonDeleteSuccess = "VRGDeleteSuccess"
+
+	// EventReasonKubeObjectsRemoved is generated when a kube objects capture does not capture objects that the
+	// previous capture captured
+	EventReasonKubeObjectsRemoved = "KubeObjectsRemovedFromCapture"
+
+	// EventReasonKubeObjectsNotReady is generated when recovered kube objects are not ready in time
+	EventReasonKubeObjectsNotReady = "KubeObjectsNotReady"
+
+	// EventReasonKubeObjectsConflict is generated when recovered cluster scoped kube objects exist already and differ
+	EventReasonKubeObjectsConflict = "KubeObjectsConflict"
+
+	// EventReasonKubeObjectsRecoverWaitTimeout is generated when the conditions that the recovery of a group of kube
+	// objects waits for are not met in time
+	EventReasonKubeObjectsRecoverWaitTimeout = "KubeObjectsRecoverWaitTimedOut"
 	// TODO: Add any additional events (or remove one of existing ones above) if necessary.
 
 	// Events for DRPC Reconciler
@@ -90,6 +108,20 @@ const (
 	// EventReasonSwitchFailed is generated when DRPC fails to switch the cluster
 	// where the app is placed
 	EventReasonSwitchFailed = "DRPCClusterSwitchFailed"
+
+	// EventReasonVolSyncPSKUpdated is generated when the VolSync pre-shared key for a DRPC is rotated, or the
+	// previous key is dropped at the end of the rotation overlap period
+	EventReasonVolSyncPSKUpdated = "VolSyncPSKUpdated"
+
+	// EventReasonDRPolicyMigrating is generated when DRPC starts migrating a workload to another DRPolicy
+	EventReasonDRPolicyMigrating = "DRPCDRPolicyMigrating"
+
+	// EventReasonDRPolicyMigrationSuccess is generated when DRPC completes migrating a workload to another DRPolicy
+	EventReasonDRPolicyMigrationSuccess = "DRPCDRPolicyMigrationSuccess"
+
+	// EventReasonFailoverClusterSelected is generated when DRPC selects the cluster to failover to, as the failover
+	// cluster is not specified
+	EventReasonFailoverClusterSelected = "DRPCFailoverClusterSelected"
 )
 
 // EventReporter is custom events reporter type which allows user to limit the events
@@ -53,6 +53,7 @@ const (
 	MWTypeVRClass   string = "vrc"
 	MWTypeVGRClass  string = "vgrc"
 	MWTypeDRCConfig string = "drcconfig"
+	MWTypeVSSecret  string = "vssecret"
 )
 
 type MWUtil struct {
@@ -289,6 +290,35 @@ func (mwu *MWUtil) generateNFManifest(nf csiaddonsv1alpha1.NetworkFence) (*ocmwo
 	return mwu.GenerateManifest(nf)
 }
 
+// Secret MW creation, the ManifestWork is named after the MWUtil instance and target namespace
+func (mwu *MWUtil) CreateOrUpdateSecretManifestWork(cluster, mwType string, secret corev1.Secret,
+	annotations map[string]string,
+) error {
+	secretManifest, err := mwu.GenerateManifest(secret)
+	if err != nil {
+		mwu.Log.Error(err, "failed to generate Secret manifest")
+
+		return err
+	}
+
+	manifestWork := mwu.newManifestWork(
+		mwu.BuildManifestWorkName(mwType),
+		cluster,
+		map[string]string{},
+		[]ocmworkv1.Manifest{*secretManifest},
+		annotations)
+
+	// Orphan the secret, so that deleting the ManifestWork, as when changing the propagation mechanism, does not
+	// delete a secret that replication still uses
+	manifestWork.Spec.DeleteOption = &ocmworkv1.DeleteOption{
+		PropagationPolicy: ocmworkv1.DeletePropagationPolicyTypeOrphan,
+	}
+
+	_, err = mwu.createOrUpdateManifestWork(manifestWork, cluster)
+
+	return err
+}
+
 // DRClusterConfig ManifestWork creation
 func (mwu *MWUtil) CreateOrUpdateDRCConfigManifestWork(cluster string, cConfig rmn.DRClusterConfig) error {
 	manifestWork, err := mwu.generateDRCConfigManifestWork(cluster, cConfig)
@@ -659,6 +689,38 @@ func (mwu *MWUtil) DeleteNamespaceManifestWork(clusterName string, annotations m
 	return nil
 }
 
+// DeleteManifestWorkOrphaningResources deletes the ManifestWork, if it exists, after setting its delete option to
+// orphan the resources it applied on the managed cluster
+func (mwu *MWUtil) DeleteManifestWorkOrphaningResources(mwName, mwNamespace string) error {
+	mw := &ocmworkv1.ManifestWork{}
+
+	err := mwu.Client.Get(mwu.Ctx, types.NamespacedName{Name: mwName, Namespace: mwNamespace}, mw)
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil
+		}
+
+		return fmt.Errorf("failed to retrieve manifestwork for type: %s. Error: %w", mwName, err)
+	}
+
+	if ResourceIsDeleted(mw) {
+		return nil
+	}
+
+	if mw.Spec.DeleteOption == nil ||
+		mw.Spec.DeleteOption.PropagationPolicy != ocmworkv1.DeletePropagationPolicyTypeOrphan {
+		mw.Spec.DeleteOption = &ocmworkv1.DeleteOption{
+			PropagationPolicy: ocmworkv1.DeletePropagationPolicyTypeOrphan,
+		}
+
+		if err := mwu.Client.Update(mwu.Ctx, mw); err != nil {
+			return fmt.Errorf("failed to set orphan delete option of manifestwork %s: %w", mwName, err)
+		}
+	}
+
+	return mwu.DeleteManifestWork(mwName, mwNamespace)
+}
+
 func (mwu *MWUtil) DeleteManifestWork(mwName, mwNamespace string) error {
 	mwu.Log.Info("Delete ManifestWork from", "namespace", mwNamespace, "name", mwName)
 
@@ -7,12 +7,18 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"reflect"
+	"time"
 
 	"github.com/go-logr/logr"
 	plrulev1 "github.com/stolostron/multicloud-operators-placementrule/pkg/apis/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ocmworkv1 "open-cluster-management.io/api/work/v1"
 	cfgpolicyv1 "open-cluster-management.io/config-policy-controller/api/v1"
 	policyv1 "open-cluster-management.io/governance-policy-propagator/api/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -62,6 +68,130 @@ func CleanupSecretPropagation(ctx context.Context, k8sClient client.Client,
 	return sp.cleanup()
 }
 
+// PropagateSecretToClustersUsingManifestWork propagates the source secret on the hub to destClusters using a
+// ManifestWork per cluster, for clusters where the governance policy framework is not available.
+// Should be run from a hub, as an alternative to PropagateSecretToClusters
+func PropagateSecretToClustersUsingManifestWork(mwu *util.MWUtil, sourceSecret *corev1.Secret,
+	destClusters []string, destSecretName, destSecretNamespace string, annotations map[string]string,
+) error {
+	secret := corev1.Secret{
+		TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "Secret"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      destSecretName,
+			Namespace: destSecretNamespace,
+			Labels:    map[string]string{util.CreatedByRamenLabel: "true"},
+		},
+		Type: corev1.SecretTypeOpaque,
+		Data: sourceSecret.Data,
+	}
+
+	for _, cluster := range destClusters {
+		if err := mwu.CreateOrUpdateSecretManifestWork(cluster, util.MWTypeVSSecret, secret, annotations); err != nil {
+			return fmt.Errorf("error propagating secret to cluster %s using ManifestWork (%w)", cluster, err)
+		}
+	}
+
+	return nil
+}
+
+// Cleans up the ManifestWorks used to propagate the volsync secret to clusters (if they exist), orphaning the
+// propagated secrets, which replication continues to use until the other mechanism propagates them
+func CleanupSecretPropagationManifestWork(mwu *util.MWUtil, clusters []string) error {
+	for _, cluster := range clusters {
+		err := mwu.DeleteManifestWorkOrphaningResources(mwu.BuildManifestWorkName(util.MWTypeVSSecret), cluster)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SecretPropagatedToClustersUsingManifestWork returns true if the ManifestWorks propagating the source secret on the
+// hub hold its current data, and are applied at their current generation, on all destClusters
+func SecretPropagatedToClustersUsingManifestWork(mwu *util.MWUtil, sourceSecret *corev1.Secret,
+	destClusters []string,
+) (bool, error) {
+	for _, cluster := range destClusters {
+		mw, err := mwu.FindManifestWorkByType(util.MWTypeVSSecret, cluster)
+		if err != nil {
+			if k8serrors.IsNotFound(err) {
+				return false, nil
+			}
+
+			return false, err
+		}
+
+		secret := &corev1.Secret{}
+
+		err = util.ExtractResourceFromManifestWork(mw, secret, corev1.SchemeGroupVersion.WithKind("Secret"))
+		if err != nil {
+			return false, err
+		}
+
+		if !reflect.DeepEqual(secret.Data, sourceSecret.Data) {
+			return false, nil
+		}
+
+		applied := meta.FindStatusCondition(mw.Status.Conditions, ocmworkv1.WorkApplied)
+		if applied == nil || applied.Status != metav1.ConditionTrue || applied.ObservedGeneration != mw.Generation {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// SecretPropagatedToClusters returns true if the policies replicated to all destClusters, to propagate the secret of
+// the owner, report compliance as of the passed in time the secret was last updated, or later
+func SecretPropagatedToClusters(ctx context.Context, k8sClient client.Client, ownerObject metav1.Object,
+	destClusters []string, updatedAt time.Time, log logr.Logger,
+) (bool, error) {
+	sp := newSecretPropagator(ctx, k8sClient, nil, ownerObject, nil, "", "", log)
+
+	for _, cluster := range destClusters {
+		policy := &policyv1.Policy{}
+
+		// Policies are replicated to the namespace of each cluster, named after the namespace and name of the root
+		err := k8sClient.Get(ctx, types.NamespacedName{
+			Namespace: cluster,
+			Name:      ownerObject.GetNamespace() + "." + sp.PolicyName,
+		}, policy)
+		if err != nil {
+			if k8serrors.IsNotFound(err) {
+				return false, nil
+			}
+
+			return false, fmt.Errorf("failed to get replicated secret propagation policy for cluster %s (%w)",
+				cluster, err)
+		}
+
+		if policy.Status.ComplianceState != policyv1.Compliant || !policyEvaluatedSince(policy, updatedAt) {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// policyEvaluatedSince returns true if the passed in policy reports a compliance evaluation at or after the passed
+// in time
+func policyEvaluatedSince(policy *policyv1.Policy, since time.Time) bool {
+	for _, details := range policy.Status.Details {
+		if details == nil {
+			continue
+		}
+
+		for _, history := range details.History {
+			if !history.LastTimestamp.Time.Before(since) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
 type secretPropagator struct {
 	Context              context.Context
 	Client               client.Client
@@ -151,7 +281,13 @@ func (sp *secretPropagator) cleanup() error {
 }
 
 func (sp *secretPropagator) deleteIgnoreNotFound(obj client.Object) error {
-	return client.IgnoreNotFound(sp.Client.Delete(sp.Context, obj))
+	err := sp.Client.Delete(sp.Context, obj)
+	// Policy resources are not installed on hubs without the governance add-on
+	if meta.IsNoMatchError(err) {
+		return nil
+	}
+
+	return client.IgnoreNotFound(err)
 }
 
 func (sp *secretPropagator) reconcileSecretPropagationPolicy() error {
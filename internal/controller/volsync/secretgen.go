@@ -8,6 +8,9 @@ import (
 	"crypto/rand"
 	"encoding/hex"
 	"fmt"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
@@ -20,7 +23,41 @@ import (
 	rmnutil "github.com/ramendr/ramen/internal/controller/util"
 )
 
-const tlsPSKDataSize = 64
+const (
+	tlsPSKDataSize = 64
+
+	tlsPSKKey      = "psk.txt"
+	tlsPSKIdentity = "volsyncramen"
+
+	// PSKGenerationAnnotation records the number of times the pre-shared key in a VolSync replication secret has
+	// been rotated
+	PSKGenerationAnnotation = "volsync.ramendr.openshift.io/psk-generation"
+
+	// PSKRotatedAtAnnotation records the time at which the pre-shared key in a VolSync replication secret was
+	// last generated
+	PSKRotatedAtAnnotation = "volsync.ramendr.openshift.io/psk-rotated-at"
+
+	// PSKRotationPhaseAnnotation records the phase of an in-progress rotation of the pre-shared key in a VolSync
+	// replication secret, it is removed once the rotation completes
+	PSKRotationPhaseAnnotation = "volsync.ramendr.openshift.io/psk-rotation-phase"
+
+	// PSKUpdatedAtAnnotation records the time at which the pre-shared keys in a VolSync replication secret were
+	// last updated
+	PSKUpdatedAtAnnotation = "volsync.ramendr.openshift.io/psk-updated-at"
+
+	// PSKRotationPhaseStaged is the rotation phase in which the new key is accepted, but not yet used to connect
+	PSKRotationPhaseStaged = "Staged"
+
+	// PSKRotationPhasePromoted is the rotation phase in which the new key is used to connect, and the previous key
+	// is still accepted
+	PSKRotationPhasePromoted = "Promoted"
+)
+
+// PSKRotation configures the rotation of the pre-shared key in a VolSync replication secret
+type PSKRotation struct {
+	// Interval between rotations, the key is not rotated if zero
+	Interval time.Duration
+}
 
 // Creates a new volsync replication secret on the cluster (should be called on the hub cluster).  If the secret
 // already exists, nop
@@ -82,7 +119,7 @@ func generateNewVolSyncReplicationSecret(secretName, secretNamespace string, log
 			},
 		},
 		StringData: map[string]string{
-			"psk.txt": "volsyncramen:" + tlsKey,
+			tlsPSKKey: tlsPSKIdentity + ":" + tlsKey,
 		},
 	}
 
@@ -101,3 +138,129 @@ func genTLSPreSharedKey(log logr.Logger) (string, error) {
 
 	return hex.EncodeToString(pskData), nil
 }
+
+// RotateVolSyncReplicationSecret rotates the pre-shared key in the VolSync replication secret (should be called on the
+// hub cluster) once the rotation interval has elapsed since the key was generated. The rsync-TLS movers accept any
+// key listed in the secret, and use the first listed key to connect, hence a rotation proceeds in phases, each of
+// which is started only once the passed in applied flag confirms the secret of the previous phase is applied on all
+// clusters, so that the keys used to connect are accepted by every cluster at all times:
+//   - Staged: the new key is listed after the current key, to be accepted by all clusters
+//   - Promoted: the new key is listed first, to be used to connect, with the previous key still accepted
+//   - the previous key is dropped, completing the rotation
+//
+// It returns true if the secret was updated.
+func RotateVolSyncReplicationSecret(ctx context.Context, k8sClient client.Client, secret *corev1.Secret,
+	rotation PSKRotation, applied bool, now time.Time, log logr.Logger,
+) (bool, error) {
+	updated, err := rotatePSK(secret, rotation, applied, now, log)
+	if err != nil || !updated {
+		return false, err
+	}
+
+	if err := k8sClient.Update(ctx, secret); err != nil {
+		log.Error(err, "Error updating secret", "secretName", secret.GetName())
+
+		return false, fmt.Errorf("error updating secret for volsync (%w)", err)
+	}
+
+	return true, nil
+}
+
+// rotatePSK updates the pre-shared keys in the secret, staging a new key if the rotation interval has elapsed, or
+// moving an in-progress rotation to its next phase if the secret is applied on all clusters. It returns true if the
+// secret was modified.
+func rotatePSK(secret *corev1.Secret, rotation PSKRotation, applied bool, now time.Time, log logr.Logger,
+) (bool, error) {
+	keys := strings.Split(strings.TrimSpace(string(secret.Data[tlsPSKKey])), "\n")
+	phase := secret.GetAnnotations()[PSKRotationPhaseAnnotation]
+
+	if phase != "" && !applied {
+		return false, nil
+	}
+
+	switch phase {
+	case "":
+		return rotatePSKStage(secret, keys[0], rotation, now, log)
+	case PSKRotationPhaseStaged:
+		if len(keys) != 2 { //nolint:mnd
+			return false, fmt.Errorf("secret %s lists %d keys in rotation phase %s", secret.GetName(), len(keys),
+				phase)
+		}
+
+		log.Info("Promoting volsync rsync pre-shared key", "secretName", secret.GetName())
+
+		setPSKKeys(secret, []string{keys[1], keys[0]}, PSKRotationPhasePromoted, now)
+	case PSKRotationPhasePromoted:
+		log.Info("Dropping previous volsync rsync pre-shared key", "secretName", secret.GetName())
+
+		setPSKKeys(secret, keys[:1], "", now)
+	default:
+		return false, fmt.Errorf("invalid %s annotation on secret %s: %s", PSKRotationPhaseAnnotation,
+			secret.GetName(), phase)
+	}
+
+	return true, nil
+}
+
+// rotatePSKStage generates a new key for the secret, listing it after the current key, if the rotation interval has
+// elapsed since the current key was generated. It returns true if the secret was modified.
+func rotatePSKStage(secret *corev1.Secret, currentKey string, rotation PSKRotation, now time.Time,
+	log logr.Logger,
+) (bool, error) {
+	if rotation.Interval <= 0 {
+		return false, nil
+	}
+
+	rotatedAt := secret.GetCreationTimestamp().Time
+	if value, ok := secret.GetAnnotations()[PSKRotatedAtAnnotation]; ok {
+		parsed, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return false, fmt.Errorf("invalid %s annotation on secret %s (%w)", PSKRotatedAtAnnotation,
+				secret.GetName(), err)
+		}
+
+		rotatedAt = parsed
+	}
+
+	if now.Before(rotatedAt.Add(rotation.Interval)) {
+		return false, nil
+	}
+
+	tlsKey, err := genTLSPreSharedKey(log)
+	if err != nil {
+		return false, err
+	}
+
+	generation, _ := strconv.Atoi(secret.GetAnnotations()[PSKGenerationAnnotation])
+	generation++
+
+	log.Info("Staging volsync rsync pre-shared key", "secretName", secret.GetName(), "generation", generation)
+
+	// Each key requires a unique identity, as the movers look up keys by identity
+	setPSKKeys(secret, []string{currentKey, fmt.Sprintf("%s-%d:%s", tlsPSKIdentity, generation, tlsKey)},
+		PSKRotationPhaseStaged, now)
+
+	rmnutil.AddAnnotation(secret, PSKGenerationAnnotation, strconv.Itoa(generation))
+	rmnutil.AddAnnotation(secret, PSKRotatedAtAnnotation, now.UTC().Format(time.RFC3339))
+
+	return true, nil
+}
+
+// setPSKKeys lists the passed in keys in the secret, and records the passed in rotation phase, removing it if empty
+func setPSKKeys(secret *corev1.Secret, keys []string, phase string, now time.Time) {
+	if secret.Data == nil {
+		secret.Data = map[string][]byte{}
+	}
+
+	secret.Data[tlsPSKKey] = []byte(strings.Join(keys, "\n"))
+
+	rmnutil.AddAnnotation(secret, PSKUpdatedAtAnnotation, now.UTC().Format(time.RFC3339))
+
+	if phase == "" {
+		delete(secret.Annotations, PSKRotationPhaseAnnotation)
+
+		return
+	}
+
+	rmnutil.AddAnnotation(secret, PSKRotationPhaseAnnotation, phase)
+}
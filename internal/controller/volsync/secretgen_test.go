@@ -4,6 +4,9 @@
 package volsync_test
 
 import (
+	"strings"
+	"time"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
@@ -124,4 +127,79 @@ var _ = Describe("Secretgen", func() {
 			})
 		})
 	})
+
+	Describe("Rotate volsync rsync secret", func() {
+		var secret *corev1.Secret
+
+		rotation := volsync.PSKRotation{Interval: time.Hour}
+
+		pskLines := func() []string {
+			return strings.Split(string(secret.Data["psk.txt"]), "\n")
+		}
+
+		BeforeEach(func() {
+			var err error
+
+			secret, err = volsync.ReconcileVolSyncReplicationSecret(ctx, k8sClient, owner,
+				"test-secret-rotate", testNamespace.GetName(), logger)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("Should not rotate when rotation is disabled", func() {
+			updated, err := volsync.RotateVolSyncReplicationSecret(ctx, k8sClient, secret,
+				volsync.PSKRotation{}, true, time.Now().Add(24*time.Hour), logger)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(updated).To(BeFalse())
+		})
+
+		It("Should not rotate before the interval elapses", func() {
+			updated, err := volsync.RotateVolSyncReplicationSecret(ctx, k8sClient, secret,
+				rotation, true, secret.GetCreationTimestamp().Add(time.Minute), logger)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(updated).To(BeFalse())
+		})
+
+		It("Should move to each rotation phase once the secret is applied on all clusters", func() {
+			originalKey := string(secret.Data["psk.txt"])
+			rotatedAt := secret.GetCreationTimestamp().Add(rotation.Interval)
+
+			updated, err := volsync.RotateVolSyncReplicationSecret(ctx, k8sClient, secret, rotation, false, rotatedAt,
+				logger)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(updated).To(BeTrue())
+			Expect(pskLines()).To(HaveLen(2))
+			Expect(pskLines()[0]).To(Equal(originalKey))
+			Expect(pskLines()[1]).To(HavePrefix("volsyncramen-1:"))
+			Expect(secret.GetAnnotations()).To(HaveKeyWithValue(volsync.PSKGenerationAnnotation, "1"))
+			Expect(secret.GetAnnotations()).To(HaveKeyWithValue(volsync.PSKRotationPhaseAnnotation,
+				volsync.PSKRotationPhaseStaged))
+
+			updated, err = volsync.RotateVolSyncReplicationSecret(ctx, k8sClient, secret,
+				rotation, false, rotatedAt.Add(24*time.Hour), logger)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(updated).To(BeFalse())
+
+			updated, err = volsync.RotateVolSyncReplicationSecret(ctx, k8sClient, secret,
+				rotation, true, rotatedAt.Add(time.Minute), logger)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(updated).To(BeTrue())
+			Expect(pskLines()).To(HaveLen(2))
+			Expect(pskLines()[0]).To(HavePrefix("volsyncramen-1:"))
+			Expect(pskLines()[1]).To(Equal(originalKey))
+			Expect(secret.GetAnnotations()).To(HaveKeyWithValue(volsync.PSKRotationPhaseAnnotation,
+				volsync.PSKRotationPhasePromoted))
+
+			updated, err = volsync.RotateVolSyncReplicationSecret(ctx, k8sClient, secret,
+				rotation, true, rotatedAt.Add(2*time.Minute), logger)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(updated).To(BeTrue())
+			Expect(pskLines()).To(HaveLen(1))
+			Expect(pskLines()[0]).To(HavePrefix("volsyncramen-1:"))
+			Expect(secret.GetAnnotations()).NotTo(HaveKey(volsync.PSKRotationPhaseAnnotation))
+
+			stored := &corev1.Secret{}
+			Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(secret), stored)).To(Succeed())
+			Expect(stored.Data).To(Equal(secret.Data))
+		})
+	})
 })
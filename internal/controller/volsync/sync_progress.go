@@ -0,0 +1,148 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package volsync
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	ramendrv1alpha1 "github.com/ramendr/ramen/api/v1alpha1"
+)
+
+// rsyncSentRegex matches the rsync transfer summary line retained by the VolSync mover log filter,
+// for example "sent 833.81K bytes  received 397.18K bytes  30.39K bytes/sec"
+var rsyncSentRegex = regexp.MustCompile(`[sS]ent\s+([\d,.]+)([KMGT]?)\s+[bB]ytes`)
+
+var rsyncErrorRegex = regexp.MustCompile(`([fF]ail)|([eE]rror)`)
+
+// SyncProgressForRS returns the progress of the in-flight or failing synchronization of a ReplicationSource
+func SyncProgressForRS(rs *volsyncv1alpha1.ReplicationSource) *ramendrv1alpha1.SyncProgress {
+	if rs == nil || rs.Status == nil {
+		return nil
+	}
+
+	return SyncProgressFromStatus(rs.Status.Conditions, rs.Status.LastSyncStartTime, rs.Status.LastSyncDuration,
+		rs.Status.LatestMoverStatus)
+}
+
+// SyncProgressForRD returns the progress of the in-flight or failing synchronization of a ReplicationDestination
+func SyncProgressForRD(rd *volsyncv1alpha1.ReplicationDestination) *ramendrv1alpha1.SyncProgress {
+	if rd == nil || rd.Status == nil {
+		return nil
+	}
+
+	return SyncProgressFromStatus(rd.Status.Conditions, rd.Status.LastSyncStartTime, rd.Status.LastSyncDuration,
+		rd.Status.LatestMoverStatus)
+}
+
+// SyncProgressForRGS returns the progress of the in-flight or failing synchronization of a ReplicationGroupSource,
+// for the PVC whose ReplicationSource, created by the ReplicationGroupSource, is passed in, if any
+func SyncProgressForRGS(rgs *ramendrv1alpha1.ReplicationGroupSource, rs *volsyncv1alpha1.ReplicationSource,
+) *ramendrv1alpha1.SyncProgress {
+	if rgs == nil {
+		return nil
+	}
+
+	var moverStatus *volsyncv1alpha1.MoverStatus
+	if rs != nil && rs.Status != nil {
+		moverStatus = rs.Status.LatestMoverStatus
+	}
+
+	return SyncProgressFromStatus(rgs.Status.Conditions, rgs.Status.LastSyncStartTime, rgs.Status.LastSyncDuration,
+		moverStatus)
+}
+
+// SyncProgressFromStatus computes the progress of a synchronization from the status fields that the VolSync
+// state machine maintains. VolSync sets lastSyncStartTime when a synchronization starts and clears it once it
+// completes, hence a nil start time with no reported error means there is no progress to report. The latest mover
+// status describes the in-flight synchronization only if it failed, as a synchronization completes once its mover
+// succeeds, so a successful mover status describes the previous synchronization and is ignored. Hence the bytes
+// transferred are those of the last failed mover attempt, and the estimated completion time is the start time
+// plus the duration of the previous synchronization.
+func SyncProgressFromStatus(conditions []metav1.Condition, lastSyncStartTime *metav1.Time,
+	lastSyncDuration *metav1.Duration, moverStatus *volsyncv1alpha1.MoverStatus,
+) *ramendrv1alpha1.SyncProgress {
+	if lastSyncStartTime == nil || moverStatus == nil || moverStatus.Result != volsyncv1alpha1.MoverResultFailed {
+		moverStatus = nil
+	}
+
+	lastError := moverLastError(conditions, moverStatus)
+
+	if lastSyncStartTime == nil && lastError == "" {
+		return nil
+	}
+
+	progress := &ramendrv1alpha1.SyncProgress{
+		LastError: lastError,
+	}
+
+	if moverStatus != nil {
+		progress.BytesTransferred = MoverBytesTransferred(moverStatus.Logs)
+	}
+
+	if lastSyncStartTime == nil {
+		return progress
+	}
+
+	progress.StartTime = lastSyncStartTime.DeepCopy()
+
+	if lastSyncDuration != nil {
+		completionTime := metav1.NewTime(lastSyncStartTime.Add(lastSyncDuration.Duration))
+		progress.EstimatedCompletionTime = &completionTime
+	}
+
+	return progress
+}
+
+// moverLastError returns the error reported by the Synchronizing condition, or failing that, the last error
+// line in the logs of the passed in failed mover run
+func moverLastError(conditions []metav1.Condition, moverStatus *volsyncv1alpha1.MoverStatus) string {
+	condition := meta.FindStatusCondition(conditions, volsyncv1alpha1.ConditionSynchronizing)
+	if condition != nil && condition.Reason == volsyncv1alpha1.SynchronizingReasonError {
+		return condition.Message
+	}
+
+	if moverStatus == nil {
+		return ""
+	}
+
+	lastError := string(volsyncv1alpha1.MoverResultFailed)
+
+	for _, line := range strings.Split(moverStatus.Logs, "\n") {
+		if rsyncErrorRegex.MatchString(line) {
+			lastError = strings.TrimSpace(line)
+		}
+	}
+
+	return lastError
+}
+
+// MoverBytesTransferred sums the bytes sent across all rsync summaries in the mover logs. It returns nil
+// if the logs carry no rsync summary.
+func MoverBytesTransferred(logs string) *int64 {
+	var total *int64
+
+	for _, match := range rsyncSentRegex.FindAllStringSubmatch(logs, -1) {
+		value, err := strconv.ParseFloat(strings.ReplaceAll(match[1], ",", ""), 64)
+		if err != nil {
+			continue
+		}
+
+		// rsync --human-readable reports sizes in units of 1000
+		multiplier := map[string]float64{"": 1, "K": 1e3, "M": 1e6, "G": 1e9, "T": 1e12}[match[2]]
+
+		if total == nil {
+			total = new(int64)
+		}
+
+		*total += int64(value * multiplier)
+	}
+
+	return total
+}
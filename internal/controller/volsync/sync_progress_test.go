@@ -0,0 +1,115 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package volsync_test
+
+import (
+	"time"
+
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	ramendrv1alpha1 "github.com/ramendr/ramen/api/v1alpha1"
+	"github.com/ramendr/ramen/internal/controller/volsync"
+)
+
+var _ = Describe("SyncProgress", func() {
+	const moverLogs = `Total bytes sent: 833.81K
+sent 833.81K bytes  received 397.18K bytes  30.39K bytes/sec
+total size is 1,048,576  speedup is 1.00
+sent 1,003 bytes  received 5,657 bytes  13,320.00 bytes/sec
+rsync completed in 42s`
+
+	now := time.Now()
+
+	Describe("MoverBytesTransferred", func() {
+		It("sums all rsync transfer summaries", func() {
+			bytes := volsync.MoverBytesTransferred(moverLogs)
+			Expect(bytes).NotTo(BeNil())
+			Expect(*bytes).To(Equal(int64(833810 + 1003)))
+		})
+
+		It("returns nil when the logs have no rsync summary", func() {
+			Expect(volsync.MoverBytesTransferred("rsync completed in 42s")).To(BeNil())
+		})
+	})
+
+	Describe("SyncProgressFromStatus", func() {
+		It("reports nothing when no sync is in progress and there are no errors", func() {
+			Expect(volsync.SyncProgressFromStatus(nil, nil, nil,
+				&volsyncv1alpha1.MoverStatus{Result: volsyncv1alpha1.MoverResultSuccessful, Logs: moverLogs},
+			)).To(BeNil())
+		})
+
+		It("estimates the completion time from the last sync duration", func() {
+			startTime := metav1.NewTime(now.Add(-4 * time.Minute))
+			progress := volsync.SyncProgressFromStatus(nil, &startTime, &metav1.Duration{Duration: 10 * time.Minute},
+				nil)
+			Expect(progress).NotTo(BeNil())
+			Expect(progress.StartTime.Equal(&startTime)).To(BeTrue())
+			Expect(progress.EstimatedCompletionTime.Time).To(Equal(startTime.Add(10 * time.Minute)))
+			Expect(progress.LastError).To(BeEmpty())
+		})
+
+		It("reports the same progress for the same status", func() {
+			startTime := metav1.NewTime(now.Add(-4 * time.Minute))
+			duration := &metav1.Duration{Duration: 10 * time.Minute}
+			Expect(volsync.SyncProgressFromStatus(nil, &startTime, duration, nil)).To(
+				Equal(volsync.SyncProgressFromStatus(nil, &startTime, duration, nil)))
+		})
+
+		It("ignores the mover status of the previous sync", func() {
+			startTime := metav1.NewTime(now)
+			progress := volsync.SyncProgressFromStatus(nil, &startTime, nil,
+				&volsyncv1alpha1.MoverStatus{Result: volsyncv1alpha1.MoverResultSuccessful, Logs: moverLogs})
+			Expect(progress).NotTo(BeNil())
+			Expect(progress.BytesTransferred).To(BeNil())
+		})
+
+		It("reports the Synchronizing condition error", func() {
+			conditions := []metav1.Condition{{
+				Type:    volsyncv1alpha1.ConditionSynchronizing,
+				Status:  metav1.ConditionFalse,
+				Reason:  volsyncv1alpha1.SynchronizingReasonError,
+				Message: "unable to reach destination",
+			}}
+			progress := volsync.SyncProgressFromStatus(conditions, nil, nil, nil)
+			Expect(progress).NotTo(BeNil())
+			Expect(progress.LastError).To(Equal("unable to reach destination"))
+			Expect(progress.StartTime).To(BeNil())
+		})
+
+		It("reports the last error line of a failed mover run of the in-flight sync", func() {
+			startTime := metav1.NewTime(now)
+			progress := volsync.SyncProgressFromStatus(nil, &startTime, nil, &volsyncv1alpha1.MoverStatus{
+				Result: volsyncv1alpha1.MoverResultFailed,
+				Logs:   "sent 1,003 bytes  received 5,657 bytes  13,320.00 bytes/sec\nrsync error: connection reset",
+			})
+			Expect(progress).NotTo(BeNil())
+			Expect(progress.LastError).To(Equal("rsync error: connection reset"))
+			Expect(*progress.BytesTransferred).To(Equal(int64(1003)))
+		})
+	})
+
+	Describe("SyncProgressForRGS", func() {
+		It("reports the failed mover run of the ReplicationSource of the PVC", func() {
+			startTime := metav1.NewTime(now)
+			rgs := &ramendrv1alpha1.ReplicationGroupSource{Status: ramendrv1alpha1.ReplicationGroupSourceStatus{
+				LastSyncStartTime: &startTime,
+			}}
+			rs := &volsyncv1alpha1.ReplicationSource{Status: &volsyncv1alpha1.ReplicationSourceStatus{
+				LatestMoverStatus: &volsyncv1alpha1.MoverStatus{
+					Result: volsyncv1alpha1.MoverResultFailed,
+					Logs:   "rsync error: connection reset",
+				},
+			}}
+
+			progress := volsync.SyncProgressForRGS(rgs, rs)
+			Expect(progress).NotTo(BeNil())
+			Expect(progress.StartTime.Equal(&startTime)).To(BeTrue())
+			Expect(progress.LastError).To(Equal("rsync error: connection reset"))
+		})
+	})
+})
@@ -73,8 +73,17 @@ const (
 
 	// Prefix for the job that mounts unmounted PVC when RS is not found
 	VolSyncMountJobNamePrefix = "volsync-pvc-mount-"
+
+	// Prefix and suffix of the name of the PVC that VolSync allocates to receive the data of a
+	// ReplicationDestination, when the ReplicationDestination does not use the application PVC
+	VolSyncDestPVCNamePrefix = "volsync-"
+	VolSyncDestPVCNameSuffix = "-dst"
 )
 
+// ErrUnmountedPVCNotSnapshotable is returned when replicating an unmounted PVC in SnapshotOnly mode is not
+// possible, as the PVC will not bind until it is mounted by a consumer
+var ErrUnmountedPVCNotSnapshotable = fmt.Errorf("unmounted PVC cannot be snapshotted")
+
 type VSHandler struct {
 	ctx                         context.Context
 	client                      client.Client
@@ -88,6 +97,7 @@ type VSHandler struct {
 	vrgInAdminNamespace         bool
 	workloadStatus              string
 	moverConfig                 []ramendrv1alpha1.MoverConfig
+	storageClassMapping         map[string]string
 }
 
 func NewVSHandler(ctx context.Context, client client.Client, log logr.Logger, owner metav1.Object,
@@ -108,6 +118,7 @@ func NewVSHandler(ctx context.Context, client client.Client, log logr.Logger, ow
 	if asyncSpec != nil {
 		vsHandler.schedulingInterval = asyncSpec.SchedulingInterval
 		vsHandler.volumeSnapshotClassSelector = asyncSpec.VolumeSnapshotClassSelector
+		vsHandler.storageClassMapping = asyncSpec.StorageClassMapping
 	}
 
 	vrg, ok := owner.(*ramendrv1alpha1.VolumeReplicationGroup)
@@ -120,6 +131,20 @@ func NewVSHandler(ctx context.Context, client client.Client, log logr.Logger, ow
 	return vsHandler
 }
 
+// DestinationStorageClassName returns the name of the StorageClass to restore a PVC with on this cluster, given the
+// name of the StorageClass the PVC uses on the peer cluster
+func (v *VSHandler) DestinationStorageClassName(storageClassName *string) *string {
+	if storageClassName == nil {
+		return nil
+	}
+
+	if mapped, ok := v.storageClassMapping[*storageClassName]; ok {
+		return &mapped
+	}
+
+	return storageClassName
+}
+
 func (v *VSHandler) GetWorkloadStatus() string {
 	return v.workloadStatus
 }
@@ -196,9 +221,90 @@ func (v *VSHandler) ReconcileRD(
 		return nil, nil, err
 	}
 
+	resized, err := v.EnsureDestPVCResized(rdSpec, rd, dstPVC)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if !resized {
+		l.Info("Waiting for the destination PVC to be resized to the capacity of the protected PVC")
+
+		return nil, nil, nil
+	}
+
 	return v.generateRDInfo(rdSpec, rd, l)
 }
 
+// EnsureDestPVCResized expands the PVC receiving the data of the RD, if allocated and bound, to the capacity
+// requested by the protected PVC, as syncs of the protected PVC may not complete until it is resized. Returns false
+// while the PVC is smaller than that capacity, and an error if its StorageClass does not allow expanding it.
+func (v *VSHandler) EnsureDestPVCResized(rdSpec ramendrv1alpha1.VolSyncReplicationDestinationSpec,
+	rd *volsyncv1alpha1.ReplicationDestination, dstPVC *string,
+) (bool, error) {
+	capacity := rdSpec.ProtectedPVC.Resources.Requests.Storage()
+	if capacity == nil || capacity.IsZero() {
+		return true, nil
+	}
+
+	pvcName := VolSyncDestPVCNamePrefix + rd.GetName() + VolSyncDestPVCNameSuffix
+	if dstPVC != nil {
+		pvcName = *dstPVC
+	}
+
+	pvc, err := v.getPVC(types.NamespacedName{Namespace: rd.GetNamespace(), Name: pvcName})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return true, nil
+		}
+
+		return false, err
+	}
+
+	// An unbound PVC is provisioned with the capacity it requests
+	if pvc.Status.Phase != corev1.ClaimBound || pvc.Status.Capacity.Storage().Cmp(*capacity) >= 0 {
+		return true, nil
+	}
+
+	if pvc.Spec.Resources.Requests.Storage().Cmp(*capacity) < 0 {
+		if err := v.expandPVC(pvc, *capacity); err != nil {
+			return false, err
+		}
+	}
+
+	return false, nil
+}
+
+// expandPVC patches the requested capacity of the passed in PVC to the passed in capacity, if its StorageClass
+// allows expanding it
+func (v *VSHandler) expandPVC(pvc *corev1.PersistentVolumeClaim, capacity resource.Quantity) error {
+	storageClass, err := v.getStorageClass(pvc.Spec.StorageClassName)
+	if err != nil {
+		return err
+	}
+
+	if storageClass.AllowVolumeExpansion == nil || !*storageClass.AllowVolumeExpansion {
+		return fmt.Errorf("storageClass %s of PVC %s/%s does not allow volume expansion to %s",
+			storageClass.GetName(), pvc.GetNamespace(), pvc.GetName(), capacity.String())
+	}
+
+	patch := client.MergeFrom(pvc.DeepCopy())
+
+	if pvc.Spec.Resources.Requests == nil {
+		pvc.Spec.Resources.Requests = corev1.ResourceList{}
+	}
+
+	pvc.Spec.Resources.Requests[corev1.ResourceStorage] = capacity
+
+	if err := v.client.Patch(v.ctx, pvc, patch); err != nil {
+		return fmt.Errorf("failed to expand PVC %s/%s to %s: %w", pvc.GetNamespace(), pvc.GetName(),
+			capacity.String(), err)
+	}
+
+	v.log.Info("Expanding PVC", "pvc", pvc.GetNamespace()+"/"+pvc.GetName(), "capacity", capacity.String())
+
+	return nil
+}
+
 func (v *VSHandler) ensurePSKSecretReady(pskSecretName, namespace string) error {
 	secretExists, err := v.ValidateSecretAndAddVRGOwnerRef(pskSecretName)
 	if err != nil {
@@ -370,7 +476,8 @@ func (v *VSHandler) createOrUpdateRD(
 ) {
 	l := v.log.WithValues("rdSpec", rdSpec)
 
-	volumeSnapshotClassName, err := v.GetVolumeSnapshotClassFromPVCStorageClass(rdSpec.ProtectedPVC.StorageClassName)
+	volumeSnapshotClassName, err := v.GetVolumeSnapshotClassFromPVCStorageClass(
+		v.DestinationStorageClassName(rdSpec.ProtectedPVC.StorageClassName))
 	if err != nil {
 		return nil, err
 	}
@@ -411,7 +518,7 @@ func (v *VSHandler) createOrUpdateRD(
 			ReplicationDestinationVolumeOptions: volsyncv1alpha1.ReplicationDestinationVolumeOptions{
 				CopyMethod:              volsyncv1alpha1.CopyMethodSnapshot,
 				Capacity:                rdSpec.ProtectedPVC.Resources.Requests.Storage(),
-				StorageClassName:        rdSpec.ProtectedPVC.StorageClassName,
+				StorageClassName:        v.DestinationStorageClassName(rdSpec.ProtectedPVC.StorageClassName),
 				AccessModes:             pvcAccessModes,
 				VolumeSnapshotClassName: &volumeSnapshotClassName,
 				DestinationPVC:          dstPVC,
@@ -500,14 +607,14 @@ func (v *VSHandler) ReconcileRS(rsSpec ramendrv1alpha1.VolSyncReplicationSourceS
 		return false, nil, err
 	}
 
-	// When PVC is unmounted and RS for that PVC is not found, create a job to mount the PVC
-	mountJobReady, err := v.EnsureMountJobForUnmountedPVC(&rsSpec)
+	// When PVC is unmounted and RS for that PVC is not found, ensure the PVC is ready to be snapshotted
+	unmountedPVCReady, err := v.EnsureUnmountedPVCReady(&rsSpec)
 	if err != nil {
 		return false, nil, err
 	}
 
-	if !mountJobReady {
-		return false, nil, nil // Requeue until mount job completes
+	if !unmountedPVCReady {
+		return false, nil, nil // Requeue until the PVC is ready
 	}
 
 	pvcOk, err := v.validatePVCForFinalSync(rsSpec, runFinalSync)
@@ -1919,11 +2026,15 @@ func (v *VSHandler) EnsurePVCforDirectCopy(ctx context.Context,
 	op, err := ctrlutil.CreateOrUpdate(ctx, v.client, pvc, func() error {
 		if pvc.CreationTimestamp.IsZero() {
 			pvc.Spec.AccessModes = rdSpec.ProtectedPVC.AccessModes
-			pvc.Spec.StorageClassName = rdSpec.ProtectedPVC.StorageClassName
+			pvc.Spec.StorageClassName = v.DestinationStorageClassName(rdSpec.ProtectedPVC.StorageClassName)
 			pvc.Spec.VolumeMode = v.volumeModeForProtectedPVC(&rdSpec.ProtectedPVC)
 		}
 
-		pvc.Spec.Resources.Requests = rdSpec.ProtectedPVC.Resources.Requests
+		// Expand the PVC to the capacity of the protected PVC, as it may not be shrunk
+		if pvc.CreationTimestamp.IsZero() ||
+			pvc.Spec.Resources.Requests.Storage().Cmp(*rdSpec.ProtectedPVC.Resources.Requests.Storage()) < 0 {
+			pvc.Spec.Resources.Requests = rdSpec.ProtectedPVC.Resources.Requests
+		}
 
 		util.SyncPVCLabels(pvc, rdSpec.ProtectedPVC.Labels)
 		util.SyncPVCAnnotations(pvc, rdSpec.ProtectedPVC.Annotations)
@@ -2115,7 +2226,7 @@ func (v *VSHandler) ensurePVCFromSnapshot(rdSpec ramendrv1alpha1.VolSyncReplicat
 
 		if pvc.CreationTimestamp.IsZero() { // set immutable fields
 			pvc.Spec.AccessModes = accessModes
-			pvc.Spec.StorageClassName = rdSpec.ProtectedPVC.StorageClassName
+			pvc.Spec.StorageClassName = v.DestinationStorageClassName(rdSpec.ProtectedPVC.StorageClassName)
 
 			// Only set when initially creating
 			pvc.Spec.DataSource = &snapshotRef
@@ -2629,7 +2740,7 @@ func (v *VSHandler) reconcileLocalRD(rdSpec ramendrv1alpha1.VolSyncReplicationDe
 			ReplicationDestinationVolumeOptions: volsyncv1alpha1.ReplicationDestinationVolumeOptions{
 				CopyMethod:       volsyncv1alpha1.CopyMethodDirect,
 				Capacity:         rdSpec.ProtectedPVC.Resources.Requests.Storage(),
-				StorageClassName: rdSpec.ProtectedPVC.StorageClassName,
+				StorageClassName: v.DestinationStorageClassName(rdSpec.ProtectedPVC.StorageClassName),
 				AccessModes:      pvcAccessModes,
 				DestinationPVC:   &rdSpec.ProtectedPVC.Name,
 			},
@@ -2828,7 +2939,7 @@ func (v *VSHandler) createPVCFromSnapshot(rd *volsyncv1alpha1.ReplicationDestina
 ) (*corev1.PersistentVolumeClaim, error) {
 	l := v.log.WithValues("pvcName", rd.GetName(), "snapshotRef", snapshotRef, "snapRestoreSize", snapRestoreSize)
 
-	storageClass, err := v.getStorageClass(rdSpec.ProtectedPVC.StorageClassName)
+	storageClass, err := v.getStorageClass(v.DestinationStorageClassName(rdSpec.ProtectedPVC.StorageClassName))
 	if err != nil {
 		return nil, err
 	}
@@ -3226,6 +3337,51 @@ func (v *VSHandler) IsSubmarinerEnabled() bool {
 	return util.IsSubmarinerEnabled(v.owner.GetAnnotations())
 }
 
+// EnsureUnmountedPVCReady prepares a PVC that may not be mounted by any pod for replication, based on the
+// UnmountedPVCMode of the owning VRG
+func (v *VSHandler) EnsureUnmountedPVCReady(rsSpec *ramendrv1alpha1.VolSyncReplicationSourceSpec) (bool, error) {
+	vrg, ok := v.GetOwner().(*ramendrv1alpha1.VolumeReplicationGroup)
+	if ok && vrg.Spec.VolSync.UnmountedPVCMode == ramendrv1alpha1.UnmountedPVCModeSnapshotOnly {
+		return v.EnsureUnmountedPVCSnapshotable(rsSpec)
+	}
+
+	return v.EnsureMountJobForUnmountedPVC(rsSpec)
+}
+
+// EnsureUnmountedPVCSnapshotable checks that a PVC can be replicated from CSI snapshots without being mounted.
+// A bound PVC can be snapshotted as is. An unbound PVC is waited on if its StorageClass binds volumes
+// immediately, and otherwise ErrUnmountedPVCNotSnapshotable is returned, as the PVC would only bind once a
+// consumer mounts it.
+func (v *VSHandler) EnsureUnmountedPVCSnapshotable(rsSpec *ramendrv1alpha1.VolSyncReplicationSourceSpec,
+) (bool, error) {
+	log := v.log.WithValues("pvc", rsSpec.ProtectedPVC.Name, "namespace", rsSpec.ProtectedPVC.Namespace)
+
+	pvc, err := v.getPVC(util.ProtectedPVCNamespacedName(rsSpec.ProtectedPVC))
+	if err != nil {
+		return false, err
+	}
+
+	if pvc.Status.Phase == corev1.ClaimBound {
+		return true, nil
+	}
+
+	storageClass, err := v.getStorageClass(pvc.Spec.StorageClassName)
+	if err != nil {
+		return false, err
+	}
+
+	if storageClass.VolumeBindingMode != nil &&
+		*storageClass.VolumeBindingMode == storagev1.VolumeBindingWaitForFirstConsumer {
+		return false, fmt.Errorf("%w: PVC %s/%s is not bound and StorageClass %s uses volume binding mode %s",
+			ErrUnmountedPVCNotSnapshotable, pvc.GetNamespace(), pvc.GetName(), storageClass.GetName(),
+			storagev1.VolumeBindingWaitForFirstConsumer)
+	}
+
+	log.V(1).Info("Waiting for unmounted PVC to bind", "phase", pvc.Status.Phase)
+
+	return false, nil
+}
+
 func (v *VSHandler) EnsureMountJobForUnmountedPVC(rsSpec *ramendrv1alpha1.VolSyncReplicationSourceSpec,
 ) (bool, error) {
 	log := v.log.WithValues("pvc", rsSpec.ProtectedPVC.Name, "namespace", rsSpec.ProtectedPVC.Namespace)
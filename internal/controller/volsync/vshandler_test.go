@@ -558,6 +558,73 @@ var _ = Describe("VolSync_Handler", func() {
 							// Expect RDInfo to NOT be nil - address was filled out so it should have been returned
 							Expect(returnedRD).ToNot(BeNil())
 						})
+
+						Context("When the destination PVC allocated by VolSync is smaller than the protected PVC", func() {
+							var dstPVC *corev1.PersistentVolumeClaim
+
+							createBoundDstPVC := func(storageClassName string) {
+								smaller := resource.MustParse("1Gi")
+								dstPVC = &corev1.PersistentVolumeClaim{
+									ObjectMeta: metav1.ObjectMeta{
+										Name: volsync.VolSyncDestPVCNamePrefix + createdRD.GetName() +
+											volsync.VolSyncDestPVCNameSuffix,
+										Namespace: testNamespace.GetName(),
+									},
+									Spec: corev1.PersistentVolumeClaimSpec{
+										AccessModes:      []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+										StorageClassName: &storageClassName,
+										Resources: corev1.VolumeResourceRequirements{
+											Requests: corev1.ResourceList{corev1.ResourceStorage: smaller},
+										},
+									},
+								}
+								Expect(k8sClient.Create(ctx, dstPVC)).To(Succeed())
+
+								dstPVC.Status.Phase = corev1.ClaimBound
+								dstPVC.Status.Capacity = corev1.ResourceList{corev1.ResourceStorage: smaller}
+								Expect(k8sClient.Status().Update(ctx, dstPVC)).To(Succeed())
+							}
+
+							It("Should expand it, and not return the rd until it is resized", func() {
+								allowVolumeExpansion := true
+								expandableStorageClass := &storagev1.StorageClass{
+									ObjectMeta:           metav1.ObjectMeta{GenerateName: "expandable-sc-"},
+									Provisioner:          testStorageDriverName,
+									AllowVolumeExpansion: &allowVolumeExpansion,
+								}
+								Expect(k8sClient.Create(ctx, expandableStorageClass)).To(Succeed())
+
+								DeferCleanup(func() {
+									Expect(k8sClient.Delete(ctx, expandableStorageClass)).To(Succeed())
+								})
+
+								createBoundDstPVC(expandableStorageClass.GetName())
+
+								rd, _, err := vsHandler.ReconcileRD(rdSpec, nil)
+								Expect(err).ToNot(HaveOccurred())
+								Expect(rd).To(BeNil())
+
+								Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(dstPVC), dstPVC)).To(Succeed())
+								Expect(dstPVC.Spec.Resources.Requests[corev1.ResourceStorage]).To(Equal(capacity))
+
+								dstPVC.Status.Capacity = corev1.ResourceList{corev1.ResourceStorage: capacity}
+								Expect(k8sClient.Status().Update(ctx, dstPVC)).To(Succeed())
+
+								Eventually(func() bool {
+									rd, _, err = vsHandler.ReconcileRD(rdSpec, nil)
+
+									return err == nil && rd != nil
+								}, maxWait, interval).Should(BeTrue())
+							})
+
+							It("Should fail when its StorageClass does not allow volume expansion", func() {
+								createBoundDstPVC(testStorageClassName)
+
+								rd, _, err := vsHandler.ReconcileRD(rdSpec, nil)
+								Expect(err).To(MatchError(ContainSubstring("does not allow volume expansion")))
+								Expect(rd).To(BeNil())
+							})
+						})
 					})
 				})
 			})
@@ -880,6 +947,69 @@ var _ = Describe("VolSync_Handler", func() {
 					})
 				})
 
+				Context("When the PVC is unmounted and SnapshotOnly mode is used", func() {
+					var unboundPVC *corev1.PersistentVolumeClaim
+
+					createUnboundPVC := func(storageClassName string) {
+						unboundPVC = &corev1.PersistentVolumeClaim{
+							ObjectMeta: metav1.ObjectMeta{
+								Name:      testPVCName,
+								Namespace: testNamespace.GetName(),
+							},
+							Spec: corev1.PersistentVolumeClaimSpec{
+								AccessModes:      []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+								StorageClassName: &storageClassName,
+								Resources: corev1.VolumeResourceRequirements{
+									Requests: corev1.ResourceList{corev1.ResourceStorage: capacity},
+								},
+							},
+						}
+						Expect(k8sClient.Create(ctx, unboundPVC)).To(Succeed())
+					}
+
+					It("Should wait for the PVC to bind when the StorageClass binds volumes immediately", func() {
+						createUnboundPVC(testStorageClassName)
+
+						ready, err := vsHandler.EnsureUnmountedPVCSnapshotable(&rsSpec)
+						Expect(err).ToNot(HaveOccurred())
+						Expect(ready).To(BeFalse())
+
+						unboundPVC.Status.Phase = corev1.ClaimBound
+						Expect(k8sClient.Status().Update(ctx, unboundPVC)).To(Succeed())
+
+						Eventually(func() bool {
+							ready, err = vsHandler.EnsureUnmountedPVCSnapshotable(&rsSpec)
+
+							return err == nil && ready
+						}, maxWait, interval).Should(BeTrue())
+
+						// No mount job is created
+						jobName := util.GetJobName(volsync.VolSyncMountJobNamePrefix, testPVCName)
+						Expect(k8sClient.Get(ctx, types.NamespacedName{Name: jobName, Namespace: testNamespace.GetName()},
+							&batchv1.Job{})).ToNot(Succeed())
+					})
+
+					It("Should fail when the StorageClass waits for the first consumer", func() {
+						wffcBindingMode := storagev1.VolumeBindingWaitForFirstConsumer
+						wffcStorageClass := &storagev1.StorageClass{
+							ObjectMeta:        metav1.ObjectMeta{GenerateName: "wffc-sc-"},
+							Provisioner:       testStorageDriverName,
+							VolumeBindingMode: &wffcBindingMode,
+						}
+						Expect(k8sClient.Create(ctx, wffcStorageClass)).To(Succeed())
+
+						DeferCleanup(func() {
+							Expect(k8sClient.Delete(ctx, wffcStorageClass)).To(Succeed())
+						})
+
+						createUnboundPVC(wffcStorageClass.GetName())
+
+						ready, err := vsHandler.EnsureUnmountedPVCSnapshotable(&rsSpec)
+						Expect(err).To(MatchError(volsync.ErrUnmountedPVCNotSnapshotable))
+						Expect(ready).To(BeFalse())
+					})
+				})
+
 				Context("When the PVC to be protected is mounted by a pod that is NOT in running phase", func() {
 					JustBeforeEach(func() {
 						// Create PVC and pod that is mounting it - pod phase will be "Pending"
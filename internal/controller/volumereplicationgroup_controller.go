@@ -23,6 +23,7 @@ import (
 	storagev1 "k8s.io/api/storage/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -43,7 +44,6 @@ import (
 	ramendrv1alpha1 "github.com/ramendr/ramen/api/v1alpha1"
 	recipecore "github.com/ramendr/ramen/internal/controller/core"
 	"github.com/ramendr/ramen/internal/controller/kubeobjects"
-	"github.com/ramendr/ramen/internal/controller/kubeobjects/velero"
 	"github.com/ramendr/ramen/internal/controller/util"
 	"github.com/ramendr/ramen/internal/controller/volsync"
 )
@@ -51,15 +51,16 @@ import (
 // VolumeReplicationGroupReconciler reconciles a VolumeReplicationGroup object
 type VolumeReplicationGroupReconciler struct {
 	client.Client
-	APIReader           client.Reader
-	Log                 logr.Logger
-	ObjStoreGetter      ObjectStoreGetter
-	Scheme              *runtime.Scheme
-	eventRecorder       *util.EventReporter
-	kubeObjects         kubeobjects.RequestsManager
-	RateLimiter         *workqueue.TypedRateLimiter[reconcile.Request]
-	veleroCRsAreWatched bool
-	recipeRetries       sync.Map
+	APIReader                     client.Reader
+	Log                           logr.Logger
+	ObjStoreGetter                ObjectStoreGetter
+	Scheme                        *runtime.Scheme
+	eventRecorder                 *util.EventReporter
+	kubeObjects                   kubeobjects.RequestsManager
+	RateLimiter                   *workqueue.TypedRateLimiter[reconcile.Request]
+	kubeObjectsRequestsAreWatched bool
+	recipeRetries                 sync.Map
+	kubeObjectsChangeTrackers     sync.Map
 }
 
 // SetupWithManager sets up the controller with the Manager.
@@ -119,10 +120,12 @@ func (r *VolumeReplicationGroupReconciler) SetupWithManager(
 		r.Log.Info("VolSync disabled; don't own volsync resources")
 	}
 
-	r.kubeObjects = velero.RequestsManager{}
+	if err := r.kubeObjectsRequestsManagerSet(mgr, ramenConfig); err != nil {
+		return err
+	}
 
 	if !ramenConfig.KubeObjectProtection.Disabled {
-		ctrlBuilder = r.addKubeObjectsOwnsAndWatches(ctrlBuilder)
+		ctrlBuilder = r.addKubeObjectsOwnsAndWatches(ctrlBuilder, ramenConfig)
 	} else {
 		r.Log.Info("Kube object protection disabled; don't watch kube objects requests")
 	}
@@ -394,7 +397,7 @@ func filterPVC(reader client.Reader, pvc *corev1.PersistentVolumeClaim, log logr
 // +kubebuilder:rbac:groups=volsync.backube,resources=replicationdestinations/finalizers,verbs=update
 // +kubebuilder:rbac:groups=volsync.backube,resources=replicationsources,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=volsync.backube,resources=replicationsources/finalizers,verbs=update
-// +kubebuilder:rbac:groups=snapshot.storage.k8s.io,resources=volumesnapshots,verbs=get;list;watch;update;delete
+// +kubebuilder:rbac:groups=snapshot.storage.k8s.io,resources=volumesnapshots,verbs=get;list;watch;create;update;delete
 // +kubebuilder:rbac:groups=snapshot.storage.k8s.io,resources=volumesnapshotclasses,verbs=get;list;watch
 // +kubebuilder:rbac:groups=multicluster.x-k8s.io,resources=serviceexports,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=core,resources=events,verbs=get;create;patch;update
@@ -461,7 +464,7 @@ func (r *VolumeReplicationGroupReconciler) Reconcile(ctx context.Context, req ct
 	v.ramenConfig = ramenConfig
 	adminNamespaceVRG := vrgInAdminNamespace(v.instance, v.ramenConfig)
 
-	if adminNamespaceVRG && !r.veleroCRsAreWatched {
+	if adminNamespaceVRG && !r.kubeObjectsRequestsAreWatched {
 		return ctrl.Result{},
 			fmt.Errorf("VRG {%s/%s} with kube object protection doesn't work if velero/oadp is not installed. "+
 				"Please install velero/oadp and restart the operator", v.instance.Namespace, v.instance.Name)
@@ -513,6 +516,8 @@ type VRGInstance struct {
 	volSyncHandler       *volsync.VSHandler
 	objectStorers        map[string]cachedObjectStorer
 	s3StoreAccessors     []s3StoreAccessor
+	s3StoresInaccessible map[string]error
+	s3Writes             map[string]*s3Writes
 	result               ctrl.Result
 }
 
@@ -533,6 +538,7 @@ const (
 	pvVRAnnotationRetentionValue     = "retained"
 	RestoreAnnotation                = "volumereplicationgroups.ramendr.openshift.io/ramen-restore"
 	RestoredByRamen                  = "True"
+	pvcAnnotationExpectedCapacityKey = "volumereplicationgroups.ramendr.openshift.io/expected-capacity"
 
 	// StorageClass label
 	StorageIDLabel = "ramendr.openshift.io/storageid"
@@ -660,7 +666,7 @@ func (v *VRGInstance) clusterDataRestore(result *ctrl.Result) (int, error) {
 		return numRestoredForVS, fmt.Errorf("failed to restore PV/PVC for VolSync (%w)", err)
 	}
 
-	numRestoredForVR, err := v.restorePVsAndPVCsForVolRep(result)
+	numRestoredForVR, volRepSource, err := v.restorePVsAndPVCsForVolRep(result)
 	if err != nil {
 		v.log.Info("VolRep PV/PVC restore failed")
 
@@ -675,6 +681,10 @@ func (v *VRGInstance) clusterDataRestore(result *ctrl.Result) (int, error) {
 		msg = fmt.Sprintf("Restored %d volsync PVs/PVCs and %d volrep PVs/PVCs", numRestoredForVS, numRestoredForVR)
 	}
 
+	if volRepSource != "" {
+		msg += ". " + volRepSource
+	}
+
 	err = v.restoreVGRsAndVGRCsForVolRep(result)
 	if err != nil {
 		v.log.Info("VolRep VGR/VGRC restore failed")
@@ -761,7 +771,7 @@ func (v *VRGInstance) updateAsyncPVCs(pvcList *corev1.PersistentVolumeClaimList)
 }
 
 func isOffloadedByPeerClass(scName, sID string, peerClass *ramendrv1alpha1.PeerClass) bool {
-	if scName != peerClass.StorageClassName {
+	if !peerClassHasStorageClassName(peerClass, scName) {
 		return false
 	}
 
@@ -1110,9 +1120,20 @@ func (v *VRGInstance) separateAsyncPVCs(pvcList *corev1.PersistentVolumeClaimLis
 			return err
 		}
 
-		if len(peerClasses) == 0 {
+		method, err := v.replicationMethodOverride(pvc)
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case method != "":
+			err = v.separatePVCUsingReplicationMethod(method, peerClasses, storageClass, pvc)
+			if err != nil {
+				return err
+			}
+		case len(peerClasses) == 0:
 			v.separatePVCsUsingOnlySC(storageClass, pvc)
-		} else {
+		default:
 			err = v.separatePVCUsingPeerClassAndSC(peerClasses, storageClass, pvc)
 			if err != nil {
 				return err
@@ -1130,6 +1151,157 @@ func (v *VRGInstance) separateAsyncPVCs(pvcList *corev1.PersistentVolumeClaimLis
 	return nil
 }
 
+// replicationMethodOverride returns the replication method of the first VRG replication method override whose
+// selector matches the PVC, or an empty method if no override matches it
+func (v *VRGInstance) replicationMethodOverride(
+	pvc *corev1.PersistentVolumeClaim,
+) (ramendrv1alpha1.ReplicationMethod, error) {
+	for idx := range v.instance.Spec.ReplicationMethodOverrides {
+		override := &v.instance.Spec.ReplicationMethodOverrides[idx]
+
+		selector, err := metav1.LabelSelectorAsSelector(&override.Selector)
+		if err != nil {
+			return "", fmt.Errorf("invalid selector in replication method override %d (%w)", idx, err)
+		}
+
+		if selector.Matches(labels.Set(pvc.GetLabels())) {
+			return override.Method, nil
+		}
+	}
+
+	return "", nil
+}
+
+// separatePVCUsingReplicationMethod targets the PVC for the replication method requested by an override, after
+// ensuring the storage of the PVC supports that method. If the VRG has peer classes, the method must be supported by
+// the peer class of the PVC's storage class, as checked for PVCs without an override.
+func (v *VRGInstance) separatePVCUsingReplicationMethod(method ramendrv1alpha1.ReplicationMethod,
+	peerClasses []ramendrv1alpha1.PeerClass, storageClass *storagev1.StorageClass, pvc *corev1.PersistentVolumeClaim,
+) error {
+	v.log.Info("separate PVC using replication method override", "pvc", pvc.GetNamespace()+"/"+pvc.GetName(),
+		"method", method)
+
+	var peerClass *ramendrv1alpha1.PeerClass
+
+	if len(peerClasses) != 0 {
+		var err error
+
+		peerClass, err = v.findPeerClassMatchingSC(storageClass, peerClasses, pvc)
+		if err != nil {
+			return err
+		}
+	}
+
+	switch method {
+	case ramendrv1alpha1.ReplicationMethodVolSync:
+		if err := v.validateVolSyncOverride(peerClass, storageClass, pvc); err != nil {
+			return err
+		}
+
+		v.volSyncPVCs = append(v.volSyncPVCs, *pvc)
+	case ramendrv1alpha1.ReplicationMethodVolRep:
+		// A PVC may carry the consistency group label that the VRG added while it replicated the PVC in a group.
+		// The PVC is then still replicated in that group, until it is unprotected.
+		if !v.hasReplicationClassForOverride(peerClass, storageClass, false) {
+			return fmt.Errorf("failed to find replicationClass for PVC %s/%s requested for VolRep",
+				pvc.GetNamespace(), pvc.GetName())
+		}
+
+		v.volRepPVCs = append(v.volRepPVCs, *pvc)
+	case ramendrv1alpha1.ReplicationMethodVolGroupRep:
+		if !util.IsCGEnabledForVolRep(v.ctx, v.reconciler.APIReader) {
+			return fmt.Errorf("consistency groups are disabled, but requested for PVC %s/%s",
+				pvc.GetNamespace(), pvc.GetName())
+		}
+
+		if !v.hasReplicationClassForOverride(peerClass, storageClass, true) {
+			return fmt.Errorf("failed to find groupReplicationClass for PVC %s/%s requested for VolGroupRep",
+				pvc.GetNamespace(), pvc.GetName())
+		}
+
+		if err := v.addVolRepConsistencyGroupLabel(pvc); err != nil {
+			return fmt.Errorf("failed to label PVC %s/%s for consistency group (%w)",
+				pvc.GetNamespace(), pvc.GetName(), err)
+		}
+
+		v.volRepPVCs = append(v.volRepPVCs, *pvc)
+	default:
+		return fmt.Errorf("unsupported replication method %s for PVC %s/%s", method, pvc.GetNamespace(), pvc.GetName())
+	}
+
+	return nil
+}
+
+// validateVolSyncOverride ensures VolSync is enabled and, if the PVC's storage class has a peer class, that a
+// VolumeSnapshotClass exists for the storage class
+func (v *VRGInstance) validateVolSyncOverride(peerClass *ramendrv1alpha1.PeerClass,
+	storageClass *storagev1.StorageClass, pvc *corev1.PersistentVolumeClaim,
+) error {
+	if v.instance.Spec.VolSync.Disabled {
+		return fmt.Errorf("VolSync is disabled, but requested for PVC %s/%s", pvc.GetNamespace(), pvc.GetName())
+	}
+
+	if peerClass == nil {
+		return nil
+	}
+
+	snapClass, err := v.findVolSnapClass(storageClass)
+	if err != nil {
+		return err
+	}
+
+	if snapClass == nil {
+		return fmt.Errorf("failed to find snapshotClass for PVC %s/%s requested for VolSync",
+			pvc.GetNamespace(), pvc.GetName())
+	}
+
+	return nil
+}
+
+// hasReplicationClassForOverride checks whether a VolumeReplicationClass, or a VolumeGroupReplicationClass if
+// grouping is requested, exists for the storage class. If a peer class is passed in, the replication class must
+// match the peer class replicationID, or groupReplicationID if grouping is requested, and storageID.
+func (v *VRGInstance) hasReplicationClassForOverride(peerClass *ramendrv1alpha1.PeerClass,
+	storageClass *storagev1.StorageClass, grouping bool,
+) bool {
+	if peerClass == nil {
+		return v.hasReplicationClassForProvisioner(storageClass.Provisioner, grouping)
+	}
+
+	matches := func(replicationClass client.Object, provisioner, idLabel, id string) bool {
+		return id != "" && provisioner == storageClass.Provisioner &&
+			replicationClass.GetLabels()[StorageIDLabel] == storageClass.GetLabels()[StorageIDLabel] &&
+			replicationClass.GetLabels()[idLabel] == id
+	}
+
+	if grouping {
+		return slices.ContainsFunc(v.grpReplClassList.Items, func(replicationClass volrep.VolumeGroupReplicationClass,
+		) bool {
+			return matches(&replicationClass, replicationClass.Spec.Provisioner, GroupReplicationIDLabel,
+				peerClass.GroupReplicationID)
+		})
+	}
+
+	return slices.ContainsFunc(v.replClassList.Items, func(replicationClass volrep.VolumeReplicationClass) bool {
+		return matches(&replicationClass, replicationClass.Spec.Provisioner, ReplicationIDLabel, peerClass.ReplicationID)
+	})
+}
+
+// hasReplicationClassForProvisioner checks whether a VolumeReplicationClass, or a VolumeGroupReplicationClass
+// if grouping is requested, exists for the provisioner
+func (v *VRGInstance) hasReplicationClassForProvisioner(provisioner string, grouping bool) bool {
+	if grouping {
+		return slices.ContainsFunc(v.grpReplClassList.Items, func(replicationClass volrep.VolumeGroupReplicationClass,
+		) bool {
+			return replicationClass.Spec.Provisioner == provisioner
+		})
+	}
+
+	return slices.ContainsFunc(v.replClassList.Items, func(replicationClass volrep.VolumeReplicationClass) bool {
+		return replicationClass.Spec.Provisioner == provisioner
+	})
+}
+
 //nolint:gocognit,cyclop
 func (v *VRGInstance) findReplicationClassUsingPeerClass(
 	peerClass *ramendrv1alpha1.PeerClass,
@@ -1231,7 +1403,7 @@ func (v *VRGInstance) findPeerClassMatchingSC(
 	}
 
 	for idx := range peerClasses {
-		if storageClass.GetName() == peerClasses[idx].StorageClassName {
+		if peerClassHasStorageClassName(&peerClasses[idx], storageClass.GetName()) {
 			peerClass = &peerClasses[idx]
 		}
 	}
@@ -1433,7 +1605,7 @@ func (v *VRGInstance) processAsPrimary() ctrl.Result {
 	}
 
 	if v.shouldRestoreKubeObjects() {
-		err := v.kubeObjectsRecover(&v.result)
+		message, err := v.kubeObjectsRecover(&v.result)
 		if err != nil {
 			v.log.Info("Kube objects restore failed", "error", err)
 			v.errorConditionLogAndSet(err, "Failed to restore kube objects", setVRGKubeObjectsErrorCondition)
@@ -1442,13 +1614,22 @@ func (v *VRGInstance) processAsPrimary() ctrl.Result {
 		}
 
 		// save status and requeue if kube objects are restored
-		v.log.Info("Kube objects restored")
-		setVRGKubeObjectsReadyCondition(&v.instance.Status.Conditions, v.instance.Generation, "Kube objects restored")
+		if message == "" {
+			message = "Kube objects restored"
+		}
+
+		v.log.Info(message)
+		setVRGKubeObjectsReadyCondition(&v.instance.Status.Conditions, v.instance.Generation, message)
 		v.result.Requeue = true
 
 		return v.updateVRGConditionsAndStatus(v.result)
 	}
 
+	// kube objects are not protected until their recovery is complete, lest a capture miss those rolled back
+	if !v.kubeObjectsRecoveredReady(&v.result) {
+		return v.updateVRGConditionsAndStatus(v.result)
+	}
+
 	v.kubeObjectsProtectPrimary(&v.result)
 
 	if v.result.Requeue {
@@ -1532,6 +1713,12 @@ func (v *VRGInstance) shouldRestoreKubeObjects() bool {
 		return false
 	}
 
+	if v.kubeObjectsRecoveredRolledBack() {
+		v.log.Info("Kube objects restore skipped, as the recovered kube objects were rolled back")
+
+		return false
+	}
+
 	KubeObjectsRestored := util.FindCondition(v.instance.Status.Conditions, VRGConditionTypeKubeObjectsReady)
 	if KubeObjectsRestored == nil {
 		return true
@@ -1693,6 +1880,8 @@ func (v *VRGInstance) processAsSecondary() ctrl.Result {
 
 	v.instance.Status.LastGroupSyncTime = nil
 
+	v.kubeObjectsChangeTrackerStop()
+
 	if v.resetInitialStatusAsSecondary() {
 		v.result.Requeue = true
 
@@ -1719,6 +1908,7 @@ func (v *VRGInstance) reconcileAsSecondary() ctrl.Result {
 	result.Requeue = v.HandleSecondaryConflictsAndCleanup() || result.Requeue
 	result.Requeue = v.reconcileVolSyncAsSecondary() || result.Requeue
 	result.Requeue = v.reconcileVolRepsAsSecondary() || result.Requeue
+	result.Requeue = v.kubeObjectsClusterObjectsRelease() || result.Requeue
 
 	// We already have the vrg.spec.state set to Secondary, so the user has been
 	// asked to cleanup the resources and we cannot upload the kube resources
@@ -1753,6 +1943,12 @@ func (v *VRGInstance) resetInitialStatusAsSecondary() bool {
 		update = true
 	}
 
+	if util.FindCondition(v.instance.Status.Conditions, VRGConditionTypeKubeObjectsRecovered) != nil {
+		meta.RemoveStatusCondition(&v.instance.Status.Conditions, VRGConditionTypeKubeObjectsRecovered)
+
+		update = true
+	}
+
 	// Set the conditions to the current generation irresepective of update required
 	setVRGClusterDataReadyConditionUnused(
 		&v.instance.Status.Conditions,
@@ -2110,9 +2306,13 @@ func (v *VRGInstance) updateVRGConditions() {
 	v.logAndSetConditions(VRGConditionTypeAutoCleanup,
 		v.aggregateVRGAutoCleanupCondition())
 
+	v.updateVRGS3WriteQuorumCondition()
+	v.updateVRGSplitBrainCondition()
+
 	v.updateVRGLastGroupSyncTime()
 	v.updateVRGLastGroupSyncDuration()
 	v.updateLastGroupSyncBytes()
+	v.updateVRGGroupSyncProgress()
 }
 
 func (v *VRGInstance) vrgReadyStatus(reason string) *metav1.Condition {
@@ -2208,6 +2408,54 @@ func (v *VRGInstance) updateLastGroupSyncBytes() {
 	v.instance.Status.LastGroupSyncBytes = totalLastSyncBytes
 }
 
+// updateVRGGroupSyncProgress summarizes the sync progress of all protected PVCs
+func (v *VRGInstance) updateVRGGroupSyncProgress() {
+	var groupSyncProgress *ramendrv1alpha1.SyncProgress
+
+	for _, protectedPVC := range v.instance.Status.ProtectedPVCs {
+		if protectedPVC.SyncProgress == nil {
+			continue
+		}
+
+		if groupSyncProgress == nil {
+			groupSyncProgress = &ramendrv1alpha1.SyncProgress{}
+		}
+
+		mergeSyncProgress(groupSyncProgress, protectedPVC)
+	}
+
+	v.instance.Status.GroupSyncProgress = groupSyncProgress
+}
+
+// mergeSyncProgress merges the sync progress of a protected PVC into the group sync progress, retaining the
+// earliest start time, the total bytes transferred, the latest estimated completion time and the first error
+func mergeSyncProgress(groupSyncProgress *ramendrv1alpha1.SyncProgress, protectedPVC ramendrv1alpha1.ProtectedPVC) {
+	progress := protectedPVC.SyncProgress
+
+	if progress.StartTime != nil &&
+		(groupSyncProgress.StartTime == nil || progress.StartTime.Before(groupSyncProgress.StartTime)) {
+		groupSyncProgress.StartTime = progress.StartTime.DeepCopy()
+	}
+
+	if progress.BytesTransferred != nil {
+		if groupSyncProgress.BytesTransferred == nil {
+			groupSyncProgress.BytesTransferred = new(int64)
+		}
+
+		*groupSyncProgress.BytesTransferred += *progress.BytesTransferred
+	}
+
+	if progress.EstimatedCompletionTime != nil && (groupSyncProgress.EstimatedCompletionTime == nil ||
+		groupSyncProgress.EstimatedCompletionTime.Before(progress.EstimatedCompletionTime)) {
+		groupSyncProgress.EstimatedCompletionTime = progress.EstimatedCompletionTime.DeepCopy()
+	}
+
+	if progress.LastError != "" && groupSyncProgress.LastError == "" {
+		groupSyncProgress.LastError = fmt.Sprintf("PVC %s/%s: %s",
+			protectedPVC.Namespace, protectedPVC.Name, progress.LastError)
+	}
+}
+
 // isVRGReasonError returns true if the passed in VRG condition reason matches any errors reported as the Reason
 func isVRGReasonError(condition *metav1.Condition) bool {
 	return condition.Reason == VRGConditionReasonError ||
@@ -2220,8 +2468,9 @@ func isVRGReasonError(condition *metav1.Condition) bool {
 
 func (v *VRGInstance) s3StoreAccessorsGet() {
 	vrg := v.instance
-	v.s3StoreAccessors = s3StoreAccessorsGet(
+	v.s3StoreAccessors, v.s3StoresInaccessible = s3StoreAccessorsGet(
 		vrg.Spec.S3Profiles,
+		vrg.Spec.S3WriteQuorum,
 		func(s3ProfileName string) (ObjectStorer, ramendrv1alpha1.S3StoreProfile, error) {
 			return v.reconciler.ObjStoreGetter.ObjectStore(
 				v.ctx, v.reconciler.APIReader, s3ProfileName, v.namespacedName, v.log,
@@ -2396,9 +2645,15 @@ func (r *VolumeReplicationGroupReconciler) addVolsyncOwnsAndWatches(ctrlBuilder
 	return ctrlBuilder
 }
 
-func (r *VolumeReplicationGroupReconciler) addKubeObjectsOwnsAndWatches(ctrlBuilder *builder.Builder) *builder.Builder {
+func (r *VolumeReplicationGroupReconciler) addKubeObjectsOwnsAndWatches(
+	ctrlBuilder *builder.Builder, ramenConfig *ramendrv1alpha1.RamenConfig,
+) *builder.Builder {
 	r.Log.Info("Kube object protection enabled; watch kube objects requests")
 
+	if kubeObjectProtectionProviderOrDefault(ramenConfig) == ramendrv1alpha1.KubeObjectProtectionProviderNative {
+		return r.addKubeObjectsRequestsAndRecipesWatches(ctrlBuilder)
+	}
+
 	// Find if velero CRDs are present in the cluster
 	veleroCRDs := []string{
 		"backups.velero.io",
@@ -2423,13 +2678,19 @@ func (r *VolumeReplicationGroupReconciler) addKubeObjectsOwnsAndWatches(ctrlBuil
 		return ctrlBuilder
 	}
 
+	return r.addKubeObjectsRequestsAndRecipesWatches(ctrlBuilder)
+}
+
+func (r *VolumeReplicationGroupReconciler) addKubeObjectsRequestsAndRecipesWatches(
+	ctrlBuilder *builder.Builder,
+) *builder.Builder {
 	kubeObjectsRequestsWatch(ctrlBuilder, r.Scheme, r.kubeObjects)
 
 	// watch for recipe objects
 	objectToReconcileRequestsMapper := objectToReconcileRequestsMapper{reader: r.Client, log: ctrl.Log}
 	recipesWatch(ctrlBuilder, objectToReconcileRequestsMapper)
 
-	r.veleroCRsAreWatched = true
+	r.kubeObjectsRequestsAreWatched = true
 
 	return ctrlBuilder
 }
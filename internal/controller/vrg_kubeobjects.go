@@ -4,8 +4,12 @@
 package controllers
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"maps"
+	"regexp"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -16,12 +20,16 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/dynamic"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 
 	ramen "github.com/ramendr/ramen/api/v1alpha1"
 	"github.com/ramendr/ramen/internal/controller/hooks"
 	"github.com/ramendr/ramen/internal/controller/kubeobjects"
+	"github.com/ramendr/ramen/internal/controller/kubeobjects/native"
+	"github.com/ramendr/ramen/internal/controller/kubeobjects/velero"
 	"github.com/ramendr/ramen/internal/controller/util"
 )
 
@@ -37,6 +45,14 @@ func kubeObjectsCaptureInterval(kubeObjectProtectionSpec *ramen.KubeObjectProtec
 	return kubeObjectProtectionSpec.CaptureInterval.Duration
 }
 
+func kubeObjectsFullCaptureInterval(kubeObjectProtectionSpec *ramen.KubeObjectProtectionSpec) time.Duration {
+	if kubeObjectProtectionSpec.FullCaptureInterval == nil {
+		return ramen.KubeObjectProtectionFullCaptureIntervalDefault
+	}
+
+	return kubeObjectProtectionSpec.FullCaptureInterval.Duration
+}
+
 func kubeObjectsCapturePathNamesAndNamePrefix(
 	namespaceName, vrgName string, captureNumber int64, kubeObjects kubeobjects.RequestsManager,
 ) (string, string, string) {
@@ -77,6 +93,8 @@ func (v *VRGInstance) kubeObjectsProtect(
 	result *ctrl.Result,
 ) {
 	if v.kubeObjectProtectionDisabled("capture") {
+		v.kubeObjectsChangeTrackerStop()
+
 		return
 	}
 
@@ -112,6 +130,14 @@ func (v *VRGInstance) kubeObjectsCaptureStartOrResumeOrDelay(
 	veleroNamespaceName := v.veleroNamespaceName()
 	vrg := v.instance
 	interval := kubeObjectsCaptureInterval(vrg.Spec.KubeObjectProtection)
+
+	changeTrackingRequestsManager, changesCaptured := v.kubeObjectsChangeTrackingRequestsManager()
+	if changesCaptured {
+		interval = kubeObjectsFullCaptureInterval(vrg.Spec.KubeObjectProtection)
+	} else {
+		v.kubeObjectsChangeTrackerStop()
+	}
+
 	number := 1 - captureToRecoverFrom.Number
 	log := v.log.WithValues("number", number)
 	pathName, capturePathName, namePrefix := kubeObjectsCapturePathNamesAndNamePrefix(
@@ -147,7 +173,11 @@ func (v *VRGInstance) kubeObjectsCaptureStartOrResumeOrDelay(
 		delaySetIfLess(result, delay, v.log)
 		v.kubeObjectsCaptureStatusTrue(VRGConditionReasonUploaded, kubeObjectsClusterDataProtectedTrueMessage)
 
-		return
+		// a full capture is due if changes can no longer be captured
+		if !changesCaptured ||
+			v.kubeObjectsChangesCapture(result, changeTrackingRequestsManager, captureToRecoverFrom) == nil {
+			return
+		}
 	}
 
 	// before starting a new capture, delete the previous one with the same number
@@ -165,6 +195,134 @@ func (v *VRGInstance) kubeObjectsCaptureStartOrResumeOrDelay(
 		kubeobjects.RequestsMapKeyedByName(requests), log)
 }
 
+// kubeObjectsChangeTrackingRequestsManager returns the kube objects requests manager if changes to kube objects are
+// to be captured as they happen, between full captures
+func (v *VRGInstance) kubeObjectsChangeTrackingRequestsManager() (kubeobjects.ChangeTrackingRequestsManager, bool) {
+	if v.instance.Spec.KubeObjectProtection.CaptureMode != ramen.KubeObjectsCaptureModeIncremental {
+		return nil, false
+	}
+
+	changeTrackingRequestsManager, ok := v.reconciler.kubeObjects.(kubeobjects.ChangeTrackingRequestsManager)
+	if !ok {
+		v.log.Info("Kube objects incremental capture unsupported by provider; capturing fully",
+			"provider", kubeObjectProtectionProviderOrDefault(v.ramenConfig))
+	}
+
+	return changeTrackingRequestsManager, ok
+}
+
+// kubeObjectsChangeTracker captures the changes to the kube objects of a capture
+type kubeObjectsChangeTracker struct {
+	kubeobjects.ChangeTracker
+	captureNumber    int64
+	captureStartTime metav1.Time
+}
+
+func (t kubeObjectsChangeTracker) tracks(captureToRecoverFrom *ramen.KubeObjectsCaptureIdentifier) bool {
+	return t.captureNumber == captureToRecoverFrom.Number &&
+		t.captureStartTime.Unix() == captureToRecoverFrom.StartTime.Unix()
+}
+
+// kubeObjectsChangesCapture captures the changes to the kube objects of the capture to recover from, as they happen,
+// and records the time up to which they are captured. It returns an error if changes failed to be captured since the
+// previous call, after which a full capture is due.
+func (v *VRGInstance) kubeObjectsChangesCapture(
+	result *ctrl.Result,
+	changeTrackingRequestsManager kubeobjects.ChangeTrackingRequestsManager,
+	captureToRecoverFrom *ramen.KubeObjectsCaptureIdentifier,
+) error {
+	// the change capture time is updated at the preferred capture interval
+	delaySetIfLess(result, kubeObjectsCaptureInterval(v.instance.Spec.KubeObjectProtection), v.log)
+
+	value, ok := v.reconciler.kubeObjectsChangeTrackers.Load(v.namespacedName)
+	if ok {
+		if tracker, _ := value.(kubeObjectsChangeTracker); tracker.tracks(captureToRecoverFrom) {
+			return v.kubeObjectsChangesCaptureTimeUpdate(tracker, captureToRecoverFrom)
+		}
+
+		// the capture to recover from is a newer one
+		v.kubeObjectsChangeTrackerStop()
+	}
+
+	log := v.log.WithValues("number", captureToRecoverFrom.Number)
+
+	tracker, err := changeTrackingRequestsManager.ChangeTrackerStart(v.ctx, log,
+		v.kubeObjectsChangeCaptures(captureToRecoverFrom.Number), captureToRecoverFrom.StartTime)
+	if err != nil {
+		log.Error(err, "Kube objects changes capture start error")
+		v.kubeObjectsCaptureStatusFalse("KubeObjectsChangesCaptureStartError", err.Error())
+
+		result.Requeue = true
+
+		return nil
+	}
+
+	v.reconciler.kubeObjectsChangeTrackers.Store(v.namespacedName, kubeObjectsChangeTracker{
+		ChangeTracker:    tracker,
+		captureNumber:    captureToRecoverFrom.Number,
+		captureStartTime: captureToRecoverFrom.StartTime,
+	})
+
+	log.Info("Kube objects changes capture started")
+
+	return nil
+}
+
+func (v *VRGInstance) kubeObjectsChangesCaptureTimeUpdate(
+	tracker kubeObjectsChangeTracker, captureToRecoverFrom *ramen.KubeObjectsCaptureIdentifier,
+) error {
+	if err := tracker.Err(); err != nil {
+		v.log.Error(err, "Kube objects changes capture error", "number", captureToRecoverFrom.Number)
+		v.kubeObjectsCaptureStatusFalse("KubeObjectsChangesCaptureError", err.Error())
+		v.kubeObjectsChangeTrackerStop()
+
+		return err
+	}
+
+	if changesTime := tracker.ChangesTime(); !changesTime.IsZero() {
+		captureToRecoverFrom.ChangesTime = &changesTime
+	}
+
+	return nil
+}
+
+// kubeObjectsChangeCaptures returns the captures of each kube objects capture group to each s3 store
+func (v *VRGInstance) kubeObjectsChangeCaptures(captureNumber int64) []kubeobjects.ChangeCapture {
+	vrg := v.instance
+	pathName, _, namePrefix := kubeObjectsCapturePathNamesAndNamePrefix(
+		vrg.Namespace, vrg.Name, captureNumber, v.reconciler.kubeObjects)
+	captures := []kubeobjects.ChangeCapture{}
+
+	for _, captureGroup := range v.recipeElements.CaptureWorkflow {
+		if captureGroup.IsHook {
+			continue
+		}
+
+		for _, s3StoreAccessor := range v.s3StoreAccessors {
+			captures = append(captures, kubeobjects.ChangeCapture{
+				S3Url:        s3StoreAccessor.S3CompatibleEndpoint,
+				S3BucketName: s3StoreAccessor.S3Bucket,
+				S3KeyPrefix:  pathName,
+				CaptureName:  kubeObjectsCaptureName(namePrefix, captureGroup.Name, s3StoreAccessor.S3ProfileName),
+				Spec:         captureGroup.Spec,
+			})
+		}
+	}
+
+	return captures
+}
+
+func (v *VRGInstance) kubeObjectsChangeTrackerStop() {
+	value, ok := v.reconciler.kubeObjectsChangeTrackers.LoadAndDelete(v.namespacedName)
+	if !ok {
+		return
+	}
+
+	if tracker, ok := value.(kubeObjectsChangeTracker); ok {
+		tracker.Stop()
+	}
+}
+
 func (v *VRGInstance) kubeObjectsCapturesDelete(
 	result *ctrl.Result, captureNumber int64, pathName string,
 ) error {
@@ -248,7 +406,7 @@ func (v *VRGInstance) kubeObjectsCaptureStartOrResume(
 		return
 	}
 
-	firstRequest := getFirstRequest(captureSteps, requests, namePrefix, v.s3StoreAccessors[0].S3ProfileName)
+	firstRequest := v.kubeObjectsCaptureFirstRequest(captureSteps, requests, namePrefix)
 	if firstRequest == nil {
 		result.Requeue = true
 
@@ -257,6 +415,9 @@ func (v *VRGInstance) kubeObjectsCaptureStartOrResume(
 		return
 	}
 
+	v.kubeObjectsCaptureManifestsUpload(captureNumber, capturePathName, namePrefix, firstRequest.StartTime())
+	v.kubeObjectsCaptureClusterObjectsRecord(requests, namePrefix)
+
 	v.kubeObjectsCaptureComplete(
 		result,
 		captureNumber,
@@ -277,8 +438,6 @@ func (v *VRGInstance) executeCaptureSteps(result *ctrl.Result, pathName, capture
 	failOn := v.recipeElements.CaptureFailOn
 	allEssentialStepsFailed := true
 	essentialStepsCount := 0
-	requestsProcessedCount := 0
-	requestsCompletedCount := 0
 	labels := util.OwnerLabels(v.instance)
 	labels[util.VeleroKubevirtMetadataOnlyBackupLabel] = "true"
 
@@ -287,7 +446,9 @@ func (v *VRGInstance) executeCaptureSteps(result *ctrl.Result, pathName, capture
 
 		var isEssentialStep bool
 
-		var loopCount int
+		var writes *s3Writes
+
+		var complete bool
 
 		cg := captureGroup
 		log1 := log.WithValues("group", groupNumber, "name", cg.Name)
@@ -316,11 +477,11 @@ func (v *VRGInstance) executeCaptureSteps(result *ctrl.Result, pathName, capture
 
 		if !cg.IsHook {
 			isEssentialStep = cg.GroupEssential != nil && *cg.GroupEssential
-			loopCount, err = v.kubeObjectsGroupCapture(
+			cg.ClusterResources = kubeObjectsClusterResources(v.instance)
+			writes, complete, err = v.kubeObjectsGroupCapture(
 				result, cg, pathName, capturePathName, namePrefix, veleroNamespaceName,
 				labels, annotations, requests, log,
 			)
-			requestsCompletedCount += loopCount
 		}
 
 		if err != nil {
@@ -342,12 +503,13 @@ func (v *VRGInstance) executeCaptureSteps(result *ctrl.Result, pathName, capture
 		}
 
 		if !cg.IsHook {
-			requestsProcessedCount += len(v.s3StoreAccessors)
-			if requestsCompletedCount < requestsProcessedCount {
-				log.Info("Kube objects group capturing", "complete", requestsCompletedCount, "total", requestsProcessedCount)
+			if !complete {
+				log.Info("Kube objects group capturing", "complete", len(writes.succeeded), "total", writes.total)
 
 				return allEssentialStepsFailed, fmt.Errorf("kube objects group capturing incomplete")
 			}
+
+			v.s3WritesRecord("Kube objects", writes)
 		}
 	}
 
@@ -358,13 +520,20 @@ func (v *VRGInstance) executeCaptureSteps(result *ctrl.Result, pathName, capture
 	return allEssentialStepsFailed, nil
 }
 
+// kubeObjectsGroupCapture submits, or checks the status of, the group's capture request to each s3 store. The group
+// is captured once captured to all of them, or to a quorum of them with the others failed. Failed captures are
+// deleted, and their requests too so that they are submitted again, unless the group is captured regardless.
 func (v *VRGInstance) kubeObjectsGroupCapture(
 	result *ctrl.Result,
 	captureGroup kubeobjects.CaptureSpec,
 	pathName, capturePathName, namePrefix, veleroNamespaceName string,
 	labels, annotations map[string]string, requests map[string]kubeobjects.Request,
 	log logr.Logger,
-) (requestsCompletedCount int, reqErr error) {
+) (writes *s3Writes, complete bool, reqErr error) {
+	writes = v.s3AccessorWritesNew()
+	processing := 0
+	failed := map[string]kubeobjects.Request{}
+
 	for _, s3StoreAccessor := range v.s3StoreAccessors {
 		requestName := kubeObjectsCaptureName(namePrefix, captureGroup.Name, s3StoreAccessor.S3ProfileName)
 		log1 := log.WithValues("profile", s3StoreAccessor.S3ProfileName)
@@ -379,40 +548,62 @@ func (v *VRGInstance) kubeObjectsGroupCapture(
 				labels, annotations,
 			); err != nil {
 				log1.Error(err, "Kube objects group capture request submit error")
-
-				result.Requeue = true
-				reqErr = fmt.Errorf("kube objects group capture error: %v", err)
+				writes.fail(s3StoreAccessor.S3ProfileName, err)
 
 				continue
 			}
 
 			log1.Info("Kube objects group capture request submitted")
-		} else {
-			err := request.Status(v.log)
-			if err == nil {
-				requestsCompletedCount++
 
-				continue
-			}
+			processing++
 
-			if errors.Is(err, kubeobjects.RequestProcessingError{}) {
-				log1.Info("Kube objects group capturing", "state", err.Error())
+			continue
+		}
 
-				continue
-			}
+		err := request.Status(v.log)
+		if err == nil {
+			writes.succeed(s3StoreAccessor.S3ProfileName)
+
+			continue
+		}
 
-			log1.Error(err, "Kube objects group capture error")
-			v.kubeObjectsCaptureAndCaptureRequestDelete(request, s3StoreAccessor, capturePathName, log1)
-			v.kubeObjectsCaptureStatusFalse("KubeObjectsCaptureError", err.Error())
+		if errors.Is(err, kubeobjects.RequestProcessingError{}) {
+			log1.Info("Kube objects group capturing", "state", err.Error())
 
-			result.Requeue = true
-			reqErr = fmt.Errorf("kube objects group capture error: %v", err)
+			processing++
 
 			continue
 		}
+
+		log1.Error(err, "Kube objects group capture error")
+		v.kubeObjectsCaptureDeleteAndLog(s3StoreAccessor, capturePathName, request.Name(), log1)
+		writes.fail(s3StoreAccessor.S3ProfileName, err)
+		failed[s3StoreAccessor.S3ProfileName] = request
+	}
+
+	complete = writes.all() || writes.quorumMet() && processing == 0
+	if complete {
+		if !writes.all() {
+			log.Info("Kube objects group captured to a quorum of S3 profiles", "writes", writes.String())
+		}
+
+		return writes, complete, nil
 	}
 
-	return requestsCompletedCount, reqErr
+	for _, request := range failed {
+		if err := request.Deallocate(v.ctx, v.reconciler.Client, v.log); err != nil {
+			log.Error(err, "Kube objects capture request deallocate error")
+		}
+	}
+
+	if len(writes.failed) > 0 {
+		v.kubeObjectsCaptureStatusFalse("KubeObjectsCaptureError", writes.err().Error())
+
+		result.Requeue = true
+		reqErr = fmt.Errorf("kube objects group capture error: %w", writes.err())
+	}
+
+	return writes, complete, reqErr
 }
 
 func (v *VRGInstance) kubeObjectsCaptureAndCaptureRequestDelete(
@@ -494,7 +685,13 @@ func (v *VRGInstance) kubeObjectsCaptureIdentifierUpdateComplete(
 	}
 
 	v.reconciler.recipeRetries.Store(v.namespacedName, 0)
-	v.kubeObjectsCaptureStatusTrue(VRGConditionReasonUploaded, kubeObjectsClusterDataProtectedTrueMessage)
+
+	message := kubeObjectsClusterDataProtectedTrueMessage
+	if writes := v.s3Writes["Kube objects"]; writes != nil && !writes.all() {
+		message += " in " + writes.String()
+	}
+
+	v.kubeObjectsCaptureStatusTrue(VRGConditionReasonUploaded, message)
 
 	captureStartTimeSince := time.Since(captureToRecoverFromIdentifier.StartTime.Time)
 	v.log.Info("Kube objects captured", "recovery point", captureToRecoverFromIdentifier,
@@ -569,34 +766,65 @@ func (v *VRGInstance) skipIfS3ProfileIsForTest() bool {
 	return false
 }
 
-func (v *VRGInstance) kubeObjectsRecoverFromS3(result *ctrl.Result, accessor s3StoreAccessor) error {
-	s3ProfileName := accessor.S3ProfileName
+// kubeObjectsRecoverSources returns the s3 stores with a capture to recover kube objects from, the most recent first,
+// and describes why the others are not recovered from, or the preferred one not first
+func (v *VRGInstance) kubeObjectsRecoverSources() ([]s3Source, []string) {
+	sources := make([]s3Source, 0, len(v.s3StoreAccessors))
+	reasons := []string{}
 
-	sourceVrg, err := v.getVRGFromS3Profile(s3ProfileName)
-	if err != nil {
-		return fmt.Errorf("kube objects source VRG get error: %v", err)
+	for _, s3ProfileName := range slices.Sorted(maps.Keys(v.s3StoresInaccessible)) {
+		reasons = append(reasons, fmt.Sprintf("S3 profile %s inaccessible: %v", s3ProfileName,
+			v.s3StoresInaccessible[s3ProfileName]))
+	}
+
+	for _, s3StoreAccessor := range v.s3StoreAccessors {
+		sourceVrg, err := v.getVRGFromS3Profile(s3StoreAccessor.S3ProfileName)
+		if err != nil {
+			reasons = append(reasons, fmt.Sprintf("S3 profile %s source VRG get error: %v",
+				s3StoreAccessor.S3ProfileName, err))
+
+			continue
+		}
+
+		captureToRecoverFromIdentifier := sourceVrg.Status.KubeObjectProtection.CaptureToRecoverFrom
+		if captureToRecoverFromIdentifier == nil {
+			reasons = append(reasons, fmt.Sprintf("S3 profile %s source VRG has no capture to recover from",
+				s3StoreAccessor.S3ProfileName))
+
+			continue
+		}
+
+		sources = append(sources, s3Source{s3StoreAccessor, captureToRecoverFromIdentifier.StartTime, sourceVrg})
 	}
 
-	captureToRecoverFromIdentifier := sourceVrg.Status.KubeObjectProtection.CaptureToRecoverFrom
-	if captureToRecoverFromIdentifier == nil {
-		return fmt.Errorf("kube objects source VRG capture-to-recover-from identifier nil: %v", err)
+	if reason := s3SourcesOrder(sources); reason != "" {
+		reasons = append(reasons, reason)
 	}
 
+	return sources, reasons
+}
+
+func (v *VRGInstance) kubeObjectsRecoverFromS3(result *ctrl.Result, source s3Source) error {
+	s3ProfileName := source.S3ProfileName
+	captureToRecoverFromIdentifier := source.vrg.Status.KubeObjectProtection.CaptureToRecoverFrom
+
 	v.instance.Status.KubeObjectProtection.CaptureToRecoverFrom = captureToRecoverFromIdentifier
 	log := v.log.WithValues("number", captureToRecoverFromIdentifier.Number, "profile", s3ProfileName)
 
 	return v.kubeObjectsRecoveryStartOrResume(result, s3ProfileName, captureToRecoverFromIdentifier, log)
 }
 
-func (v *VRGInstance) kubeObjectsRecover(result *ctrl.Result) error {
+// kubeObjectsRecover recovers kube objects from the s3 store with the most recent capture, or the next most recent
+// one if it fails, and returns which one, and why, once recovered
+func (v *VRGInstance) kubeObjectsRecover(result *ctrl.Result) (string, error) {
 	if v.kubeObjectProtectionDisabled("recovery") {
-		return nil
+		return "", nil
 	}
 
 	if v.instance.Spec.Action == "" {
 		v.log.Info("Skipping kube objects restore in fresh deployment case")
 
-		return nil
+		return "", nil
 	}
 
 	if len(v.s3StoreAccessors) == 0 {
@@ -604,28 +832,32 @@ func (v *VRGInstance) kubeObjectsRecover(result *ctrl.Result) error {
 
 		result.Requeue = true
 
-		return fmt.Errorf("no S3Profiles configured")
+		return "", fmt.Errorf("no S3Profiles configured")
 	}
 
 	if v.skipIfS3ProfileIsForTest() {
-		return nil
+		return "", nil
 	}
 
-	for _, s3StoreAccessor := range v.s3StoreAccessors {
-		if err := v.kubeObjectsRecoverFromS3(result, s3StoreAccessor); err != nil {
-			v.log.Info("Kube objects restore error", "profile", s3StoreAccessor.S3ProfileName, "error", err)
+	sources, reasons := v.kubeObjectsRecoverSources()
+
+	for _, source := range sources {
+		if err := v.kubeObjectsRecoverFromS3(result, source); err != nil {
+			v.log.Info("Kube objects restore error", "profile", source.S3ProfileName, "error", err)
+			reasons = append(reasons, fmt.Sprintf("S3 profile %s restore error: %v", source.S3ProfileName, err))
 
 			continue
 		}
 
-		v.log.Info("Kube objects restore complete", "profile", s3StoreAccessor.S3ProfileName)
+		v.log.Info("Kube objects restore complete", "profile", source.S3ProfileName)
+		v.kubeObjectsRecoveredReadinessWaitStart()
 
-		return nil
+		return s3SourceMessage("Kube objects restored", source.S3ProfileName, reasons), nil
 	}
 
 	result.Requeue = true
 
-	return fmt.Errorf("kube objects restore error, will retry")
+	return "", fmt.Errorf("kube objects restore error, will retry: %s", strings.Join(reasons, "; "))
 }
 
 func (v *VRGInstance) findS3StoreAccessor(s3ProfileName string) (s3StoreAccessor, error) {
@@ -737,6 +969,8 @@ func (v *VRGInstance) kubeObjectsRecoveryStartOrResume(
 	startTime := getRequestsStartTime(requests)
 	duration := time.Since(startTime.Time)
 	log.Info("Kube objects recovered", "groups", len(steps), "start", startTime, "duration", duration)
+	v.instance.Status.KubeObjectProtection.RecoverStartTime = &startTime
+	v.kubeObjectsClusterObjectsRecord(requests)
 
 	return v.kubeObjectsRecoverRequestsDelete(result, v.veleroNamespaceName(), labels)
 }
@@ -837,6 +1071,17 @@ func (v *VRGInstance) executeRecoverGroup(result *ctrl.Result, s3StoreAccessor s
 ) error {
 	sourceVrgName := v.instance.Name
 	sourceVrgNamespaceName := v.instance.Namespace
+
+	transforms, err := kubeObjectsRecoverTransforms(v.instance)
+	if err != nil {
+		log1.Error(err, "Kube objects group recover transforms error")
+
+		return err
+	}
+
+	rg.Transforms = transforms
+	rg.ClusterResources = kubeObjectsClusterResources(v.instance)
+
 	request, ok, submit, cleanup := v.getRecoverOrProtectRequest(
 		captureRequests, recoverRequests, s3StoreAccessor,
 		sourceVrgNamespaceName, sourceVrgName,
@@ -844,14 +1089,15 @@ func (v *VRGInstance) executeRecoverGroup(result *ctrl.Result, s3StoreAccessor s
 		groupNumber, rg, labels, log1,
 	)
 
-	var err error
-
 	if !ok {
-		_, err = submit()
+		err = v.kubeObjectsRecoverGroupWait(result, rg.BackupName, rg.WaitFor, log1)
 		if err == nil {
-			log1.Info("Kube objects group recover request submitted")
+			_, err = submit()
+			if err == nil {
+				log1.Info("Kube objects group recover request submitted")
 
-			return errors.New("kube objects group recover request submitted")
+				return errors.New("kube objects group recover request submitted")
+			}
 		}
 	} else {
 		err = request.Status(v.log)
@@ -872,6 +1118,7 @@ func (v *VRGInstance) executeRecoverGroup(result *ctrl.Result, s3StoreAccessor s
 	log1.Error(err, "Kube objects group recover error")
 
 	if ok {
+		v.kubeObjectsClusterObjectsRecord([]kubeobjects.Request{request})
 		cleanup(request)
 	}
 
@@ -880,6 +1127,55 @@ func (v *VRGInstance) executeRecoverGroup(result *ctrl.Result, s3StoreAccessor s
 	return err
 }
 
+// kubeObjectsRecoverTransforms returns the VRG's recover transforms, with the parameters of the cluster that the
+// kube objects are recovered to expanded
+func kubeObjectsRecoverTransforms(vrg *ramen.VolumeReplicationGroup) ([]kubeobjects.Transform, error) {
+	clusterName := vrg.GetAnnotations()[DestinationClusterAnnotationKey]
+	transforms := make([]kubeobjects.Transform, 0, len(vrg.Spec.KubeObjectProtection.RecoverTransforms))
+
+	for _, recoverTransform := range vrg.Spec.KubeObjectProtection.RecoverTransforms {
+		parameters := recoverTransform.ClusterParameters[clusterName]
+		transform := kubeobjects.Transform{
+			Name:          recoverTransform.Name,
+			Group:         recoverTransform.Group,
+			Kind:          recoverTransform.Kind,
+			LabelSelector: recoverTransform.LabelSelector,
+			PatchType:     kubeobjects.TransformPatchType(recoverTransform.PatchType),
+			Patch:         expandTransformParameters(recoverTransform.Patch, parameters),
+		}
+
+		if err := transform.Validate(); err != nil {
+			return nil, err
+		}
+
+		transforms = append(transforms, transform)
+	}
+
+	return transforms, nil
+}
+
+// transformParameterRegexp matches a parameter reference in a transform patch, as $name or ${name}
+var transformParameterRegexp = regexp.MustCompile(`\$\{([A-Za-z_][A-Za-z0-9_]*)\}|\$([A-Za-z_][A-Za-z0-9_]*)`)
+
+// expandTransformParameters replaces the references to the passed in parameters in a transform patch with their
+// values. Any other $ sequence, such as a reference to an undeclared parameter, is left as is.
+func expandTransformParameters(patch string, parameters map[string]string) string {
+	return transformParameterRegexp.ReplaceAllStringFunc(patch, func(reference string) string {
+		submatches := transformParameterRegexp.FindStringSubmatch(reference)
+
+		name := submatches[1]
+		if name == "" {
+			name = submatches[2]
+		}
+
+		if value, ok := parameters[name]; ok {
+			return value
+		}
+
+		return reference
+	})
+}
+
 func (v *VRGInstance) kubeObjectsRecoverRequestsDelete(
 	result *ctrl.Result, veleroNamespaceName string, labels map[string]string,
 ) error {
@@ -903,6 +1199,11 @@ func (v *VRGInstance) veleroNamespaceName() string {
 		return v.ramenConfig.KubeObjectProtection.VeleroNamespaceName
 	}
 
+	// native requests do not need velero to be installed, so keep them with the operator
+	if kubeObjectProtectionProviderOrDefault(v.ramenConfig) == ramen.KubeObjectProtectionProviderNative {
+		return RamenOperatorNamespace()
+	}
+
 	return VeleroNamespaceNameDefault
 }
 
@@ -923,6 +1224,8 @@ func (v *VRGInstance) kubeObjectProtectionDisabled(caller string) bool {
 }
 
 func (v *VRGInstance) kubeObjectsProtectionDelete(result *ctrl.Result) error {
+	v.kubeObjectsChangeTrackerStop()
+
 	if v.kubeObjectProtectionDisabled("deletion") {
 		return nil
 	}
@@ -936,6 +1239,59 @@ func (v *VRGInstance) kubeObjectsProtectionDelete(result *ctrl.Result) error {
 	)
 }
 
+func (r *VolumeReplicationGroupReconciler) kubeObjectsRequestsManagerSet(
+	mgr ctrl.Manager, ramenConfig *ramen.RamenConfig,
+) error {
+	if kubeObjectProtectionProviderOrDefault(ramenConfig) != ramen.KubeObjectProtectionProviderNative {
+		r.kubeObjects = velero.RequestsManager{}
+
+		return nil
+	}
+
+	r.Log.Info("Kube objects are protected natively")
+
+	discoveryClient, err := discovery.NewDiscoveryClientForConfig(mgr.GetConfig())
+	if err != nil {
+		return fmt.Errorf("kube objects discovery client create: %w", err)
+	}
+
+	dynamicClient, err := dynamic.NewForConfig(mgr.GetConfig())
+	if err != nil {
+		return fmt.Errorf("kube objects dynamic client create: %w", err)
+	}
+
+	r.kubeObjects = native.RequestsManager{
+		Discovery:       discoveryClient,
+		ObjectStorerGet: r.kubeObjectsObjectStorerGet,
+		Dynamic:         dynamicClient,
+	}
+
+	return nil
+}
+
+// kubeObjectsObjectStorerGet returns the object store of the s3 profile with the endpoint and bucket
+func (r *VolumeReplicationGroupReconciler) kubeObjectsObjectStorerGet(
+	ctx context.Context, s3Url, s3BucketName string, log logr.Logger,
+) (native.ObjectStorer, error) {
+	_, ramenConfig, err := ConfigMapGet(ctx, r.APIReader)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, s3StoreProfile := range ramenConfig.S3StoreProfiles {
+		if s3StoreProfile.S3CompatibleEndpoint != s3Url || s3StoreProfile.S3Bucket != s3BucketName {
+			continue
+		}
+
+		objectStorer, _, err := r.ObjStoreGetter.ObjectStore(
+			ctx, r.APIReader, s3StoreProfile.S3ProfileName, "kube objects", log)
+
+		return objectStorer, err
+	}
+
+	return nil, fmt.Errorf("s3 profile with endpoint %s and bucket %s not found in RamenConfig", s3Url, s3BucketName)
+}
+
 func kubeObjectsRequestsWatch(
 	b *builder.Builder, scheme *runtime.Scheme, kubeObjects kubeobjects.RequestsManager,
 ) *builder.Builder {
@@ -1288,6 +1644,20 @@ func convertRecipeGroupToCaptureSpec(group Recipe.Group) (*kubeobjects.CaptureSp
 	return &captureSpec, nil
 }
 
+// kubeObjectsCaptureFirstRequest returns the first group's request of the first s3 store that it is complete for
+func (v *VRGInstance) kubeObjectsCaptureFirstRequest(captureSteps []kubeobjects.CaptureSpec,
+	requests map[string]kubeobjects.Request, namePrefix string,
+) kubeobjects.Request {
+	for _, s3StoreAccessor := range v.s3StoreAccessors {
+		request := getFirstRequest(captureSteps, requests, namePrefix, s3StoreAccessor.S3ProfileName)
+		if request != nil && request.Status(v.log) == nil {
+			return request
+		}
+	}
+
+	return nil
+}
+
 func getFirstRequest(groups []kubeobjects.CaptureSpec, requests map[string]kubeobjects.Request,
 	namePrefix string, s3ProfileName string,
 ) kubeobjects.Request {
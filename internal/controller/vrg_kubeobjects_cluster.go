@@ -0,0 +1,143 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+
+	ramen "github.com/ramendr/ramen/api/v1alpha1"
+	"github.com/ramendr/ramen/internal/controller/kubeobjects"
+	"github.com/ramendr/ramen/internal/controller/util"
+)
+
+// kubeObjectsClusterResources returns the VRG's selection of the cluster scoped kube objects to protect, if any
+func kubeObjectsClusterResources(vrg *ramen.VolumeReplicationGroup) *kubeobjects.ClusterResourcesSpec {
+	clusterResources := vrg.Spec.KubeObjectProtection.ClusterResources
+	if clusterResources == nil {
+		return nil
+	}
+
+	return &kubeobjects.ClusterResourcesSpec{
+		Resources:      clusterResources.Resources,
+		LabelSelector:  clusterResources.LabelSelector,
+		Referenced:     clusterResources.Referenced == nil || *clusterResources.Referenced,
+		ConflictPolicy: kubeobjects.ConflictPolicy(clusterResources.ConflictPolicy),
+	}
+}
+
+// kubeObjectsCaptureClusterObjectsRecord records the cluster scoped kube objects of a capture, as captured by the
+// first s3 store that each group's capture is complete for
+func (v *VRGInstance) kubeObjectsCaptureClusterObjectsRecord(requests map[string]kubeobjects.Request,
+	namePrefix string,
+) {
+	groupRequests := []kubeobjects.Request{}
+
+	for _, captureGroup := range v.recipeElements.CaptureWorkflow {
+		if captureGroup.IsHook {
+			continue
+		}
+
+		for _, s3StoreAccessor := range v.s3StoreAccessors {
+			request := requests[kubeObjectsCaptureName(namePrefix, captureGroup.Name, s3StoreAccessor.S3ProfileName)]
+			if request != nil && request.Status(v.log) == nil {
+				groupRequests = append(groupRequests, request)
+
+				break
+			}
+		}
+	}
+
+	v.kubeObjectsClusterObjectsRecord(groupRequests)
+}
+
+// kubeObjectsClusterObjectsRecord records the cluster scoped kube objects that requests captured or recovered, once
+// each, and reports those that conflict with the ones that exist already. Those that a recovery created remain
+// recorded as such, so that they are released when the app is cleaned up, even once captured since.
+func (v *VRGInstance) kubeObjectsClusterObjectsRecord(requests []kubeobjects.Request) {
+	clusterObjects := []ramen.KubeObjectsClusterObjectStatus{}
+	recorded := map[string]struct{}{}
+	conflicts := []string{}
+
+	for _, clusterObject := range v.instance.Status.KubeObjectProtection.ClusterObjects {
+		if clusterObject.Result != ramen.KubeObjectsClusterObjectResult(kubeobjects.ClusterObjectCreated) {
+			continue
+		}
+
+		recorded[clusterObject.Resource+"/"+clusterObject.Name] = struct{}{}
+		clusterObjects = append(clusterObjects, clusterObject)
+	}
+
+	for _, request := range requests {
+		reporter, ok := request.(kubeobjects.ClusterObjectsReporter)
+		if !ok {
+			continue
+		}
+
+		for _, clusterObject := range reporter.ClusterObjects() {
+			key := clusterObject.Resource + "/" + clusterObject.Name
+			if _, ok := recorded[key]; ok {
+				continue
+			}
+
+			recorded[key] = struct{}{}
+			clusterObjects = append(clusterObjects, ramen.KubeObjectsClusterObjectStatus{
+				Resource: clusterObject.Resource,
+				Name:     clusterObject.Name,
+				Result:   ramen.KubeObjectsClusterObjectResult(clusterObject.Result),
+				Message:  clusterObject.Message,
+			})
+
+			if clusterObject.Result == kubeobjects.ClusterObjectConflict {
+				conflicts = append(conflicts, key+" "+clusterObject.Message)
+			}
+		}
+	}
+
+	v.instance.Status.KubeObjectProtection.ClusterObjects = clusterObjects
+
+	if len(conflicts) > 0 {
+		util.ReportIfNotPresent(v.reconciler.eventRecorder, v.instance, corev1.EventTypeWarning,
+			util.EventReasonKubeObjectsConflict,
+			fmt.Sprintf("%d recovered cluster scoped kube objects exist already and differ: %s", len(conflicts),
+				strings.Join(conflicts, "; ")))
+	}
+}
+
+// kubeObjectsClusterObjectsRelease releases the cluster scoped kube objects that the VRG captured or recovered, as the
+// app is cleaned up from the cluster, so that those that no other app depends on are deleted with it. It returns
+// whether to requeue.
+func (v *VRGInstance) kubeObjectsClusterObjectsRelease() bool {
+	vrgClusterObjects := v.instance.Status.KubeObjectProtection.ClusterObjects
+	if len(vrgClusterObjects) == 0 {
+		return false
+	}
+
+	if releaser, ok := v.reconciler.kubeObjects.(kubeobjects.ClusterObjectsReleasingRequestsManager); ok {
+		clusterObjects := make([]kubeobjects.ClusterObject, 0, len(vrgClusterObjects))
+
+		for _, clusterObject := range vrgClusterObjects {
+			clusterObjects = append(clusterObjects, kubeobjects.ClusterObject{
+				Resource: clusterObject.Resource,
+				Name:     clusterObject.Name,
+				Result:   kubeobjects.ClusterObjectResult(clusterObject.Result),
+			})
+		}
+
+		if err := releaser.ClusterObjectsRelease(v.ctx, v.reconciler.Client, v.log, util.OwnerLabels(v.instance),
+			clusterObjects,
+		); err != nil {
+			v.log.Error(err, "Cluster scoped kube objects release error")
+
+			return true
+		}
+	}
+
+	v.log.Info("Cluster scoped kube objects released", "count", len(vrgClusterObjects))
+	v.instance.Status.KubeObjectProtection.ClusterObjects = nil
+
+	return false
+}
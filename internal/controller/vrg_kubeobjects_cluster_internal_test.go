@@ -0,0 +1,47 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	ramen "github.com/ramendr/ramen/api/v1alpha1"
+	"github.com/ramendr/ramen/internal/controller/kubeobjects"
+)
+
+type clusterObjectsReportingRequest struct {
+	kubeobjects.Request
+	clusterObjects []kubeobjects.ClusterObject
+}
+
+func (r clusterObjectsReportingRequest) ClusterObjects() []kubeobjects.ClusterObject {
+	return r.clusterObjects
+}
+
+var _ = Describe("Kube objects cluster scoped objects record", func() {
+	It("keeps the objects that a recovery created recorded as created once captured", func() {
+		v := &VRGInstance{instance: &ramen.VolumeReplicationGroup{
+			Status: ramen.VolumeReplicationGroupStatus{
+				KubeObjectProtection: ramen.KubeObjectProtectionStatus{
+					ClusterObjects: []ramen.KubeObjectsClusterObjectStatus{
+						{Resource: "storageclasses.storage.k8s.io", Name: "fast", Result: "Created"},
+						{Resource: "storageclasses.storage.k8s.io", Name: "slow", Result: "Unchanged"},
+					},
+				},
+			},
+		}}
+
+		v.kubeObjectsClusterObjectsRecord([]kubeobjects.Request{clusterObjectsReportingRequest{
+			clusterObjects: []kubeobjects.ClusterObject{
+				{Resource: "storageclasses.storage.k8s.io", Name: "fast", Result: kubeobjects.ClusterObjectCaptured},
+				{Resource: "storageclasses.storage.k8s.io", Name: "slow", Result: kubeobjects.ClusterObjectCaptured},
+			},
+		}})
+		Expect(v.instance.Status.KubeObjectProtection.ClusterObjects).To(ConsistOf(
+			ramen.KubeObjectsClusterObjectStatus{Resource: "storageclasses.storage.k8s.io", Name: "fast", Result: "Created"},
+			ramen.KubeObjectsClusterObjectStatus{Resource: "storageclasses.storage.k8s.io", Name: "slow", Result: "Captured"},
+		))
+	})
+})
@@ -0,0 +1,157 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	ramen "github.com/ramendr/ramen/api/v1alpha1"
+	"github.com/ramendr/ramen/internal/controller/kubeobjects"
+	"github.com/ramendr/ramen/internal/controller/util"
+)
+
+const (
+	kubeObjectsCaptureManifestKeySuffix = "manifest"
+	kubeObjectsRemovedSummaryLimit      = 10
+)
+
+// kubeObjectsCaptureManifest lists the kube objects of a capture, of all its groups, and how they differ from those
+// of the previous capture. It is stored next to the capture in each s3 store. Changes captured incrementally after
+// the capture are not listed.
+type kubeObjectsCaptureManifest struct {
+	Number    int64                       `json:"number"`
+	StartTime metav1.Time                 `json:"startTime"`
+	Objects   kubeobjects.CapturedObjects `json:"objects"`
+	Counts    map[string]int              `json:"counts"`
+
+	PreviousNumber *int64                      `json:"previousNumber,omitempty"`
+	Added          kubeobjects.CapturedObjects `json:"added,omitempty"`
+	Removed        kubeobjects.CapturedObjects `json:"removed,omitempty"`
+}
+
+func (m *kubeObjectsCaptureManifest) summary() *ramen.KubeObjectsCaptureManifestSummary {
+	return &ramen.KubeObjectsCaptureManifestSummary{
+		Number:       m.Number,
+		ObjectCount:  m.Objects.Count(),
+		KindCounts:   m.Counts,
+		AddedCount:   m.Added.Count(),
+		RemovedCount: m.Removed.Count(),
+		Removed:      m.Removed.Strings(kubeObjectsRemovedSummaryLimit),
+	}
+}
+
+func kubeObjectsCaptureManifestKey(namespaceName, vrgName string, captureNumber int64,
+	kubeObjects kubeobjects.RequestsManager,
+) string {
+	pathName, _, _ := kubeObjectsCapturePathNamesAndNamePrefix(namespaceName, vrgName, captureNumber, kubeObjects)
+
+	return pathName + kubeObjectsCaptureManifestKeySuffix
+}
+
+// kubeObjectsCaptureManifestsUpload uploads the manifest of a completed capture to each s3 store, and summarizes the
+// first one in the status. Errors are logged only, since the capture is complete regardless.
+func (v *VRGInstance) kubeObjectsCaptureManifestsUpload(
+	captureNumber int64, capturePathName, namePrefix string, startTime metav1.Time,
+) {
+	vrg := v.instance
+	previous := vrg.Status.KubeObjectProtection.CaptureToRecoverFrom
+	key := kubeObjectsCaptureManifestKey(vrg.Namespace, vrg.Name, captureNumber, v.reconciler.kubeObjects)
+
+	var summary *ramen.KubeObjectsCaptureManifestSummary
+
+	for _, s3StoreAccessor := range v.s3StoreAccessors {
+		log := v.log.WithValues("number", captureNumber, "profile", s3StoreAccessor.S3ProfileName)
+
+		manifest, err := v.kubeObjectsCaptureManifest(s3StoreAccessor, captureNumber, capturePathName, namePrefix,
+			startTime)
+		if err != nil {
+			log.Error(err, "Kube objects capture manifest error")
+
+			continue
+		}
+
+		if previous != nil && previous.Number != captureNumber {
+			v.kubeObjectsCaptureManifestDiff(manifest, s3StoreAccessor, previous.Number, log)
+		}
+
+		if err := s3StoreAccessor.ObjectStorer.UploadObject(key, manifest); err != nil {
+			log.Error(err, "Kube objects capture manifest upload error")
+
+			continue
+		}
+
+		log.Info("Kube objects capture manifest uploaded", "objects", manifest.Objects.Count(),
+			"added", manifest.Added.Count(), "removed", manifest.Removed.Count())
+
+		if summary == nil {
+			summary = manifest.summary()
+		}
+	}
+
+	vrg.Status.KubeObjectProtection.CaptureManifest = summary
+
+	if summary != nil && summary.RemovedCount > 0 {
+		util.ReportIfNotPresent(v.reconciler.eventRecorder, vrg, corev1.EventTypeWarning,
+			util.EventReasonKubeObjectsRemoved,
+			fmt.Sprintf("%d kube objects captured previously are not captured by capture %d: %s",
+				summary.RemovedCount, captureNumber, strings.Join(summary.Removed, ", ")))
+	}
+}
+
+// kubeObjectsCaptureManifest lists the objects captured by the groups of a capture to an s3 store
+func (v *VRGInstance) kubeObjectsCaptureManifest(
+	s3StoreAccessor s3StoreAccessor, captureNumber int64, capturePathName, namePrefix string, startTime metav1.Time,
+) (*kubeObjectsCaptureManifest, error) {
+	manifest := &kubeObjectsCaptureManifest{
+		Number:    captureNumber,
+		StartTime: startTime,
+		Objects:   kubeobjects.CapturedObjects{},
+	}
+
+	for _, captureGroup := range v.recipeElements.CaptureWorkflow {
+		if captureGroup.IsHook {
+			continue
+		}
+
+		requestName := kubeObjectsCaptureName(namePrefix, captureGroup.Name, s3StoreAccessor.S3ProfileName)
+		key := capturePathName + v.reconciler.kubeObjects.CapturedObjectsKey(requestName)
+		capturedObjects := kubeobjects.CapturedObjects{}
+
+		if err := s3StoreAccessor.ObjectStorer.DownloadObject(key, &capturedObjects); err != nil {
+			return nil, fmt.Errorf("group %s captured objects download: %w", captureGroup.Name, err)
+		}
+
+		manifest.Objects.Merge(capturedObjects)
+	}
+
+	manifest.Counts = manifest.Objects.Counts()
+
+	return manifest, nil
+}
+
+// kubeObjectsCaptureManifestDiff records the objects added and removed since the previous capture, if its manifest
+// is found
+func (v *VRGInstance) kubeObjectsCaptureManifestDiff(
+	manifest *kubeObjectsCaptureManifest, s3StoreAccessor s3StoreAccessor, previousNumber int64, log logr.Logger,
+) {
+	vrg := v.instance
+	key := kubeObjectsCaptureManifestKey(vrg.Namespace, vrg.Name, previousNumber, v.reconciler.kubeObjects)
+	previous := &kubeObjectsCaptureManifest{}
+
+	if err := s3StoreAccessor.ObjectStorer.DownloadObject(key, previous); err != nil {
+		log.Info("Kube objects previous capture manifest not found; no diff", "previous", previousNumber,
+			"error", err.Error())
+
+		return
+	}
+
+	manifest.PreviousNumber = &previousNumber
+	manifest.Added = manifest.Objects.Difference(previous.Objects)
+	manifest.Removed = previous.Objects.Difference(manifest.Objects)
+}
@@ -0,0 +1,409 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+// +kubebuilder:rbac:groups=apps,resources=deployments;replicasets;statefulsets;daemonsets,verbs=delete
+
+package controllers
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ramen "github.com/ramendr/ramen/api/v1alpha1"
+	"github.com/ramendr/ramen/internal/controller/kubeobjects"
+	"github.com/ramendr/ramen/internal/controller/util"
+)
+
+var namespaceGroupVersionKind = corev1.SchemeGroupVersion.WithKind("Namespace")
+
+const (
+	kubeObjectsRecoverReadinessCheckInterval = 15 * time.Second
+	kubeObjectsNotReadySummaryLimit          = 5
+)
+
+func (v *VRGInstance) kubeObjectsRecoverReadiness() *ramen.KubeObjectsRecoverReadinessSpec {
+	if v.instance.Spec.KubeObjectProtection == nil {
+		return nil
+	}
+
+	return v.instance.Spec.KubeObjectProtection.RecoverReadiness
+}
+
+func kubeObjectsRecoverReadinessTimeout(readiness *ramen.KubeObjectsRecoverReadinessSpec) time.Duration {
+	if readiness.Timeout == nil {
+		return ramen.KubeObjectsRecoverReadinessTimeoutDefault
+	}
+
+	return readiness.Timeout.Duration
+}
+
+func kubeObjectsReadinessRules(readiness *ramen.KubeObjectsRecoverReadinessSpec) []kubeobjects.ReadinessRule {
+	rules := make([]kubeobjects.ReadinessRule, 0, len(readiness.Rules))
+
+	for _, rule := range readiness.Rules {
+		rules = append(rules, kubeobjects.ReadinessRule{
+			Group:              rule.Group,
+			Kind:               rule.Kind,
+			ConditionType:      rule.ConditionType,
+			ReadyReplicasField: rule.ReadyReplicasField,
+			ReplicasField:      rule.ReplicasField,
+			Ignore:             rule.Ignore,
+		})
+	}
+
+	return rules
+}
+
+// kubeObjectsRecoveredReadinessWaitStart starts waiting for the kube objects just restored to be ready, if the VRG
+// asks for it
+func (v *VRGInstance) kubeObjectsRecoveredReadinessWaitStart() {
+	if v.kubeObjectsRecoverReadiness() == nil {
+		return
+	}
+
+	setVRGKubeObjectsRecoveredCondition(&v.instance.Status.Conditions, v.instance.Generation,
+		metav1.ConditionUnknown, VRGConditionReasonKubeObjectsWaiting,
+		"Waiting for recovered kube objects to be ready")
+}
+
+// kubeObjectsRecoveredRolledBack returns whether the recovered kube objects were rolled back for the VRG's current
+// generation, in which case they are not recovered again until it changes
+func (v *VRGInstance) kubeObjectsRecoveredRolledBack() bool {
+	condition := util.FindCondition(v.instance.Status.Conditions, VRGConditionTypeKubeObjectsRecovered)
+
+	return condition != nil && condition.Reason == VRGConditionReasonKubeObjectsRolledBack &&
+		condition.ObservedGeneration == v.instance.Generation
+}
+
+// kubeObjectsRecoveredReady returns whether the recovery of the kube objects is complete, i.e. whether they are ready,
+// their readiness is not waited for, or they are kept regardless. If not, the VRG is requeued as needed.
+func (v *VRGInstance) kubeObjectsRecoveredReady(result *ctrl.Result) bool {
+	readiness := v.kubeObjectsRecoverReadiness()
+	if readiness == nil {
+		meta.RemoveStatusCondition(&v.instance.Status.Conditions, VRGConditionTypeKubeObjectsRecovered)
+
+		return true
+	}
+
+	condition := util.FindCondition(v.instance.Status.Conditions, VRGConditionTypeKubeObjectsRecovered)
+	if condition == nil {
+		return true
+	}
+
+	switch condition.Reason {
+	case VRGConditionReasonKubeObjectsWaiting:
+	case VRGConditionReasonKubeObjectsRolledBack, VRGConditionReasonKubeObjectsRetrying:
+		return false
+	default:
+		return true
+	}
+
+	log := v.log.WithName("KubeObjectsReadiness")
+
+	capturedObjects, err := v.kubeObjectsRecoveredObjects()
+	if err != nil {
+		log.Info("Recovered kube objects readiness not checked", "error", err.Error())
+		setVRGKubeObjectsRecoveredCondition(&v.instance.Status.Conditions, v.instance.Generation,
+			metav1.ConditionTrue, VRGConditionReasonKubeObjectsUnchecked,
+			fmt.Sprintf("Recovered kube objects readiness not checked: %v", err))
+
+		return true
+	}
+
+	notReady, err := v.kubeObjectsNotReady(capturedObjects, kubeObjectsReadinessRules(readiness))
+	if err != nil {
+		log.Error(err, "Recovered kube objects readiness check error")
+
+		result.Requeue = true
+
+		return false
+	}
+
+	if len(notReady) == 0 {
+		log.Info("Recovered kube objects ready", "objects", capturedObjects.Count())
+		setVRGKubeObjectsRecoveredCondition(&v.instance.Status.Conditions, v.instance.Generation,
+			metav1.ConditionTrue, VRGConditionReasonReady, "Recovered kube objects are ready")
+
+		return true
+	}
+
+	message := kubeObjectsNotReadyMessage(notReady)
+
+	delay := kubeObjectsRecoverReadinessTimeout(readiness) - time.Since(condition.LastTransitionTime.Time)
+	if delay > 0 {
+		log.Info("Waiting for recovered kube objects to be ready", "notReady", len(notReady), "timeout", delay)
+		setVRGKubeObjectsRecoveredCondition(&v.instance.Status.Conditions, v.instance.Generation,
+			metav1.ConditionUnknown, VRGConditionReasonKubeObjectsWaiting, message)
+		delaySetIfLess(result, min(delay, kubeObjectsRecoverReadinessCheckInterval), log)
+
+		return false
+	}
+
+	return v.kubeObjectsRecoveredNotReadyInTime(readiness.FailurePolicy, capturedObjects, message, result, log)
+}
+
+// kubeObjectsRecoveredNotReadyInTime applies the failure policy to recovered kube objects that are not ready in time
+func (v *VRGInstance) kubeObjectsRecoveredNotReadyInTime(failurePolicy ramen.KubeObjectsRecoverFailurePolicy,
+	capturedObjects kubeobjects.CapturedObjects, message string, result *ctrl.Result, log logr.Logger,
+) bool {
+	vrg := v.instance
+
+	log.Info("Recovered kube objects not ready in time", "policy", failurePolicy, "message", message)
+	util.ReportIfNotPresent(v.reconciler.eventRecorder, vrg, corev1.EventTypeWarning,
+		util.EventReasonKubeObjectsNotReady,
+		fmt.Sprintf("Recovered kube objects not ready in time, failure policy %s: %s", failurePolicy, message))
+
+	switch failurePolicy {
+	case ramen.KubeObjectsRecoverFailurePolicyKeep:
+		setVRGKubeObjectsRecoveredCondition(&vrg.Status.Conditions, vrg.Generation,
+			metav1.ConditionFalse, VRGConditionReasonKubeObjectsNotReady, message+"; kept")
+
+		return true
+	case ramen.KubeObjectsRecoverFailurePolicyRollback:
+		if err := v.kubeObjectsRecoveredDelete(capturedObjects, log); err != nil {
+			log.Error(err, "Recovered kube objects rollback error")
+
+			result.Requeue = true
+
+			return false
+		}
+
+		setVRGKubeObjectsRecoveredCondition(&vrg.Status.Conditions, vrg.Generation,
+			metav1.ConditionFalse, VRGConditionReasonKubeObjectsRolledBack, message+"; rolled back")
+		setVRGKubeObjectsErrorCondition(&vrg.Status.Conditions, vrg.Generation,
+			"Recovered kube objects rolled back as they were not ready in time")
+
+		return false
+	default:
+		setVRGKubeObjectsRecoveredCondition(&vrg.Status.Conditions, vrg.Generation,
+			metav1.ConditionFalse, VRGConditionReasonKubeObjectsRetrying, message+"; restoring again")
+		setVRGKubeObjectsErrorCondition(&vrg.Status.Conditions, vrg.Generation,
+			"Recovered kube objects not ready in time; restoring again")
+
+		result.Requeue = true
+
+		return false
+	}
+}
+
+// kubeObjectsRecoveredObjects returns the objects of the capture recovered from, as listed by its manifest in the
+// first s3 store that has it
+func (v *VRGInstance) kubeObjectsRecoveredObjects() (kubeobjects.CapturedObjects, error) {
+	vrg := v.instance
+
+	captureToRecoverFrom := vrg.Status.KubeObjectProtection.CaptureToRecoverFrom
+	if captureToRecoverFrom == nil {
+		return nil, fmt.Errorf("capture recovered from unknown")
+	}
+
+	key := kubeObjectsCaptureManifestKey(vrg.Namespace, vrg.Name, captureToRecoverFrom.Number,
+		v.reconciler.kubeObjects)
+	errs := []string{}
+
+	for _, s3StoreAccessor := range v.s3StoreAccessors {
+		manifest := &kubeObjectsCaptureManifest{}
+
+		err := s3StoreAccessor.ObjectStorer.DownloadObject(key, manifest)
+		if err == nil {
+			return manifest.Objects, nil
+		}
+
+		errs = append(errs, fmt.Sprintf("%s: %v", s3StoreAccessor.S3ProfileName, err))
+	}
+
+	return nil, fmt.Errorf("capture %d manifest download: %s", captureToRecoverFrom.Number, strings.Join(errs, "; "))
+}
+
+// kubeObjectsNotReady returns the recovered objects that are not ready, and why. Objects not found are not recovered,
+// e.g. not selected by a recover group, so are not waited for, nor are objects of kinds the operator may not read.
+func (v *VRGInstance) kubeObjectsNotReady(
+	capturedObjects kubeobjects.CapturedObjects, rules []kubeobjects.ReadinessRule,
+) ([]string, error) {
+	notReady := []string{}
+
+	err := v.kubeObjectsRecoveredVisit(capturedObjects, func(kind, name string, object *unstructured.Unstructured) error {
+		if found, err := v.kubeObjectRecoveredGet(kind, name, object); err != nil || !found {
+			return err
+		}
+
+		ready, reason, err := kubeobjects.ObjectReady(object, rules)
+		if err != nil {
+			return err
+		}
+
+		if !ready {
+			notReady = append(notReady, fmt.Sprintf("%s %s: %s", kind, name, reason))
+		}
+
+		return nil
+	})
+
+	return notReady, err
+}
+
+// kubeObjectRecoveredGet gets a recovered object, and returns whether it was found. Objects of kinds the operator may
+// not read are not found.
+func (v *VRGInstance) kubeObjectRecoveredGet(kind, name string, object *unstructured.Unstructured) (bool, error) {
+	if err := v.reconciler.APIReader.Get(v.ctx, types.NamespacedName{
+		Namespace: object.GetNamespace(), Name: object.GetName(),
+	}, object); err != nil {
+		if k8serrors.IsNotFound(err) || k8serrors.IsForbidden(err) {
+			return false, nil
+		}
+
+		return false, fmt.Errorf("%s %s get: %w", kind, name, err)
+	}
+
+	return true, nil
+}
+
+// kubeObjectsRecoveredDelete deletes the recovered objects that the recovery created. Objects that existed already
+// are not, nor are cluster scoped objects, such as namespaces, as they may be shared with others.
+func (v *VRGInstance) kubeObjectsRecoveredDelete(
+	capturedObjects kubeobjects.CapturedObjects, log logr.Logger,
+) error {
+	recovered := v.kubeObjectRecoveredByRecovery()
+
+	return v.kubeObjectsRecoveredVisit(capturedObjects, func(kind, name string, object *unstructured.Unstructured) error {
+		if object.GetNamespace() == "" {
+			return nil
+		}
+
+		if found, err := v.kubeObjectRecoveredGet(kind, name, object); err != nil || !found {
+			return err
+		}
+
+		if !recovered(object) {
+			log.Info("Recovered kube object not created by the recovery; not deleted", "kind", kind, "name", name)
+
+			return nil
+		}
+
+		if err := v.reconciler.Client.Delete(v.ctx, object,
+			client.Preconditions{UID: ptr.To(object.GetUID())},
+			client.PropagationPolicy(metav1.DeletePropagationBackground),
+		); err != nil {
+			if k8serrors.IsNotFound(err) || k8serrors.IsConflict(err) {
+				return nil
+			}
+
+			return fmt.Errorf("%s %s delete: %w", kind, name, err)
+		}
+
+		log.Info("Recovered kube object deleted", "kind", kind, "name", name)
+
+		return nil
+	})
+}
+
+// kubeObjectRecoveredByRecovery returns a function that returns whether an object was created by the latest recovery,
+// i.e. whether it is labeled by one of its recover requests, and was created since it started
+func (v *VRGInstance) kubeObjectRecoveredByRecovery() func(*unstructured.Unstructured) bool {
+	vrg := v.instance
+	recoverNamePrefix := kubeObjectsRecoverNamePrefix(vrg.Namespace, vrg.Name)
+	labels := map[string]map[string]struct{}{}
+
+	for groupNumber := range v.recipeElements.RecoverWorkflow {
+		key, value := v.reconciler.kubeObjects.RecoveredObjectLabel(
+			kubeObjectsRecoverName(recoverNamePrefix, groupNumber))
+		if labels[key] == nil {
+			labels[key] = map[string]struct{}{}
+		}
+
+		labels[key][value] = struct{}{}
+	}
+
+	startTime := vrg.Status.KubeObjectProtection.RecoverStartTime
+
+	return func(object *unstructured.Unstructured) bool {
+		if startTime == nil || object.GetCreationTimestamp().Time.Before(startTime.Time) {
+			return false
+		}
+
+		for key, values := range labels {
+			if _, ok := values[object.GetLabels()[key]]; ok {
+				return true
+			}
+		}
+
+		return false
+	}
+}
+
+// kubeObjectsRecoveredVisit calls a function with each object listed, in order, its kind and name set, and its
+// namespace mapped as it was recovered
+func (v *VRGInstance) kubeObjectsRecoveredVisit(capturedObjects kubeobjects.CapturedObjects,
+	visit func(kind, name string, object *unstructured.Unstructured) error,
+) error {
+	namespaceMapping := v.kubeObjectsRecoverNamespaceMapping()
+
+	for _, kind := range slices.Sorted(maps.Keys(capturedObjects)) {
+		names := capturedObjects[kind]
+		groupVersionKind, err := kubeobjects.CapturedObjectsGroupVersionKind(kind)
+		if err != nil {
+			return err
+		}
+
+		for _, name := range names {
+			object := &unstructured.Unstructured{}
+			object.SetGroupVersionKind(groupVersionKind)
+
+			namespaceName, objectName := kubeobjects.CapturedObjectNamespaceAndName(name)
+			if mappedNamespaceName, ok := namespaceMapping[namespaceName]; ok && namespaceName != "" {
+				namespaceName = mappedNamespaceName
+			}
+
+			if mappedName, ok := namespaceMapping[objectName]; ok && groupVersionKind == namespaceGroupVersionKind {
+				objectName = mappedName
+			}
+
+			object.SetNamespace(namespaceName)
+			object.SetName(objectName)
+
+			if err := visit(kind, name, object); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// kubeObjectsRecoverNamespaceMapping returns the namespace mapping of the recover groups. A namespace mapped by more
+// than one group is mapped as the first one does.
+func (v *VRGInstance) kubeObjectsRecoverNamespaceMapping() map[string]string {
+	namespaceMapping := map[string]string{}
+
+	for _, recoverGroup := range v.recipeElements.RecoverWorkflow {
+		for namespaceName, mappedNamespaceName := range recoverGroup.NamespaceMapping {
+			if _, ok := namespaceMapping[namespaceName]; !ok {
+				namespaceMapping[namespaceName] = mappedNamespaceName
+			}
+		}
+	}
+
+	return namespaceMapping
+}
+
+func kubeObjectsNotReadyMessage(notReady []string) string {
+	summary := notReady
+	if len(summary) > kubeObjectsNotReadySummaryLimit {
+		summary = summary[:kubeObjectsNotReadySummaryLimit]
+	}
+
+	return fmt.Sprintf("%d recovered kube objects not ready: %s", len(notReady), strings.Join(summary, "; "))
+}
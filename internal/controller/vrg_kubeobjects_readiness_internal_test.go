@@ -0,0 +1,79 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	ramen "github.com/ramendr/ramen/api/v1alpha1"
+	"github.com/ramendr/ramen/internal/controller/kubeobjects"
+	"github.com/ramendr/ramen/internal/controller/kubeobjects/native"
+	"github.com/ramendr/ramen/internal/controller/util"
+)
+
+var _ = Describe("Kube objects recovered readiness", func() {
+	startTime := metav1.NewTime(time.Now().Truncate(time.Second))
+
+	vrgInstance := func(recoverWorkflow ...kubeobjects.RecoverSpec) *VRGInstance {
+		return &VRGInstance{
+			instance: &ramen.VolumeReplicationGroup{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "ramen-ops", Name: "vrg"},
+				Status: ramen.VolumeReplicationGroupStatus{
+					KubeObjectProtection: ramen.KubeObjectProtectionStatus{RecoverStartTime: &startTime},
+				},
+			},
+			reconciler:     &VolumeReplicationGroupReconciler{kubeObjects: native.RequestsManager{}},
+			recipeElements: util.RecipeElements{RecoverWorkflow: recoverWorkflow},
+		}
+	}
+
+	object := func(creationTime time.Time, labels map[string]string) *unstructured.Unstructured {
+		object := &unstructured.Unstructured{}
+		object.SetCreationTimestamp(metav1.NewTime(creationTime))
+		object.SetLabels(labels)
+
+		return object
+	}
+
+	It("visits the objects in the namespaces they are recovered to", func() {
+		v := vrgInstance(
+			kubeobjects.RecoverSpec{NamespaceMapping: map[string]string{"app": "app2"}},
+			kubeobjects.RecoverSpec{NamespaceMapping: map[string]string{"app": "app3", "other": "other2"}},
+		)
+		visited := []string{}
+
+		Expect(v.kubeObjectsRecoveredVisit(kubeobjects.CapturedObjects{
+			"v1/ConfigMap":       {"app/config", "third/config"},
+			"v1/Namespace":       {"app"},
+			"apps/v1/Deployment": {"other/deploy"},
+		}, func(_, _ string, object *unstructured.Unstructured) error {
+			visited = append(visited, object.GetNamespace()+"/"+object.GetName())
+
+			return nil
+		})).To(Succeed())
+		Expect(visited).To(ConsistOf("app2/config", "third/config", "/app2", "other2/deploy"))
+	})
+
+	It("deletes only the objects that the recovery created", func() {
+		v := vrgInstance(kubeobjects.RecoverSpec{}, kubeobjects.RecoverSpec{})
+		recovered := v.kubeObjectRecoveredByRecovery()
+		key, value := native.RequestsManager{}.RecoveredObjectLabel(
+			kubeObjectsRecoverName(kubeObjectsRecoverNamePrefix("ramen-ops", "vrg"), 1))
+
+		Expect(recovered(object(startTime.Add(time.Second), map[string]string{key: value}))).To(BeTrue())
+		Expect(recovered(object(startTime.Time, map[string]string{key: value}))).To(BeTrue())
+		Expect(recovered(object(startTime.Add(-time.Second), map[string]string{key: value}))).To(BeFalse())
+		Expect(recovered(object(startTime.Add(time.Second), map[string]string{key: "other"}))).To(BeFalse())
+		Expect(recovered(object(startTime.Add(time.Second), nil))).To(BeFalse())
+
+		v.instance.Status.KubeObjectProtection.RecoverStartTime = nil
+		Expect(v.kubeObjectRecoveredByRecovery()(object(startTime.Add(time.Second),
+			map[string]string{key: value}))).To(BeFalse())
+	})
+})
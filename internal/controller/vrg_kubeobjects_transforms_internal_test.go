@@ -0,0 +1,25 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("KubeObjectsRecoverTransforms", func() {
+	parameters := map[string]string{"region": "us-east", "zone": "a"}
+
+	DescribeTable("expandTransformParameters",
+		func(patch, expected string) {
+			Expect(expandTransformParameters(patch, parameters)).To(Equal(expected))
+		},
+		Entry("replaces $name", `{"region":"$region"}`, `{"region":"us-east"}`),
+		Entry("replaces ${name}", `{"zone":"${region}-${zone}"}`, `{"zone":"us-east-a"}`),
+		Entry("keeps undeclared parameters", `{"cmd":"echo $HOME ${PATH}"}`, `{"cmd":"echo $HOME ${PATH}"}`),
+		Entry("keeps $$", `{"cmd":"echo $$"}`, `{"cmd":"echo $$"}`),
+		Entry("does not replace a prefix of a longer name", `{"a":"$regional"}`, `{"a":"$regional"}`),
+		Entry("keeps a trailing $", `{"price":"5$"}`, `{"price":"5$"}`),
+	)
+})
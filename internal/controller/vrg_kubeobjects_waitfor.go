@@ -0,0 +1,155 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+// +kubebuilder:rbac:groups=admissionregistration.k8s.io,resources=validatingwebhookconfigurations;mutatingwebhookconfigurations,verbs=get;list
+// +kubebuilder:rbac:groups=discovery.k8s.io,resources=endpointslices,verbs=list
+
+package controllers
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	ramen "github.com/ramendr/ramen/api/v1alpha1"
+	"github.com/ramendr/ramen/internal/controller/kubeobjects"
+	"github.com/ramendr/ramen/internal/controller/util"
+)
+
+const (
+	customResourceDefinitionsResource = "customresourcedefinitions.apiextensions.k8s.io"
+	kubeObjectsRecoverWaitInterval    = 10 * time.Second
+)
+
+var customResourceDefinitionGroupKind = schema.GroupKind{
+	Group: "apiextensions.k8s.io", Kind: "CustomResourceDefinition",
+}
+
+// kubeObjectsRecoverWaitFor returns the conditions of the VRG to wait for before a recover group, of a name, is
+// recovered, followed by those of the group itself
+func kubeObjectsRecoverWaitFor(vrg *ramen.VolumeReplicationGroup, groupName string, groupWaitFor []kubeobjects.WaitFor,
+) ([]kubeobjects.WaitFor, error) {
+	waitFor := make([]kubeobjects.WaitFor, 0, len(vrg.Spec.KubeObjectProtection.RecoverWaitFor)+len(groupWaitFor))
+
+	for _, recoverWaitFor := range vrg.Spec.KubeObjectProtection.RecoverWaitFor {
+		if recoverWaitFor.Group != "" && recoverWaitFor.Group != groupName {
+			continue
+		}
+
+		w := kubeobjects.WaitFor{
+			CustomResourceDefinition: recoverWaitFor.CustomResourceDefinition,
+			Webhook:                  recoverWaitFor.Webhook,
+		}
+
+		if recoverWaitFor.Deployment != nil {
+			w.Deployment = &types.NamespacedName{
+				Namespace: recoverWaitFor.Deployment.Namespace,
+				Name:      recoverWaitFor.Deployment.Name,
+			}
+		}
+
+		if recoverWaitFor.Timeout != nil {
+			w.Timeout = recoverWaitFor.Timeout.Duration
+		}
+
+		if err := w.Validate(); err != nil {
+			return nil, err
+		}
+
+		waitFor = append(waitFor, w)
+	}
+
+	return append(waitFor, groupWaitFor...), nil
+}
+
+// kubeObjectsRecoverWaitForResolve replaces the wait for the custom resource definitions recovered with waits for
+// each custom resource definition of the capture recovered from
+func (v *VRGInstance) kubeObjectsRecoverWaitForResolve(waitFor []kubeobjects.WaitFor) ([]kubeobjects.WaitFor, error) {
+	resolved := make([]kubeobjects.WaitFor, 0, len(waitFor))
+
+	for _, w := range waitFor {
+		if !w.CustomResourceDefinitionsRecovered {
+			resolved = append(resolved, w)
+
+			continue
+		}
+
+		capturedObjects, err := v.kubeObjectsRecoveredObjects()
+		if err != nil {
+			return nil, err
+		}
+
+		for kind, names := range capturedObjects {
+			groupVersionKind, err := kubeobjects.CapturedObjectsGroupVersionKind(kind)
+			if err != nil || groupVersionKind.GroupKind() != customResourceDefinitionGroupKind {
+				continue
+			}
+
+			for _, name := range names {
+				resolved = append(resolved, kubeobjects.WaitFor{CustomResourceDefinition: name, Timeout: w.Timeout})
+			}
+		}
+	}
+
+	return resolved, nil
+}
+
+// kubeObjectsRecoverGroupWait returns nil once the conditions that the recovery of a group waits for are met, a
+// processing error while they are not, and an error if they are not met in time, after which they are waited for
+// again
+func (v *VRGInstance) kubeObjectsRecoverGroupWait(result *ctrl.Result, groupName string,
+	groupWaitFor []kubeobjects.WaitFor, log logr.Logger,
+) error {
+	vrg := v.instance
+
+	waitFor, err := kubeObjectsRecoverWaitFor(vrg, groupName, groupWaitFor)
+	if err != nil {
+		return err
+	}
+
+	if waitFor, err = v.kubeObjectsRecoverWaitForResolve(waitFor); err != nil {
+		return err
+	}
+
+	unmet, err := kubeobjects.WaitForUnmet(v.ctx, v.reconciler.APIReader, waitFor)
+	if err != nil {
+		return err
+	}
+
+	if len(unmet) == 0 {
+		vrg.Status.KubeObjectProtection.RecoverWaiting = nil
+
+		return nil
+	}
+
+	waiting := vrg.Status.KubeObjectProtection.RecoverWaiting
+	if waiting == nil || waiting.Group != groupName {
+		waiting = &ramen.KubeObjectsRecoverWaitingStatus{Group: groupName, Since: metav1.Now()}
+		vrg.Status.KubeObjectProtection.RecoverWaiting = waiting
+	}
+
+	waiting.Message = strings.Join(unmet, "; ")
+
+	if timeout := kubeobjects.WaitForTimeout(waitFor); time.Since(waiting.Since.Time) >= timeout {
+		vrg.Status.KubeObjectProtection.RecoverWaiting = nil
+		message := fmt.Sprintf("Kube objects recover group %q conditions not met in %v: %s", groupName, timeout,
+			waiting.Message)
+
+		log.Info("Kube objects group recover conditions not met in time", "message", waiting.Message)
+		util.ReportIfNotPresent(v.reconciler.eventRecorder, vrg, corev1.EventTypeWarning,
+			util.EventReasonKubeObjectsRecoverWaitTimeout, message)
+
+		return fmt.Errorf("%s; waiting again", message)
+	}
+
+	delaySetIfLess(result, kubeObjectsRecoverWaitInterval, log)
+
+	return kubeobjects.RequestProcessingErrorCreate("waiting for " + waiting.Message)
+}
@@ -0,0 +1,91 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	ramen "github.com/ramendr/ramen/api/v1alpha1"
+	"github.com/ramendr/ramen/internal/controller/kubeobjects"
+)
+
+var _ = Describe("Kube objects recover wait for", func() {
+	vrg := func(kubeObjectProtection ramen.KubeObjectProtectionSpec) *ramen.VolumeReplicationGroup {
+		return &ramen.VolumeReplicationGroup{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "app", Name: "vrg"},
+			Spec:       ramen.VolumeReplicationGroupSpec{KubeObjectProtection: &kubeObjectProtection},
+		}
+	}
+
+	It("returns the conditions of all groups and of the group", func() {
+		waitFor, err := kubeObjectsRecoverWaitFor(vrg(ramen.KubeObjectProtectionSpec{
+			RecoverWaitFor: []ramen.KubeObjectsRecoverWaitFor{
+				{CustomResourceDefinition: "databases.example.com"},
+				{
+					Group:      "instances",
+					Deployment: &ramen.KubeObjectsNamespacedName{Namespace: "operator", Name: "manager"},
+					Timeout:    &metav1.Duration{Duration: time.Minute},
+				},
+				{Group: "other", Webhook: "databases"},
+			},
+		}), "instances", []kubeobjects.WaitFor{{CustomResourceDefinitionsRecovered: true}})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(waitFor).To(HaveExactElements(
+			kubeobjects.WaitFor{CustomResourceDefinition: "databases.example.com"},
+			kubeobjects.WaitFor{
+				Deployment: &types.NamespacedName{Namespace: "operator", Name: "manager"},
+				Timeout:    time.Minute,
+			},
+			kubeobjects.WaitFor{CustomResourceDefinitionsRecovered: true},
+		))
+		Expect(kubeobjects.WaitForTimeout(waitFor)).To(Equal(kubeobjects.WaitForTimeoutDefault))
+	})
+
+	It("fails conditions that do not set exactly one condition", func() {
+		_, err := kubeObjectsRecoverWaitFor(vrg(ramen.KubeObjectProtectionSpec{
+			RecoverWaitFor: []ramen.KubeObjectsRecoverWaitFor{{CustomResourceDefinition: "a", Webhook: "b"}},
+		}), "", nil)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("recovers custom resource definitions first, then built-in kinds once established, then custom kinds once "+
+		"webhooks are ready, if ordered automatically", func() {
+		recoverSpecs := recoverWorkflowDefault(*vrg(ramen.KubeObjectProtectionSpec{
+			RecoverOrdering: ramen.KubeObjectsRecoverOrderingAutomatic,
+		}), ramen.RamenConfig{})
+		Expect(recoverSpecs).To(HaveLen(3))
+		Expect(recoverSpecs[0].IncludedResources).To(HaveExactElements(customResourceDefinitionsResource))
+		Expect(recoverSpecs[0].IncludedNamespaces).To(HaveExactElements("app"))
+
+		for _, recoverSpec := range recoverSpecs[1:] {
+			Expect(recoverSpec.ExcludedResources).To(HaveExactElements(customResourceDefinitionsResource))
+			Expect(recoverSpec.OrderByOwners).To(BeTrue())
+		}
+
+		Expect(recoverSpecs[1].WaitFor).To(HaveExactElements(
+			kubeobjects.WaitFor{CustomResourceDefinitionsRecovered: true}))
+		Expect(recoverSpecs[1].CustomResources).To(HaveValue(BeFalse()))
+		Expect(recoverSpecs[2].WaitFor).To(HaveExactElements(
+			kubeobjects.WaitFor{WebhookNamespaces: []string{"app"}}))
+		Expect(recoverSpecs[2].CustomResources).To(HaveValue(BeTrue()))
+
+		Expect(recoverWorkflowDefault(*vrg(ramen.KubeObjectProtectionSpec{}), ramen.RamenConfig{})).To(HaveLen(1))
+	})
+
+	It("fails automatic ordering unless kube objects are protected natively", func() {
+		automatic := *vrg(ramen.KubeObjectProtectionSpec{RecoverOrdering: ramen.KubeObjectsRecoverOrderingAutomatic})
+		native := ramen.RamenConfig{}
+		native.KubeObjectProtection.Provider = ramen.KubeObjectProtectionProviderNative
+
+		Expect(kubeObjectsRecoverOrderingValidate(automatic, ramen.RamenConfig{})).NotTo(Succeed())
+		Expect(kubeObjectsRecoverOrderingValidate(automatic, native)).To(Succeed())
+		Expect(kubeObjectsRecoverOrderingValidate(*vrg(ramen.KubeObjectProtectionSpec{}), ramen.RamenConfig{})).
+			To(Succeed())
+	})
+})
@@ -0,0 +1,123 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"fmt"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	ramendrv1alpha1 "github.com/ramendr/ramen/api/v1alpha1"
+)
+
+// A PVC's PV and PVC cluster data are uploaded only once a resize of it completes, while the VRG records the PVC's
+// requested capacity as soon as it changes. So the VRG may expect a larger capacity than that of the PVC cluster data
+// it restores, as the replicated volume is already resized. The expected capacity is recorded on the restored PVC,
+// and the PVC is expanded to it once its volume is primary.
+
+// pvcResizePending returns true if the capacity requested by the bound PVC exceeds its actual capacity
+func pvcResizePending(pvc *corev1.PersistentVolumeClaim) bool {
+	if pvc.Status.Phase != corev1.ClaimBound {
+		return false
+	}
+
+	requested := pvc.Spec.Resources.Requests[corev1.ResourceStorage]
+	actual := pvc.Status.Capacity[corev1.ResourceStorage]
+
+	return requested.Cmp(actual) > 0
+}
+
+// pvcExpectedCapacityRecord records, on the PVC to restore, the capacity requested by the VRG's protected PVC of the
+// same name, if it exceeds the capacity requested by the PVC
+func pvcExpectedCapacityRecord(pvc *corev1.PersistentVolumeClaim, vrg *ramendrv1alpha1.VolumeReplicationGroup) {
+	if vrg == nil {
+		return
+	}
+
+	protectedPVC := FindProtectedPVC(vrg, pvc.Namespace, pvc.Name)
+	if protectedPVC == nil {
+		return
+	}
+
+	expected, ok := protectedPVC.Resources.Requests[corev1.ResourceStorage]
+	if !ok {
+		return
+	}
+
+	requested := pvc.Spec.Resources.Requests[corev1.ResourceStorage]
+	if expected.Cmp(requested) <= 0 {
+		return
+	}
+
+	if pvc.Annotations == nil {
+		pvc.Annotations = map[string]string{}
+	}
+
+	pvc.Annotations[pvcAnnotationExpectedCapacityKey] = expected.String()
+}
+
+// pvcExpandToExpectedCapacity expands the PVC to the capacity expected for it when it was restored, if any, and
+// removes the record of the expected capacity once the PVC reaches it
+func (v *VRGInstance) pvcExpandToExpectedCapacity(pvc *corev1.PersistentVolumeClaim, log logr.Logger) error {
+	value, ok := pvc.Annotations[pvcAnnotationExpectedCapacityKey]
+	if !ok {
+		return nil
+	}
+
+	expected, err := resource.ParseQuantity(value)
+	if err != nil {
+		return fmt.Errorf("invalid expected capacity %q of PVC %s/%s: %w", value, pvc.Namespace, pvc.Name, err)
+	}
+
+	requested := pvc.Spec.Resources.Requests[corev1.ResourceStorage]
+	actual := pvc.Status.Capacity[corev1.ResourceStorage]
+
+	switch {
+	case requested.Cmp(expected) < 0:
+		if pvc.Spec.Resources.Requests == nil {
+			pvc.Spec.Resources.Requests = corev1.ResourceList{}
+		}
+
+		pvc.Spec.Resources.Requests[corev1.ResourceStorage] = expected
+
+		log.Info("Expanding PVC to its expected capacity", "from", requested.String(), "to", value)
+	case actual.Cmp(expected) >= 0:
+		delete(pvc.Annotations, pvcAnnotationExpectedCapacityKey)
+
+		log.Info("PVC expanded to its expected capacity", "capacity", actual.String())
+	default:
+		return nil
+	}
+
+	if err := v.reconciler.Update(v.ctx, pvc); err != nil {
+		return fmt.Errorf("failed to update PVC %s/%s: %w", pvc.Namespace, pvc.Name, err)
+	}
+
+	return nil
+}
+
+// reconcilePVCCapacityAsPrimary expands the PVC to its expected capacity once its volume is primary, and reports
+// the PVC as not yet protected while a resize of it is pending, as its PV and PVC cluster data are not uploaded and
+// its peer volume may not yet be resized
+func (v *VRGInstance) reconcilePVCCapacityAsPrimary(pvc *corev1.PersistentVolumeClaim, primary bool,
+	log logr.Logger,
+) {
+	if primary {
+		if err := v.pvcExpandToExpectedCapacity(pvc, log); err != nil {
+			log.Info("Requeuing, as expanding PVC to its expected capacity failed", "errorValue", err)
+
+			v.requeue()
+		}
+	}
+
+	if !pvcResizePending(pvc) {
+		return
+	}
+
+	requested := pvc.Spec.Resources.Requests[corev1.ResourceStorage]
+	v.updatePVCDataProtectedCondition(pvc.Namespace, pvc.Name, VRGConditionReasonProgressing,
+		fmt.Sprintf("PVC resize to %s is in progress", requested.String()))
+}
@@ -0,0 +1,123 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	ramen "github.com/ramendr/ramen/api/v1alpha1"
+)
+
+var _ = Describe("PVCResize", func() {
+	pvc := func(requested, actual string) *corev1.PersistentVolumeClaim {
+		return &corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{Name: "pvc", Namespace: "ns"},
+			Spec: corev1.PersistentVolumeClaimSpec{
+				Resources: corev1.VolumeResourceRequirements{
+					Requests: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse(requested)},
+				},
+			},
+			Status: corev1.PersistentVolumeClaimStatus{
+				Phase:    corev1.ClaimBound,
+				Capacity: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse(actual)},
+			},
+		}
+	}
+
+	vrg := func(requested string) *ramen.VolumeReplicationGroup {
+		return &ramen.VolumeReplicationGroup{Status: ramen.VolumeReplicationGroupStatus{
+			ProtectedPVCs: []ramen.ProtectedPVC{{
+				Name:      "pvc",
+				Namespace: "ns",
+				Resources: corev1.VolumeResourceRequirements{
+					Requests: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse(requested)},
+				},
+			}},
+		}}
+	}
+
+	It("reports a resize pending while a bound PVC is smaller than it requests", func() {
+		Expect(pvcResizePending(pvc("2Gi", "1Gi"))).To(BeTrue())
+		Expect(pvcResizePending(pvc("1Gi", "1Gi"))).To(BeFalse())
+		Expect(pvcResizePending(pvc("1Gi", "2Gi"))).To(BeFalse())
+
+		unbound := pvc("2Gi", "1Gi")
+		unbound.Status.Phase = corev1.ClaimPending
+		Expect(pvcResizePending(unbound)).To(BeFalse())
+	})
+
+	DescribeTable("pvcExpectedCapacityRecord",
+		func(vrg *ramen.VolumeReplicationGroup, expected string) {
+			restored := pvc("1Gi", "1Gi")
+			pvcExpectedCapacityRecord(restored, vrg)
+
+			if expected == "" {
+				Expect(restored.Annotations).ToNot(HaveKey(pvcAnnotationExpectedCapacityKey))
+			} else {
+				Expect(restored.Annotations).To(HaveKeyWithValue(pvcAnnotationExpectedCapacityKey, expected))
+			}
+		},
+		Entry("records nothing without a VRG", nil, ""),
+		Entry("records nothing when the VRG expects no more", vrg("1Gi"), ""),
+		Entry("records the capacity the VRG expects", vrg("2Gi"), "2Gi"),
+	)
+
+	Describe("pvcExpandToExpectedCapacity", func() {
+		instance := func(objects ...client.Object) *VRGInstance {
+			scheme := runtime.NewScheme()
+			Expect(clientgoscheme.AddToScheme(scheme)).To(Succeed())
+
+			return &VRGInstance{
+				ctx: context.TODO(),
+				reconciler: &VolumeReplicationGroupReconciler{
+					Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build(),
+				},
+			}
+		}
+
+		expecting := func(requested, actual string) *corev1.PersistentVolumeClaim {
+			restored := pvc(requested, actual)
+			restored.Annotations = map[string]string{pvcAnnotationExpectedCapacityKey: "2Gi"}
+
+			return restored
+		}
+
+		get := func(v *VRGInstance) *corev1.PersistentVolumeClaim {
+			updated := &corev1.PersistentVolumeClaim{}
+			Expect(v.reconciler.Get(v.ctx, client.ObjectKey{Namespace: "ns", Name: "pvc"}, updated)).To(Succeed())
+
+			return updated
+		}
+
+		It("expands the PVC to its expected capacity", func() {
+			restored := expecting("1Gi", "1Gi")
+			v := instance(restored)
+
+			Expect(v.pvcExpandToExpectedCapacity(restored, logr.Discard())).To(Succeed())
+
+			updated := get(v)
+			Expect(updated.Spec.Resources.Requests.Storage().String()).To(Equal("2Gi"))
+			Expect(updated.Annotations).To(HaveKey(pvcAnnotationExpectedCapacityKey))
+		})
+
+		It("removes the expected capacity once the PVC reaches it", func() {
+			restored := expecting("2Gi", "2Gi")
+			v := instance(restored)
+
+			Expect(v.pvcExpandToExpectedCapacity(restored, logr.Discard())).To(Succeed())
+			Expect(get(v).Annotations).ToNot(HaveKey(pvcAnnotationExpectedCapacityKey))
+		})
+	})
+})
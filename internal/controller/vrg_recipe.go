@@ -15,6 +15,7 @@ import (
 	recipev1 "github.com/ramendr/recipe/api/v1alpha1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
@@ -75,9 +76,55 @@ func recoverWorkflowDefault(vrg ramen.VolumeReplicationGroup, ramenConfig ramen.
 		},
 	}
 
+	if vrg.Spec.KubeObjectProtection.RecoverOrdering == ramen.KubeObjectsRecoverOrderingAutomatic {
+		return recoverWorkflowAutomatic(recoverSpecs[0])
+	}
+
 	return recoverSpecs
 }
 
+// recoverWorkflowAutomatic splits the default recover group in three: the custom resource definitions; then, once
+// they are established, the objects of built-in resources, e.g. the deployments of operators and their webhook
+// services; then, once the webhooks served in the namespaces are ready, the objects of custom resources. The objects
+// of each group are ordered by their owners.
+func recoverWorkflowAutomatic(recoverSpec kubeobjects.RecoverSpec) []kubeobjects.RecoverSpec {
+	definitions := kubeobjects.RecoverSpec{
+		Spec: kubeobjects.Spec{
+			KubeResourcesSpec: kubeobjects.KubeResourcesSpec{
+				IncludedNamespaces: recoverSpec.IncludedNamespaces,
+				IncludedResources:  []string{customResourceDefinitionsResource},
+			},
+			IncludeClusterResources: ptr.To(true),
+		},
+	}
+
+	recoverSpec.ExcludedResources = []string{customResourceDefinitionsResource}
+	recoverSpec.OrderByOwners = true
+
+	builtinResources := recoverSpec
+	builtinResources.WaitFor = []kubeobjects.WaitFor{{CustomResourceDefinitionsRecovered: true}}
+	builtinResources.CustomResources = ptr.To(false)
+
+	customResources := recoverSpec
+	customResources.WaitFor = []kubeobjects.WaitFor{{WebhookNamespaces: recoverSpec.IncludedNamespaces}}
+	customResources.CustomResources = ptr.To(true)
+
+	return []kubeobjects.RecoverSpec{definitions, builtinResources, customResources}
+}
+
+// kubeObjectsRecoverOrderingValidate fails automatic ordering unless the kube objects are protected natively, as
+// only the native kube object protection provider orders objects by their owners and selects them by whether their
+// resources are custom
+func kubeObjectsRecoverOrderingValidate(vrg ramen.VolumeReplicationGroup, ramenConfig ramen.RamenConfig) error {
+	if vrg.Spec.KubeObjectProtection.RecoverOrdering == ramen.KubeObjectsRecoverOrderingAutomatic &&
+		kubeObjectProtectionProviderOrDefault(&ramenConfig) != ramen.KubeObjectProtectionProviderNative {
+		return fmt.Errorf("kube objects recover ordering %s unsupported by kube object protection provider %s",
+			ramen.KubeObjectsRecoverOrderingAutomatic, kubeObjectProtectionProviderOrDefault(&ramenConfig))
+	}
+
+	return nil
+}
+
 func GetPVCSelector(ctx context.Context, reader client.Reader, vrg ramen.VolumeReplicationGroup,
 	ramenConfig ramen.RamenConfig,
 	log logr.Logger,
@@ -109,6 +156,10 @@ func RecipeElementsGet(ctx context.Context, reader client.Reader, vrg ramen.Volu
 		return recipeElements, nil
 	}
 
+	if err := kubeObjectsRecoverOrderingValidate(vrg, ramenConfig); err != nil {
+		return recipeElements, err
+	}
+
 	if vrg.Spec.KubeObjectProtection.RecipeRef == nil {
 		pvcSelector := getPVCSelector(vrg, ramenConfig, nil, nil)
 
@@ -0,0 +1,293 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"fmt"
+	"strings"
+
+	volrep "github.com/csi-addons/kubernetes-csi-addons/api/replication.storage/v1alpha1"
+	"github.com/go-logr/logr"
+	snapv1 "github.com/kubernetes-csi/external-snapshotter/client/v8/apis/volumesnapshot/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	ramen "github.com/ramendr/ramen/api/v1alpha1"
+	rmnutil "github.com/ramendr/ramen/internal/controller/util"
+)
+
+// A PVC may be in split-brain when its volume replication resource is still reported primary as the VRG, primary
+// when the failover away from its cluster took place, is demoted: the volume may hold writes after its last sync
+// that the new primary does not have, and resyncing it from the new primary discards them. Whether it took such
+// writes is not known, as the storage does not report it, so such PVCs are recorded in the VRG status as possibly
+// diverged, optionally preserved in a VolumeSnapshot before they are demoted, and optionally not resynced until the
+// discarding of their possibly diverged data is acknowledged. Records are cleared once the VRG is primary again.
+
+// isSplitBrainDemotion returns true if a volume replication resource in the passed in state is to be demoted to
+// the passed in desired state after a failover away from the cluster of the VRG, while its volume may have diverged
+// from the new primary: its passed in status reports the volume primary for the passed in current generation of the
+// resource, and the VRG reports being primary for an earlier generation, as it was still primary, and so may have
+// taken writes after its last sync, when the failover took place. This holds for every volume that was primary at
+// an unplanned failover, as the storage does not report whether it took writes after its last sync.
+func isSplitBrainDemotion(vrg *ramen.VolumeReplicationGroup, current, desired volrep.ReplicationState,
+	status *volrep.VolumeReplicationStatus, generation int64,
+) bool {
+	if current != volrep.Primary || desired != volrep.Secondary || vrg.Spec.Action != ramen.VRGActionFailover {
+		return false
+	}
+
+	if status.State != volrep.PrimaryState || status.ObservedGeneration != generation {
+		return false
+	}
+
+	return vrg.Status.State == ramen.PrimaryState && vrg.Status.ObservedGeneration != vrg.Generation
+}
+
+// splitBrainAcknowledgementPending returns true if acknowledgement is required before the diverged data of
+// split-brain PVCs is discarded, and a PVC that is not yet resynced was detected after the last acknowledgement
+func splitBrainAcknowledgementPending(vrg *ramen.VolumeReplicationGroup) bool {
+	spec := vrg.Spec.SplitBrain
+	if spec == nil || !spec.RequireAcknowledgement {
+		return false
+	}
+
+	for idx := range vrg.Status.SplitBrainPVCs {
+		record := &vrg.Status.SplitBrainPVCs[idx]
+		if record.Discarded {
+			continue
+		}
+
+		if spec.AcknowledgedTime == nil || spec.AcknowledgedTime.Before(&record.DetectedTime) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// findSplitBrainPVC returns the split-brain record of the passed in PVC, or nil if not found
+func findSplitBrainPVC(vrg *ramen.VolumeReplicationGroup, pvcNamespace, pvcName string) *ramen.SplitBrainPVC {
+	for idx := range vrg.Status.SplitBrainPVCs {
+		record := &vrg.Status.SplitBrainPVCs[idx]
+		if record.Namespace == pvcNamespace && record.Name == pvcName {
+			return record
+		}
+	}
+
+	return nil
+}
+
+// splitBrainRecord returns the split-brain record of the passed in PVC, recording it if it is not recorded or was
+// resynced already
+func (v *VRGInstance) splitBrainRecord(pvc *corev1.PersistentVolumeClaim, log logr.Logger) *ramen.SplitBrainPVC {
+	record := findSplitBrainPVC(v.instance, pvc.Namespace, pvc.Name)
+	if record != nil && !record.Discarded {
+		return record
+	}
+
+	newRecord := ramen.SplitBrainPVC{
+		Name:         pvc.Name,
+		Namespace:    pvc.Namespace,
+		DetectedTime: metav1.Now(),
+	}
+
+	if protectedPVC := v.findProtectedPVC(pvc.Namespace, pvc.Name); protectedPVC != nil {
+		newRecord.LastSyncTime = protectedPVC.LastSyncTime
+	}
+
+	log.Info("Possible split-brain detected", "pvc", pvc.Namespace+"/"+pvc.Name, "lastSyncTime", newRecord.LastSyncTime)
+
+	rmnutil.ReportIfNotPresent(v.reconciler.eventRecorder, v.instance, corev1.EventTypeWarning,
+		rmnutil.EventReasonSplitBrainDetected,
+		fmt.Sprintf("PVC %s/%s may have diverged, holding writes that were not replicated", pvc.Namespace, pvc.Name))
+
+	if record != nil {
+		*record = newRecord
+
+		return record
+	}
+
+	v.instance.Status.SplitBrainPVCs = append(v.instance.Status.SplitBrainPVCs, newRecord)
+
+	return &v.instance.Status.SplitBrainPVCs[len(v.instance.Status.SplitBrainPVCs)-1]
+}
+
+// splitBrainPrepareDemotion records the passed in PVCs as possibly split-brain if their volume replication resource,
+// in the passed in state, status and generation, is to be demoted after a failover and may hold diverged writes, and
+// preserves their possibly diverged data if so configured. PVCs recorded already remain so until they are resynced,
+// as the VRG status that they are detected by is updated once they are. Returns true once the volume replication
+// resource may be demoted.
+func (v *VRGInstance) splitBrainPrepareDemotion(pvcs []*corev1.PersistentVolumeClaim,
+	current, desired volrep.ReplicationState, status *volrep.VolumeReplicationStatus, generation int64,
+	log logr.Logger,
+) (bool, error) {
+	if current != volrep.Primary || desired != volrep.Secondary {
+		return true, nil
+	}
+
+	diverged := isSplitBrainDemotion(v.instance, current, desired, status, generation)
+	preserve := v.instance.Spec.SplitBrain != nil && v.instance.Spec.SplitBrain.PreserveDivergedData
+	ready := true
+
+	for _, pvc := range pvcs {
+		if record := findSplitBrainPVC(v.instance, pvc.Namespace, pvc.Name); !diverged &&
+			(record == nil || record.Discarded) {
+			continue
+		}
+
+		record := v.splitBrainRecord(pvc, log)
+		if !preserve {
+			continue
+		}
+
+		preserved, err := v.splitBrainPreserve(pvc, record, log)
+		if err != nil {
+			return false, err
+		}
+
+		if !preserved {
+			v.updatePVCDataReadyCondition(pvc.Namespace, pvc.Name, VRGConditionReasonProgressing,
+				fmt.Sprintf("Waiting for VolumeSnapshot %s preserving diverged data to be ready", record.SnapshotName))
+
+			ready = false
+		}
+	}
+
+	return ready, nil
+}
+
+// splitBrainSnapshotName returns the name of the VolumeSnapshot that preserves the possibly diverged data of a PVC,
+// with the PVC name hashed if the name would otherwise exceed the maximum length of a name
+func splitBrainSnapshotName(record *ramen.SplitBrainPVC) string {
+	suffix := fmt.Sprintf("-splitbrain-%d", record.DetectedTime.Unix())
+	if len(record.Name)+len(suffix) > rmnutil.MaxK8sNameLength {
+		return rmnutil.GetHashedName(record.Name) + suffix
+	}
+
+	return record.Name + suffix
+}
+
+// splitBrainPreserve ensures a VolumeSnapshot of the passed in split-brain PVC exists. Returns true once it is
+// ready to use.
+func (v *VRGInstance) splitBrainPreserve(pvc *corev1.PersistentVolumeClaim, record *ramen.SplitBrainPVC,
+	log logr.Logger,
+) (bool, error) {
+	if record.SnapshotName == "" {
+		record.SnapshotName = splitBrainSnapshotName(record)
+	}
+
+	snapshot := &snapv1.VolumeSnapshot{}
+
+	err := v.reconciler.Get(v.ctx, types.NamespacedName{Namespace: pvc.Namespace, Name: record.SnapshotName},
+		snapshot)
+	if err == nil {
+		return snapshot.Status != nil && snapshot.Status.ReadyToUse != nil && *snapshot.Status.ReadyToUse, nil
+	}
+
+	if !k8serrors.IsNotFound(err) {
+		return false, fmt.Errorf("failed to get VolumeSnapshot %s/%s: %w", pvc.Namespace, record.SnapshotName, err)
+	}
+
+	volumeSnapshotClassName, err := v.volSyncHandler.GetVolumeSnapshotClassFromPVCStorageClass(
+		pvc.Spec.StorageClassName)
+	if err != nil {
+		return false, fmt.Errorf("failed to find a VolumeSnapshotClass for PVC %s/%s: %w", pvc.Namespace, pvc.Name,
+			err)
+	}
+
+	snapshot = &snapv1.VolumeSnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      record.SnapshotName,
+			Namespace: pvc.Namespace,
+		},
+		Spec: snapv1.VolumeSnapshotSpec{
+			Source: snapv1.VolumeSnapshotSource{
+				PersistentVolumeClaimName: &pvc.Name,
+			},
+			VolumeSnapshotClassName: &volumeSnapshotClassName,
+		},
+	}
+
+	rmnutil.AddLabel(snapshot, rmnutil.CreatedByRamenLabel, "true")
+
+	if err := v.reconciler.Create(v.ctx, snapshot); err != nil && !k8serrors.IsAlreadyExists(err) {
+		return false, fmt.Errorf("failed to create VolumeSnapshot %s/%s: %w", pvc.Namespace, record.SnapshotName, err)
+	}
+
+	log.Info("Created VolumeSnapshot to preserve diverged data", "pvc", pvc.Namespace+"/"+pvc.Name,
+		"snapshot", record.SnapshotName)
+
+	return false, nil
+}
+
+// splitBrainMarkDiscarded marks the split-brain records of the passed in PVCs as discarded, once their volume
+// replication resource is to resync as secondary
+func (v *VRGInstance) splitBrainMarkDiscarded(pvcs []*corev1.PersistentVolumeClaim, state volrep.ReplicationState,
+	autoResync bool,
+) {
+	if state != volrep.Secondary || !autoResync {
+		return
+	}
+
+	for _, pvc := range pvcs {
+		if record := findSplitBrainPVC(v.instance, pvc.Namespace, pvc.Name); record != nil {
+			record.Discarded = true
+		}
+	}
+}
+
+// updateVRGSplitBrainCondition clears split-brain records once the VRG is primary, and sets the SplitBrain
+// condition to report the recorded PVCs, or removes it if there are none
+func (v *VRGInstance) updateVRGSplitBrainCondition() {
+	if v.instance.Spec.ReplicationState == ramen.Primary {
+		v.instance.Status.SplitBrainPVCs = nil
+	}
+
+	if len(v.instance.Status.SplitBrainPVCs) == 0 {
+		meta.RemoveStatusCondition(&v.instance.Status.Conditions, VRGConditionTypeSplitBrain)
+
+		return
+	}
+
+	status, reason := metav1.ConditionFalse, VRGConditionReasonSplitBrainResolved
+	pending, discarded := []string{}, []string{}
+
+	for _, record := range v.instance.Status.SplitBrainPVCs {
+		description := record.Namespace + "/" + record.Name
+		if record.SnapshotName != "" {
+			description += " (VolumeSnapshot " + record.SnapshotName + ")"
+		}
+
+		if record.Discarded {
+			discarded = append(discarded, description)
+
+			continue
+		}
+
+		pending = append(pending, description)
+	}
+
+	message := "Possibly diverged data discarded for PVCs: " + strings.Join(discarded, ", ")
+
+	if len(pending) != 0 {
+		status, reason = metav1.ConditionTrue, VRGConditionReasonSplitBrainDetected
+		message = "PVCs may have diverged, holding writes that were not replicated: " + strings.Join(pending, ", ")
+
+		if splitBrainAcknowledgementPending(v.instance) {
+			reason = VRGConditionReasonSplitBrainAcknowledgementRequired
+			message += ". Acknowledge to resync them, discarding their diverged data"
+		}
+	}
+
+	rmnutil.SetStatusCondition(&v.instance.Status.Conditions, metav1.Condition{
+		Type:               VRGConditionTypeSplitBrain,
+		Reason:             reason,
+		ObservedGeneration: v.instance.Generation,
+		Status:             status,
+		Message:            message,
+	})
+}
@@ -0,0 +1,209 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	volrep "github.com/csi-addons/kubernetes-csi-addons/api/replication.storage/v1alpha1"
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	ramen "github.com/ramendr/ramen/api/v1alpha1"
+	"github.com/ramendr/ramen/internal/controller/util"
+)
+
+var _ = Describe("SplitBrain", func() {
+	detectedTime := metav1.NewTime(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+	before := metav1.NewTime(detectedTime.Add(-time.Minute))
+	after := metav1.NewTime(detectedTime.Add(time.Minute))
+
+	vrg := func(spec *ramen.SplitBrainSpec, records ...ramen.SplitBrainPVC) *ramen.VolumeReplicationGroup {
+		return &ramen.VolumeReplicationGroup{
+			Spec: ramen.VolumeReplicationGroupSpec{
+				ReplicationState: ramen.Secondary,
+				Action:           ramen.VRGActionFailover,
+				SplitBrain:       spec,
+			},
+			Status: ramen.VolumeReplicationGroupStatus{SplitBrainPVCs: records},
+		}
+	}
+
+	record := func(name string, discarded bool) ramen.SplitBrainPVC {
+		return ramen.SplitBrainPVC{Name: name, Namespace: "ns", DetectedTime: detectedTime, Discarded: discarded}
+	}
+
+	DescribeTable("splitBrainAcknowledgementPending",
+		func(spec *ramen.SplitBrainSpec, records []ramen.SplitBrainPVC, pending bool) {
+			Expect(splitBrainAcknowledgementPending(vrg(spec, records...))).To(Equal(pending))
+		},
+		Entry("not pending when acknowledgement is not required",
+			&ramen.SplitBrainSpec{}, []ramen.SplitBrainPVC{record("a", false)}, false),
+		Entry("pending when not acknowledged",
+			&ramen.SplitBrainSpec{RequireAcknowledgement: true}, []ramen.SplitBrainPVC{record("a", false)}, true),
+		Entry("pending when acknowledged before detection",
+			&ramen.SplitBrainSpec{RequireAcknowledgement: true, AcknowledgedTime: &before},
+			[]ramen.SplitBrainPVC{record("a", false)}, true),
+		Entry("not pending when acknowledged after detection",
+			&ramen.SplitBrainSpec{RequireAcknowledgement: true, AcknowledgedTime: &after},
+			[]ramen.SplitBrainPVC{record("a", false)}, false),
+		Entry("not pending when discarded already",
+			&ramen.SplitBrainSpec{RequireAcknowledgement: true}, []ramen.SplitBrainPVC{record("a", true)}, false),
+	)
+
+	DescribeTable("isSplitBrainDemotion",
+		func(mutate func(*ramen.VolumeReplicationGroup, *volrep.VolumeReplicationStatus), splitBrain bool) {
+			instance := vrg(nil)
+			instance.Generation = 2
+			instance.Status.State = ramen.PrimaryState
+			instance.Status.ObservedGeneration = 1
+			status := &volrep.VolumeReplicationStatus{State: volrep.PrimaryState, ObservedGeneration: 3}
+
+			mutate(instance, status)
+			Expect(isSplitBrainDemotion(instance, volrep.Primary, volrep.Secondary, status, 3)).To(Equal(splitBrain))
+		},
+		Entry("detected when both the volume and the VRG were primary at the failover",
+			func(*ramen.VolumeReplicationGroup, *volrep.VolumeReplicationStatus) {}, true),
+		Entry("not detected when not failing over",
+			func(vrg *ramen.VolumeReplicationGroup, _ *volrep.VolumeReplicationStatus) {
+				vrg.Spec.Action = ramen.VRGActionRelocate
+			}, false),
+		Entry("not detected when the volume is not reported primary",
+			func(_ *ramen.VolumeReplicationGroup, status *volrep.VolumeReplicationStatus) {
+				status.State = volrep.UnknownState
+			}, false),
+		Entry("not detected when the volume status is stale",
+			func(_ *ramen.VolumeReplicationGroup, status *volrep.VolumeReplicationStatus) {
+				status.ObservedGeneration = 2
+			}, false),
+		Entry("not detected when the VRG was not primary",
+			func(vrg *ramen.VolumeReplicationGroup, _ *volrep.VolumeReplicationStatus) {
+				vrg.Status.State = ramen.SecondaryState
+			}, false),
+		Entry("not detected when the VRG observed the failover already",
+			func(vrg *ramen.VolumeReplicationGroup, _ *volrep.VolumeReplicationStatus) {
+				vrg.Status.ObservedGeneration = 2
+			}, false),
+	)
+
+	It("keeps recorded PVCs once the VRG status they were detected by is updated", func() {
+		v := &VRGInstance{instance: vrg(nil, record("a", false), record("b", true))}
+		pvcs := []*corev1.PersistentVolumeClaim{
+			{ObjectMeta: metav1.ObjectMeta{Name: "a", Namespace: "ns"}},
+			{ObjectMeta: metav1.ObjectMeta{Name: "b", Namespace: "ns"}},
+		}
+
+		ready, err := v.splitBrainPrepareDemotion(pvcs, volrep.Primary, volrep.Secondary,
+			&volrep.VolumeReplicationStatus{}, 1, logr.Discard())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ready).To(BeTrue())
+		Expect(v.instance.Status.SplitBrainPVCs).To(HaveLen(2))
+		Expect(v.instance.Status.SplitBrainPVCs[0].DetectedTime).To(Equal(detectedTime))
+		Expect(v.instance.Status.SplitBrainPVCs[1].Discarded).To(BeTrue())
+	})
+
+	It("does not resync as secondary while acknowledgement is pending", func() {
+		v := &VRGInstance{instance: vrg(&ramen.SplitBrainSpec{RequireAcknowledgement: true}, record("a", false))}
+		Expect(v.autoResync(volrep.Secondary)).To(BeFalse())
+
+		v.instance.Spec.SplitBrain.AcknowledgedTime = &after
+		Expect(v.autoResync(volrep.Secondary)).To(BeTrue())
+	})
+
+	It("marks records discarded once resyncing as secondary", func() {
+		v := &VRGInstance{instance: vrg(nil, record("a", false), record("b", false))}
+		pvcs := []*corev1.PersistentVolumeClaim{{ObjectMeta: metav1.ObjectMeta{Name: "a", Namespace: "ns"}}}
+
+		v.splitBrainMarkDiscarded(pvcs, volrep.Secondary, false)
+		Expect(v.instance.Status.SplitBrainPVCs[0].Discarded).To(BeFalse())
+
+		v.splitBrainMarkDiscarded(pvcs, volrep.Secondary, true)
+		Expect(v.instance.Status.SplitBrainPVCs[0].Discarded).To(BeTrue())
+		Expect(v.instance.Status.SplitBrainPVCs[1].Discarded).To(BeFalse())
+	})
+
+	It("names VolumeSnapshots within the maximum length of a name", func() {
+		short := record("a", false)
+		Expect(splitBrainSnapshotName(&short)).To(Equal(fmt.Sprintf("a-splitbrain-%d", detectedTime.Unix())))
+
+		long := record(strings.Repeat("a", util.MaxK8sNameLength), false)
+		name := splitBrainSnapshotName(&long)
+		Expect(len(name)).To(BeNumerically("<=", util.MaxK8sNameLength))
+		Expect(name).To(HaveSuffix(fmt.Sprintf("-splitbrain-%d", detectedTime.Unix())))
+	})
+
+	Describe("updateVRGSplitBrainCondition", func() {
+		conditionOf := func(v *VRGInstance) *metav1.Condition {
+			v.updateVRGSplitBrainCondition()
+
+			return util.FindCondition(v.instance.Status.Conditions, VRGConditionTypeSplitBrain)
+		}
+
+		It("reports no condition without records", func() {
+			Expect(conditionOf(&VRGInstance{instance: vrg(nil)})).To(BeNil())
+		})
+
+		It("reports records pending acknowledgement", func() {
+			condition := conditionOf(&VRGInstance{
+				instance: vrg(&ramen.SplitBrainSpec{RequireAcknowledgement: true}, record("a", false)),
+			})
+			Expect(condition.Status).To(Equal(metav1.ConditionTrue))
+			Expect(condition.Reason).To(Equal(VRGConditionReasonSplitBrainAcknowledgementRequired))
+			Expect(condition.Message).To(ContainSubstring("ns/a"))
+		})
+
+		It("reports resolved once all records are discarded", func() {
+			condition := conditionOf(&VRGInstance{instance: vrg(nil, record("a", true))})
+			Expect(condition.Status).To(Equal(metav1.ConditionFalse))
+			Expect(condition.Reason).To(Equal(VRGConditionReasonSplitBrainResolved))
+		})
+
+		It("clears records once primary", func() {
+			v := &VRGInstance{instance: vrg(nil, record("a", true))}
+			v.instance.Spec.ReplicationState = ramen.Primary
+
+			Expect(conditionOf(v)).To(BeNil())
+			Expect(v.instance.Status.SplitBrainPVCs).To(BeEmpty())
+		})
+	})
+
+	Describe("updateDRPCSplitBrainCondition", func() {
+		vrgWithCondition := func(status metav1.ConditionStatus, reason string) *ramen.VolumeReplicationGroup {
+			return &ramen.VolumeReplicationGroup{Status: ramen.VolumeReplicationGroupStatus{
+				Conditions: []metav1.Condition{{
+					Type: VRGConditionTypeSplitBrain, Status: status, Reason: reason, Message: "ns/a",
+				}},
+			}}
+		}
+
+		It("requires acknowledgement if any VRG does", func() {
+			drpc := &ramen.DRPlacementControl{}
+
+			updateDRPCSplitBrainCondition(drpc, map[string]*ramen.VolumeReplicationGroup{
+				"c1": vrgWithCondition(metav1.ConditionTrue, VRGConditionReasonSplitBrainAcknowledgementRequired),
+				"c2": vrgWithCondition(metav1.ConditionFalse, VRGConditionReasonSplitBrainResolved),
+			})
+
+			condition := util.FindCondition(drpc.Status.Conditions, ramen.ConditionSplitBrain)
+			Expect(condition.Status).To(Equal(metav1.ConditionTrue))
+			Expect(condition.Reason).To(Equal(ramen.ReasonSplitBrainAcknowledgementRequired))
+			Expect(condition.Message).To(ContainSubstring("cluster c1"))
+			Expect(condition.Message).To(ContainSubstring("spec.splitBrain.acknowledgedTime"))
+		})
+
+		It("removes the condition once no VRG reports one", func() {
+			drpc := &ramen.DRPlacementControl{Status: ramen.DRPlacementControlStatus{
+				Conditions: []metav1.Condition{{Type: ramen.ConditionSplitBrain, Status: metav1.ConditionTrue}},
+			}}
+
+			updateDRPCSplitBrainCondition(drpc, map[string]*ramen.VolumeReplicationGroup{"c1": {}})
+			Expect(util.FindCondition(drpc.Status.Conditions, ramen.ConditionSplitBrain)).To(BeNil())
+		})
+	})
+})
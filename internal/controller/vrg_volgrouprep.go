@@ -32,7 +32,7 @@ func (v *VRGInstance) reconcileVolGroupRepsAsPrimary(groupPVCs map[types.Namespa
 	for vgrNamespacedName, pvcs := range groupPVCs {
 		log := v.log.WithValues("vgr", vgrNamespacedName.String())
 
-		requeueResult, _, err := v.processVGRAsPrimary(vgrNamespacedName, pvcs, log)
+		requeueResult, primary, err := v.processVGRAsPrimary(vgrNamespacedName, pvcs, log)
 		if requeueResult {
 			v.requeue()
 		}
@@ -47,6 +47,8 @@ func (v *VRGInstance) reconcileVolGroupRepsAsPrimary(groupPVCs map[types.Namespa
 		for idx := range pvcs {
 			pvc := pvcs[idx]
 
+			v.reconcilePVCCapacityAsPrimary(pvc, primary, log)
+
 			if err := v.uploadPVandPVCtoS3Stores(pvc, log); err != nil {
 				log.Error(err, "Requeuing due to failure to upload PV/PVC object to S3 store(s)")
 
@@ -200,28 +202,24 @@ func (v *VRGInstance) uploadVGRandVGRCtoS3Stores(vrNamespacedName types.Namespac
 			vgr.Name)
 	}
 
-	s3Profiles, err := v.UploadVGRandVGRCtoS3Stores(vgr, log)
-	if err != nil {
-		return fmt.Errorf("failed to upload VGR/VGRC with error (%w). Uploaded to %v S3 profile(s)", err, s3Profiles)
-	}
-
-	numProfilesUploaded := len(s3Profiles)
+	writes := v.UploadVGRandVGRCtoS3Stores(vgr, log)
+	v.s3WritesRecord("VGR cluster data", writes)
 
-	if numProfilesUploaded != numProfilesToUpload {
-		// Merely defensive as we don't expect to reach here
-		msg := fmt.Sprintf("uploaded VGR/VGRC cluster data to only  %d of %d S3 profile(s): %v",
-			numProfilesUploaded, numProfilesToUpload, s3Profiles)
-		v.log.Info(msg)
-
-		return errors.New(msg)
+	if !writes.quorumMet() {
+		return fmt.Errorf("failed to upload VGR/VGRC with error (%w). Uploaded to %s", writes.err(), writes)
 	}
 
+	// archived once uploaded to a quorum, so it is not uploaded to the others again until it changes
 	if err := v.addArchivedAnnotationForVGRandVGRC(vgr, log); err != nil {
 		return err
 	}
 
 	msg := fmt.Sprintf("Done uploading VGR/VGRC cluster data to %d of %d S3 profile(s): %v",
-		numProfilesUploaded, numProfilesToUpload, s3Profiles)
+		len(writes.succeeded), numProfilesToUpload, writes.succeeded)
+	if !writes.all() {
+		msg = fmt.Sprintf("Done uploading VGR/VGRC cluster data to %s", writes)
+	}
+
 	v.log.Info(msg)
 
 	return nil
@@ -278,22 +276,24 @@ func (v *VRGInstance) UploadVGRAndVGRCtoS3(s3ProfileName string, objectStore Obj
 
 func (v *VRGInstance) UploadVGRandVGRCtoS3Stores(vgr *volrep.VolumeGroupReplication,
 	log logr.Logger,
-) ([]string, error) {
-	succeededProfiles := []string{}
+) *s3Writes {
+	writes := newS3Writes(v.instance.Spec.S3WriteQuorum, len(v.instance.Spec.S3Profiles))
 	// Upload the VGR and VGRC to all the S3 profiles in the VRG spec
 	for _, s3ProfileName := range v.instance.Spec.S3Profiles {
 		err := v.UploadVGRandVGRCtoS3Store(s3ProfileName, vgr)
 		if err != nil {
 			rmnutil.ReportIfNotPresent(v.reconciler.eventRecorder, v.instance, corev1.EventTypeWarning,
 				rmnutil.EventReasonUploadFailed, err.Error())
+			log.Info("VGR/VGRC cluster data upload error", "profile", s3ProfileName, "error", err.Error())
+			writes.fail(s3ProfileName, err)
 
-			return succeededProfiles, err
+			continue
 		}
 
-		succeededProfiles = append(succeededProfiles, s3ProfileName)
+		writes.succeed(s3ProfileName)
 	}
 
-	return succeededProfiles, nil
+	return writes
 }
 
 func (v *VRGInstance) getVGRCFromVGR(vgr *volrep.VolumeGroupReplication) (volrep.VolumeGroupReplicationContent, error) {
@@ -702,8 +702,15 @@ func (v *VRGInstance) updateVGR(pvcs []*corev1.PersistentVolumeClaim,
 
 	log.Info(fmt.Sprintf("Update VolumeGroupReplication %s/%s", volRep.Namespace, volRep.Name))
 
+	ready, err := v.splitBrainPrepareDemotion(pvcs, volRep.Spec.ReplicationState, state,
+		&volRep.Status.VolumeReplicationStatus, volRep.Generation, log)
+	if err != nil || !ready {
+		return requeue, false, err
+	}
+
 	if volRep.Spec.ReplicationState == state && volRep.Spec.AutoResync == v.autoResync(state) {
 		log.Info("VolumeGroupReplication and VolumeReplicationGroup state match. Proceeding to status check")
+		v.splitBrainMarkDiscarded(pvcs, state, volRep.Spec.AutoResync)
 
 		return !requeue, v.checkVRStatus(pvcs, volRep, &volRep.Status.VolumeReplicationStatus), nil
 	}
@@ -732,6 +739,8 @@ func (v *VRGInstance) updateVGR(pvcs []*corev1.PersistentVolumeClaim,
 			v.instance.Namespace, v.instance.Name, err)
 	}
 
+	v.splitBrainMarkDiscarded(pvcs, state, volRep.Spec.AutoResync)
+
 	log.Info(fmt.Sprintf("Updated VolumeGroupReplication resource (%s/%s) with state %s",
 		volRep.GetName(), volRep.GetNamespace(), state))
 	// Just updated the state of the VolRep. Mark it as progressing.
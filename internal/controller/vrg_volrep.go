@@ -102,7 +102,7 @@ func (v *VRGInstance) reconcileVolRepsAsPrimary() {
 		}
 
 		// If VR did not reach primary state, it is fine to still upload the PV and continue processing
-		requeueResult, _, err := v.processVRAsPrimary(pvcNamespacedName, pvc, log)
+		requeueResult, primary, err := v.processVRAsPrimary(pvcNamespacedName, pvc, log)
 		if requeueResult {
 			v.requeue()
 		}
@@ -114,6 +114,8 @@ func (v *VRGInstance) reconcileVolRepsAsPrimary() {
 			continue
 		}
 
+		v.reconcilePVCCapacityAsPrimary(pvc, primary, log)
+
 		// Protect the PVC's PV object stored in etcd by uploading it to S3
 		// store(s).  Note that the VRG is responsible only to protect the PV
 		// object of each PVC of the subscription.  However, the PVC object
@@ -318,12 +320,17 @@ func (v *VRGInstance) updateProtectedPVCs(pvc *corev1.PersistentVolumeClaim) err
 	}
 
 	protectedPVC.ProtectedByVolSync = false
+	protectedPVC.ReplicationMethod = ramendrv1alpha1.ReplicationMethodVolRep
 	protectedPVC.StorageClassName = pvc.Spec.StorageClassName
 	protectedPVC.Labels = pvc.Labels
 	protectedPVC.AccessModes = pvc.Spec.AccessModes
 	protectedPVC.Resources = pvc.Spec.Resources
 	protectedPVC.VolumeMode = pvc.Spec.VolumeMode
 
+	if selectVolumeGroup {
+		protectedPVC.ReplicationMethod = ramendrv1alpha1.ReplicationMethodVolGroupRep
+	}
+
 	return v.setPVCStorageIdentifiers(protectedPVC, storageClass, pvc)
 }
 
@@ -370,8 +377,10 @@ func MModesFromCSV(modes string) []ramendrv1alpha1.MMode {
 
 	for _, mode := range strings.Split(modes, ",") {
 		switch mode {
-		case string(ramendrv1alpha1.MModeFailover):
-			mModes = append(mModes, ramendrv1alpha1.MModeFailover)
+		case string(ramendrv1alpha1.MModeFailover),
+			string(ramendrv1alpha1.MModeRelocate),
+			string(ramendrv1alpha1.MModeResync):
+			mModes = append(mModes, ramendrv1alpha1.MMode(mode))
 		default:
 			// ignore unknown modes (TODO: should we error instead?)
 			continue
@@ -670,24 +679,15 @@ func (v *VRGInstance) uploadPVandPVCtoS3Stores(pvc *corev1.PersistentVolumeClaim
 			pvc.Name)
 	}
 
-	s3Profiles, err := v.UploadPVandPVCtoS3Stores(pvc, log)
-	if err != nil {
-		return fmt.Errorf("failed to upload PV/PVC with error (%w). Uploaded to %v S3 profile(s)", err, s3Profiles)
-	}
-
-	numProfilesUploaded := len(s3Profiles)
+	writes := v.UploadPVandPVCtoS3Stores(pvc, log)
+	v.s3WritesRecord("PV cluster data", writes)
 
-	if numProfilesUploaded != numProfilesToUpload {
-		// Merely defensive as we don't expect to reach here
-		msg := fmt.Sprintf("uploaded PV/PVC cluster data to only  %d of %d S3 profile(s): %v",
-			numProfilesUploaded, numProfilesToUpload, s3Profiles)
-		v.log.Info(msg)
-		v.updatePVCClusterDataProtectedCondition(pvc.Namespace, pvc.Name,
-			VRGConditionReasonUploadError, msg)
-
-		return errors.New(msg)
+	if !writes.quorumMet() {
+		return fmt.Errorf("failed to upload PV/PVC with error (%w). Uploaded to %s", writes.err(), writes)
 	}
 
+	// archived once uploaded to a quorum, so it is not uploaded to the others again until it changes. Restores skip
+	// stores that lack the cluster data of a PVC reported protected, see s3SourceMissingPVCs.
 	if err := v.addArchivedAnnotationForPVC(pvc, log); err != nil {
 		msg := fmt.Sprintf("failed to add archived annotation: %s", err)
 		v.updatePVCClusterDataProtectedCondition(pvc.Namespace, pvc.Name,
@@ -697,7 +697,11 @@ func (v *VRGInstance) uploadPVandPVCtoS3Stores(pvc *corev1.PersistentVolumeClaim
 	}
 
 	msg := fmt.Sprintf("Done uploading PV/PVC cluster data to %d of %d S3 profile(s): %v",
-		numProfilesUploaded, numProfilesToUpload, s3Profiles)
+		len(writes.succeeded), numProfilesToUpload, writes.succeeded)
+	if !writes.all() {
+		msg = fmt.Sprintf("Done uploading PV/PVC cluster data to %s", writes)
+	}
+
 	v.log.Info(msg)
 	v.updatePVCClusterDataProtectedCondition(pvc.Namespace, pvc.Name,
 		VRGConditionReasonUploaded, msg)
@@ -756,8 +760,8 @@ func (v *VRGInstance) UploadPVAndPVCtoS3(s3ProfileName string, objectStore Objec
 
 func (v *VRGInstance) UploadPVandPVCtoS3Stores(pvc *corev1.PersistentVolumeClaim,
 	log logr.Logger,
-) ([]string, error) {
-	succeededProfiles := []string{}
+) *s3Writes {
+	writes := newS3Writes(v.instance.Spec.S3WriteQuorum, len(v.instance.Spec.S3Profiles))
 	// Upload the PV to all the S3 profiles in the VRG spec
 	for _, s3ProfileName := range v.instance.Spec.S3Profiles {
 		err := v.UploadPVandPVCtoS3Store(s3ProfileName, pvc)
@@ -765,14 +769,16 @@ func (v *VRGInstance) UploadPVandPVCtoS3Stores(pvc *corev1.PersistentVolumeClaim
 			v.updatePVCClusterDataProtectedCondition(pvc.Namespace, pvc.Name, VRGConditionReasonUploadError, err.Error())
 			rmnutil.ReportIfNotPresent(v.reconciler.eventRecorder, v.instance, corev1.EventTypeWarning,
 				rmnutil.EventReasonUploadFailed, err.Error())
+			log.Info("PV/PVC cluster data upload error", "profile", s3ProfileName, "error", err.Error())
+			writes.fail(s3ProfileName, err)
 
-			return succeededProfiles, err
+			continue
 		}
 
-		succeededProfiles = append(succeededProfiles, s3ProfileName)
+		writes.succeed(s3ProfileName)
 	}
 
-	return succeededProfiles, nil
+	return writes
 }
 
 func (v *VRGInstance) getPVFromPVC(pvc *corev1.PersistentVolumeClaim) (corev1.PersistentVolume, error) {
@@ -1268,7 +1274,7 @@ func (v *VRGInstance) autoResync(state volrep.ReplicationState) bool {
 		return false
 	}
 
-	return true
+	return !splitBrainAcknowledgementPending(v.instance)
 }
 
 // updateVR updates the VR to the desired state and returns,
@@ -1280,11 +1286,20 @@ func (v *VRGInstance) updateVR(pvc *corev1.PersistentVolumeClaim, volRep *volrep
 ) (bool, bool, error) {
 	const requeue = true
 
+	pvcs := []*corev1.PersistentVolumeClaim{pvc}
+
+	ready, err := v.splitBrainPrepareDemotion(pvcs, volRep.Spec.ReplicationState, state, &volRep.Status,
+		volRep.Generation, log)
+	if err != nil || !ready {
+		return requeue, false, err
+	}
+
 	// If state is already as desired, check the status
 	if volRep.Spec.ReplicationState == state && volRep.Spec.AutoResync == v.autoResync(state) {
 		log.Info("VolumeReplication and VolumeReplicationGroup state and autoresync match. Proceeding to status check")
+		v.splitBrainMarkDiscarded(pvcs, state, volRep.Spec.AutoResync)
 
-		return !requeue, v.checkVRStatus([]*corev1.PersistentVolumeClaim{pvc}, volRep, &volRep.Status), nil
+		return !requeue, v.checkVRStatus(pvcs, volRep, &volRep.Status), nil
 	}
 
 	volRep.Spec.ReplicationState = state
@@ -1306,6 +1321,8 @@ func (v *VRGInstance) updateVR(pvc *corev1.PersistentVolumeClaim, volRep *volrep
 			v.instance.Namespace, v.instance.Name, err)
 	}
 
+	v.splitBrainMarkDiscarded(pvcs, state, volRep.Spec.AutoResync)
+
 	log.Info(fmt.Sprintf("Updated VolumeReplication resource (%s/%s) with state %s",
 		volRep.GetName(), volRep.GetNamespace(), state))
 	// Just updated the state of the VolRep. Mark it as progressing.
@@ -2122,7 +2139,9 @@ func (v *VRGInstance) s3KeyPrefix() string {
 	return S3KeyPrefix(v.namespacedName)
 }
 
-func (v *VRGInstance) restorePVsAndPVCsForVolRep(result *ctrl.Result) (int, error) {
+// restorePVsAndPVCsForVolRep restores the PVs and PVCs from the s3 store with the most recent copy of them, and returns
+// how many, and which store and why, if restored from one
+func (v *VRGInstance) restorePVsAndPVCsForVolRep(result *ctrl.Result) (int, string, error) {
 	v.log.Info("Restoring VolRep PVs and PVCs")
 
 	if len(v.instance.Spec.S3Profiles) == 0 {
@@ -2130,50 +2149,96 @@ func (v *VRGInstance) restorePVsAndPVCsForVolRep(result *ctrl.Result) (int, erro
 
 		result.Requeue = true
 
-		return 0, fmt.Errorf("no S3Profiles configured")
+		return 0, "", fmt.Errorf("no S3Profiles configured")
 	}
 
 	v.log.Info(fmt.Sprintf("Restoring PVs and PVCs to this managed cluster. ProfileList: %v", v.instance.Spec.S3Profiles))
 
-	count, err := v.restorePVsAndPVCsFromS3(result)
+	count, s3ProfileName, reasons, err := v.restorePVsAndPVCsFromS3(result)
 	if err != nil {
 		errMsg := fmt.Sprintf("failed to restore PVs and PVCs using profile list (%v)", v.instance.Spec.S3Profiles)
 		v.log.Info(errMsg)
 
-		return 0, fmt.Errorf("%s: %w", errMsg, err)
+		return 0, "", fmt.Errorf("%s: %w", errMsg, err)
+	}
+
+	if s3ProfileName == "" || count == 0 {
+		return count, "", nil
 	}
 
-	return count, nil
+	return count, s3SourceMessage("VolRep PVs and PVCs restored", s3ProfileName, reasons), nil
 }
 
-func (v *VRGInstance) restorePVsAndPVCsFromS3(result *ctrl.Result) (int, error) {
+// restorePVsAndPVCsSources returns the s3 stores to restore PVs and PVCs from, those whose copy of the VRG was updated
+// most recently first, and those without a copy of it last, and describes why the others are not restored from, or
+// the preferred one not first
+func (v *VRGInstance) restorePVsAndPVCsSources() ([]s3Source, []string, bool, error) {
+	sources := make([]s3Source, 0, len(v.instance.Spec.S3Profiles))
+	reasons := []string{}
+	noS3 := false
 	err := errors.New("s3Profiles empty")
-	NoS3 := false
 
 	for _, s3ProfileName := range v.instance.Spec.S3Profiles {
 		if s3ProfileName == NoS3StoreAvailable {
 			v.log.Info("NoS3 available to fetch")
 
-			NoS3 = true
+			noS3 = true
 
 			continue
 		}
 
 		var objectStore ObjectStorer
 
-		objectStore, _, err = v.reconciler.ObjStoreGetter.ObjectStore(
+		var s3StoreProfile ramendrv1alpha1.S3StoreProfile
+
+		objectStore, s3StoreProfile, err = v.reconciler.ObjStoreGetter.ObjectStore(
 			v.ctx, v.reconciler.APIReader, s3ProfileName, v.namespacedName, v.log)
 		if err != nil {
 			v.log.Error(err, "Kube objects recovery object store inaccessible", "profile", s3ProfileName)
+			reasons = append(reasons, fmt.Sprintf("S3 profile %s inaccessible: %v", s3ProfileName, err))
 
 			continue
 		}
 
+		source := s3Source{s3StoreAccessor: s3StoreAccessor{objectStore, s3StoreProfile}}
+		source.S3ProfileName = s3ProfileName
+
+		vrg := &ramendrv1alpha1.VolumeReplicationGroup{}
+		if err := vrgObjectDownload(objectStore, v.s3KeyPrefix(), vrg); err == nil {
+			source.vrg = vrg
+			source.time = vrg.Status.LastUpdateTime
+		}
+
+		sources = append(sources, source)
+	}
+
+	if reason := s3SourcesOrder(sources); reason != "" {
+		reasons = append(reasons, reason)
+	}
+
+	return sources, reasons, noS3, err
+}
+
+func (v *VRGInstance) restorePVsAndPVCsFromS3(result *ctrl.Result) (int, string, []string, error) {
+	sources, reasons, noS3, err := v.restorePVsAndPVCsSources()
+
+	for _, source := range sources {
+		s3ProfileName := source.S3ProfileName
+		objectStore := source.ObjectStorer
+
 		var pvCount, pvcCount int
 
+		if reason := v.s3SourceIncomplete(source); reason != "" {
+			reasons = append(reasons, reason)
+
+			continue
+		}
+
 		// Restore all PVs found in the s3 store. If any failure, the next profile will be retried
 		pvCount, err = v.restorePVsFromObjectStore(objectStore, s3ProfileName)
 		if err != nil {
+			reasons = append(reasons, fmt.Sprintf("S3 profile %s PVs restore error: %v", s3ProfileName, err))
+
 			continue
 		}
 
@@ -2184,26 +2249,55 @@ func (v *VRGInstance) restorePVsAndPVCsFromS3(result *ctrl.Result) (int, error)
 		// CrunchyDB is responsible for creating and managing the lifecycle of their own PVCs, a newly created
 		// PVC may cause a new PV to be created.
 		// Ignoring PVC restore errors helps with the upgrade from ODF-4.12.x to 4.13
-		pvcCount, err = v.restorePVCsFromObjectStore(objectStore, s3ProfileName)
+		pvcCount, err = v.restorePVCsFromObjectStore(objectStore, s3ProfileName, source.vrg)
 		if err != nil || pvCount != pvcCount {
 			v.log.Info(fmt.Sprintf("Warning: Mismatch in PV/PVC count %d/%d (%v)",
 				pvCount, pvcCount, err))
+			reasons = append(reasons, fmt.Sprintf("S3 profile %s PV/PVC count mismatch %d/%d",
+				s3ProfileName, pvCount, pvcCount))
 
 			continue
 		}
 
 		v.log.Info(fmt.Sprintf("Restored %d PVs and %d PVCs using profile %s", pvCount, pvcCount, s3ProfileName))
 
-		return pvCount + pvcCount, nil
+		return pvCount + pvcCount, s3ProfileName, reasons, nil
 	}
 
-	if NoS3 {
-		return 0, nil
+	if noS3 {
+		return 0, "", nil, nil
 	}
 
 	result.Requeue = true
 
-	return 0, err
+	return 0, "", nil, err
+}
+
+// s3SourceIncomplete describes why the s3 store is not restored from if it lacks the cluster data of any PVC that its
+// copy of the VRG reports protected, or returns an empty string otherwise
+func (v *VRGInstance) s3SourceIncomplete(source s3Source) string {
+	if source.vrg == nil {
+		return ""
+	}
+
+	pvList, err := downloadPVs(source.ObjectStorer, v.s3KeyPrefix())
+	if err != nil {
+		return fmt.Sprintf("S3 profile %s PVs download error: %v", source.S3ProfileName, err)
+	}
+
+	pvcList, err := downloadPVCs(source.ObjectStorer, v.s3KeyPrefix())
+	if err != nil {
+		return fmt.Sprintf("S3 profile %s PVCs download error: %v", source.S3ProfileName, err)
+	}
+
+	missing := s3SourceMissingPVCs(source.vrg, pvList, pvcList)
+	if len(missing) == 0 {
+		return ""
+	}
+
+	v.log.Info("S3 store lacks cluster data of protected PVCs", "profile", source.S3ProfileName, "pvcs", missing)
+
+	return fmt.Sprintf("S3 profile %s lacks cluster data of protected PVCs %v", source.S3ProfileName, missing)
 }
 
 func (v *VRGInstance) restorePVsFromObjectStore(objectStore ObjectStorer, s3ProfileName string) (int, error) {
@@ -2227,7 +2321,9 @@ func (v *VRGInstance) restorePVsFromObjectStore(objectStore ObjectStorer, s3Prof
 	return restoreClusterDataObjects(v, pvList, "PV", v.cleanupPVForRestore, v.validateExistingPV)
 }
 
-func (v *VRGInstance) restorePVCsFromObjectStore(objectStore ObjectStorer, s3ProfileName string) (int, error) {
+func (v *VRGInstance) restorePVCsFromObjectStore(objectStore ObjectStorer, s3ProfileName string,
+	sourceVRG *ramendrv1alpha1.VolumeReplicationGroup,
+) (int, error) {
 	pvcList, err := downloadPVCs(objectStore, v.s3KeyPrefix())
 	if err != nil {
 		v.log.Error(err, fmt.Sprintf("error fetching PVC cluster data from S3 profile %s", s3ProfileName))
@@ -2239,7 +2335,17 @@ func (v *VRGInstance) restorePVCsFromObjectStore(objectStore ObjectStorer, s3Pro
 
 	v.volRepPVCs = append(v.volRepPVCs, pvcList...)
 
-	return restoreClusterDataObjects(v, pvcList, "PVC", cleanupPVCForRestore, v.validateExistingPVC)
+	cleanupForRestore := func(pvc *corev1.PersistentVolumeClaim) error {
+		if err := cleanupPVCForRestore(pvc); err != nil {
+			return err
+		}
+
+		pvcExpectedCapacityRecord(pvc, sourceVRG)
+
+		return nil
+	}
+
+	return restoreClusterDataObjects(v, pvcList, "PVC", cleanupForRestore, v.validateExistingPVC)
 }
 
 // checkPVClusterData returns an error if there are PVs in the input pvList
@@ -5,6 +5,7 @@ package controllers
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"reflect"
 	"strings"
@@ -47,7 +48,8 @@ func (v *VRGInstance) restorePVsAndPVCsForVolSync() (int, error) {
 		if ok && util.IsCGEnabledForVolSync(v.ctx, v.reconciler.APIReader) {
 			v.log.Info("The CG label from the primary cluster found in RDSpec", "Label", cgLabelVal)
 			// Get the CG label value for this cluster
-			cgLabelVal, err = v.getCGLabelValue(rdSpec.ProtectedPVC.StorageClassName,
+			cgLabelVal, err = v.getCGLabelValue(
+				v.volSyncHandler.DestinationStorageClassName(rdSpec.ProtectedPVC.StorageClassName),
 				rdSpec.ProtectedPVC.Name, rdSpec.ProtectedPVC.Namespace)
 			if err == nil {
 				cephfsCGHandler := cephfscg.NewVSCGHandler(
@@ -252,6 +254,7 @@ func (v *VRGInstance) reconcilePVCAsVolSyncPrimary(pvc corev1.PersistentVolumeCl
 		if rgs != nil {
 			protectedPVC.LastSyncTime = rgs.Status.LastSyncTime
 			protectedPVC.LastSyncDuration = rgs.Status.LastSyncDuration
+			protectedPVC.SyncProgress = volsync.SyncProgressForRGS(rgs, v.cgReplicationSource(&pvc))
 		}
 
 		return v.instance.Spec.RunFinalSync && !finalSyncComplete
@@ -264,6 +267,13 @@ func (v *VRGInstance) reconcilePVCAsVolSyncPrimary(pvc corev1.PersistentVolumeCl
 		v.log.Info(fmt.Sprintf("Failed to reconcile VolSync Replication Source for rsSpec %v. Error %v",
 			rsSpec, err))
 
+		if errors.Is(err, volsync.ErrUnmountedPVCNotSnapshotable) {
+			setVRGConditionTypeVolSyncRepSourceSetupUnmountedPVCNotSnapshotable(&protectedPVC.Conditions,
+				v.instance.Generation, err.Error())
+
+			return true
+		}
+
 		setVRGConditionTypeVolSyncRepSourceSetupError(&protectedPVC.Conditions, v.instance.Generation,
 			"VolSync setup failed")
 
@@ -281,9 +291,29 @@ func (v *VRGInstance) reconcilePVCAsVolSyncPrimary(pvc corev1.PersistentVolumeCl
 		protectedPVC.LastSyncDuration = rs.Status.LastSyncDuration
 	}
 
+	protectedPVC.SyncProgress = volsync.SyncProgressForRS(rs)
+
 	return v.instance.Spec.RunFinalSync && !finalSyncComplete
 }
 
+// cgReplicationSource returns the ReplicationSource that the ReplicationGroupSource of a consistency group created
+// for the passed in PVC, or nil if it is not found
+func (v *VRGInstance) cgReplicationSource(pvc *corev1.PersistentVolumeClaim) *volsyncv1alpha1.ReplicationSource {
+	rs := &volsyncv1alpha1.ReplicationSource{}
+
+	err := v.reconciler.Get(v.ctx, types.NamespacedName{Namespace: pvc.Namespace, Name: pvc.Name}, rs)
+	if err != nil {
+		if !k8serrors.IsNotFound(err) {
+			v.log.Info("Failed to get ReplicationSource for sync progress", "pvc", pvc.Namespace+"/"+pvc.Name,
+				"error", err)
+		}
+
+		return nil
+	}
+
+	return rs
+}
+
 func (v *VRGInstance) buildProtectedPVCForPVC(
 	pvc corev1.PersistentVolumeClaim,
 ) (*ramendrv1alpha1.ProtectedPVC, bool, bool) {
@@ -291,6 +321,7 @@ func (v *VRGInstance) buildProtectedPVCForPVC(
 		Name:               pvc.Name,
 		Namespace:          pvc.Namespace,
 		ProtectedByVolSync: true,
+		ReplicationMethod:  ramendrv1alpha1.ReplicationMethodVolSync,
 		StorageClassName:   pvc.Spec.StorageClassName,
 		Annotations:        PruneAnnotations(pvc.GetAnnotations()),
 		Labels:             pvc.Labels,
@@ -461,7 +492,8 @@ func (v *VRGInstance) reconcileCGMembership() (map[string]struct{}, bool, error)
 		if ok && util.IsCGEnabledForVolSync(v.ctx, v.reconciler.APIReader) {
 			v.log.Info("RDSpec contains the CG label from the primary cluster", "Label", cgLabelVal)
 			// Get the CG label value for this cluster
-			cgLabelVal, err := v.getCGLabelValue(rdSpec.ProtectedPVC.StorageClassName,
+			cgLabelVal, err := v.getCGLabelValue(
+				v.volSyncHandler.DestinationStorageClassName(rdSpec.ProtectedPVC.StorageClassName),
 				rdSpec.ProtectedPVC.Name, rdSpec.ProtectedPVC.Namespace)
 			if err != nil {
 				v.log.Error(err, "Failed to get cgLabelVal")
@@ -516,6 +548,8 @@ func (v *VRGInstance) reconcileNonCG(rdSpecsUsingCG map[string]struct{}) (bool,
 		}
 
 		if rdInfoForStatus != nil {
+			rdInfoForStatus.ProtectedPVC.SyncProgress = volsync.SyncProgressForRD(rd)
+
 			v.log.Info("Computed RDInfo for VRG (secondary role)", "RDInfo", rdInfoForStatus)
 
 			v.instance.Status.RDInfo = v.volSyncHandler.AppendOrUpdateRdInfo(v.instance.Status.RDInfo, *rdInfoForStatus)
@@ -616,8 +650,9 @@ func (v *VRGInstance) updateRDInfoFromReplicationDestinations(
 
 		newRDInfo := ramendrv1alpha1.VolSyncReplicationDestinationInfo{
 			ProtectedPVC: ramendrv1alpha1.ProtectedPVC{
-				Name:      pvcName,
-				Namespace: namespace,
+				Name:         pvcName,
+				Namespace:    namespace,
+				SyncProgress: volsync.SyncProgressForRD(rd),
 			},
 			RsyncTLS: &ramendrv1alpha1.RsyncTLSConfig{
 				Address: *rd.Status.RsyncTLS.Address,
@@ -789,6 +824,13 @@ func (v *VRGInstance) buildDataProtectedCondition() *metav1.Condition {
 		}
 
 		actualVolSyncPVCs++
+
+		// Syncs of a PVC whose resize is pending may not complete, until the destination is resized as well
+		if pvcResizePending(&pvc) {
+			ready = false
+
+			v.log.Info(fmt.Sprintf("Resize is in progress for VolSync PVC %s", pvc.Name))
+		}
 	}
 
 	if ready && actualVolSyncPVCs > protectedByVolSyncCount {
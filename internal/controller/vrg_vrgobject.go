@@ -36,6 +36,13 @@ func (v *VRGInstance) vrgObjectProtectThrottled(result *ctrl.Result,
 	vrg := v.instance
 	eventReporter := v.reconciler.eventRecorder
 	log := v.log
+	writes := v.s3AccessorWritesNew()
+
+	if writes.total == 0 {
+		success()
+
+		return
+	}
 
 	for _, s3StoreAccessor := range v.s3StoreAccessors {
 		log1 := log.WithValues("profile", s3StoreAccessor.S3ProfileName)
@@ -44,26 +51,36 @@ func (v *VRGInstance) vrgObjectProtectThrottled(result *ctrl.Result,
 			util.ReportIfNotPresent(
 				eventReporter, vrg, corev1.EventTypeWarning, util.EventReasonVrgUploadFailed, err.Error(),
 			)
+			log1.Error(err, "VRG Kube object protect error")
+			writes.fail(s3StoreAccessor.S3ProfileName, err)
 
-			const message = "VRG Kube object protect error"
+			continue
+		}
 
-			log1.Error(err, message)
+		log1.Info("VRG Kube object protected")
+		writes.succeed(s3StoreAccessor.S3ProfileName)
+	}
 
-			v.vrgObjectProtected = newVRGClusterDataUnprotectedCondition(vrg.Generation,
-				"VolumeReplicationGroupObjectCaptureError", message)
-			result.Requeue = true
+	v.s3WritesRecord("VRG", writes)
 
-			failure()
+	if !writes.quorumMet() {
+		v.vrgObjectProtected = newVRGClusterDataUnprotectedCondition(vrg.Generation,
+			"VolumeReplicationGroupObjectCaptureError", "VRG Kube object protect error: "+writes.String())
+		result.Requeue = true
 
-			return
-		}
+		failure()
 
-		log1.Info("VRG Kube object protected")
+		return
+	}
 
-		vrgLastUploadVersion.Store(v.namespacedName, vrg.ResourceVersion)
-		v.vrgObjectProtected = newVRGClusterDataProtectedCondition(vrg.Generation, vrgClusterDataProtectedTrueMessage)
+	message := vrgClusterDataProtectedTrueMessage
+	if !writes.all() {
+		message += " in " + writes.String()
 	}
 
+	vrgLastUploadVersion.Store(v.namespacedName, vrg.ResourceVersion)
+	v.vrgObjectProtected = newVRGClusterDataProtectedCondition(vrg.Generation, message)
+
 	success()
 }
 